@@ -6,6 +6,8 @@ go 1.19
 
 require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector v0.72.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector v0.72.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanlogsconnector v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/alibabacloudlogserviceexporter v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awscloudwatchlogsexporter v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter v0.72.0
@@ -44,6 +46,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/skywalkingexporter v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter v0.72.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tanzuobservabilityexporter v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tencentcloudlogserviceexporter v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/exporter/zipkinexporter v0.72.0
@@ -97,6 +100,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/collectdreceiver v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/couchdbreceiver v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/datadogreceiver v0.72.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dotnetdiagnosticsreceiver v0.72.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver v0.72.0
@@ -659,6 +663,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nsxtr
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver => ../../receiver/kubeletstatsreceiver
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver => ../../receiver/dockerlogsreceiver
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver => ../../receiver/dockerstatsreceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudpubsubexporter => ../../exporter/googlecloudpubsubexporter
@@ -981,6 +987,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googl
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter => ../../exporter/sumologicexporter
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter => ../../exporter/syslogexporter
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/exporter/instanaexporter => ../../exporter/instanaexporter
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver => ../../receiver/otlpjsonfilereceiver
@@ -1002,3 +1010,7 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest
 replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil => ../../pkg/pdatautil
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector => ../../connector/countconnector
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanlogsconnector => ../../connector/spanlogsconnector
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector => ../../connector/logmetricsconnector
@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+const (
+	delta      = "AGGREGATION_TEMPORALITY_DELTA"
+	cumulative = "AGGREGATION_TEMPORALITY_CUMULATIVE"
+)
+
+// Supported metric types.
+const (
+	metricTypeSum       = "sum"
+	metricTypeGauge     = "gauge"
+	metricTypeHistogram = "histogram"
+)
+
+var defaultHistogramBuckets = []float64{
+	2, 4, 6, 8, 10, 50, 100, 200, 400, 800, 1000, 1400, 2000, 5000, 10_000, 15_000,
+}
+
+// Config defines the configuration options for logmetricsconnector.
+type Config struct {
+	// Metrics defines the metrics to emit, keyed by metric name.
+	Metrics map[string]MetricInfo `mapstructure:"metrics"`
+
+	AggregationTemporality string `mapstructure:"aggregation_temporality"`
+
+	// DimensionsCacheSize is the maximum number of data points, i.e. of distinct resources, metrics and
+	// dimensions, that are aggregated. The least recently updated data points are dropped after the next
+	// flush once reached, which avoids the memory growing indefinitely with cumulative temporality.
+	// Optional. See defaultDimensionsCacheSize in connector.go for the default value.
+	DimensionsCacheSize int `mapstructure:"dimensions_cache_size"`
+
+	// MetricsFlushInterval is the time period between when metrics are flushed or emitted to the next consumer.
+	MetricsFlushInterval time.Duration `mapstructure:"metrics_flush_interval"`
+}
+
+// MetricInfo defines how a metric is extracted from log records.
+type MetricInfo struct {
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+
+	// Type is one of sum, gauge or histogram.
+	Type string `mapstructure:"type"`
+
+	// Value is an OTTL value expression evaluated against each matching log record,
+	// e.g. attributes["duration_ms"] or body.
+	Value string `mapstructure:"value"`
+
+	// Pattern is an optional regular expression applied to the string form of the value.
+	// The first capture group is parsed as the value of the measurement.
+	Pattern string `mapstructure:"pattern"`
+
+	// Conditions are OTTL conditions, ORed together, that a log record must match to be measured.
+	// All log records are measured if no conditions are specified.
+	Conditions []string `mapstructure:"conditions"`
+
+	// Dimensions defines the attributes of the emitted data points. The values are
+	// fetched from the log record attributes, then from the resource attributes.
+	Dimensions []Dimension `mapstructure:"dimensions"`
+
+	// Monotonic marks a sum as monotonic. Only used by sums.
+	Monotonic bool `mapstructure:"monotonic"`
+
+	// Buckets are the explicit bounds of a histogram. Only used by histograms.
+	// See defaultHistogramBuckets for the default value.
+	Buckets []float64 `mapstructure:"buckets"`
+}
+
+// Dimension defines the dimension name and optional default value if the Dimension is missing from a log record attribute.
+type Dimension struct {
+	Name    string  `mapstructure:"name"`
+	Default *string `mapstructure:"default"`
+}
+
+var _ component.ConfigValidator = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid
+func (c *Config) Validate() error {
+	if len(c.Metrics) == 0 {
+		return errors.New("at least one metric must be configured")
+	}
+	if c.AggregationTemporality != delta && c.AggregationTemporality != cumulative {
+		return fmt.Errorf("invalid aggregation_temporality: %q", c.AggregationTemporality)
+	}
+	if c.MetricsFlushInterval <= 0 {
+		return fmt.Errorf("invalid metrics_flush_interval: %v, the interval should be positive", c.MetricsFlushInterval)
+	}
+
+	parser, err := newLogParser(zap.NewNop())
+	if err != nil {
+		return err
+	}
+	for name, info := range c.Metrics {
+		if name == "" {
+			return fmt.Errorf("metrics: metric name missing")
+		}
+		switch info.Type {
+		case metricTypeSum, metricTypeGauge, metricTypeHistogram:
+		default:
+			return fmt.Errorf("metric %q: invalid type %q", name, info.Type)
+		}
+		if info.Value == "" {
+			return fmt.Errorf("metric %q: value missing", name)
+		}
+		if _, err = parseValue(parser, info.Value); err != nil {
+			return fmt.Errorf("metric %q: value: %w", name, err)
+		}
+		if info.Pattern != "" {
+			re, err := regexp.Compile(info.Pattern)
+			if err != nil {
+				return fmt.Errorf("metric %q: pattern: %w", name, err)
+			}
+			if re.NumSubexp() == 0 {
+				return fmt.Errorf("metric %q: pattern must contain a capture group", name)
+			}
+		}
+		if _, err = parser.ParseConditions(info.Conditions); err != nil {
+			return fmt.Errorf("metric %q: condition: %w", name, err)
+		}
+		if err = validateDimensions(info.Dimensions); err != nil {
+			return fmt.Errorf("metric %q: %w", name, err)
+		}
+		if !sort.Float64sAreSorted(info.Buckets) {
+			return fmt.Errorf("metric %q: buckets must be sorted in ascending order", name)
+		}
+	}
+
+	if c.DimensionsCacheSize <= 0 {
+		return fmt.Errorf(
+			"invalid cache size: %v, the maximum number of the items in the cache should be positive",
+			c.DimensionsCacheSize,
+		)
+	}
+	return nil
+}
+
+// GetAggregationTemporality converts the string value given in the config into a AggregationTemporality.
+// Returns cumulative, unless delta is correctly specified.
+func (c *Config) GetAggregationTemporality() pmetric.AggregationTemporality {
+	if c.AggregationTemporality == delta {
+		return pmetric.AggregationTemporalityDelta
+	}
+	return pmetric.AggregationTemporalityCumulative
+}
+
+// validateDimensions checks for missing and duplicate dimension names.
+func validateDimensions(dimensions []Dimension) error {
+	names := make(map[string]struct{}, len(dimensions))
+	for _, d := range dimensions {
+		if d.Name == "" {
+			return errors.New("dimension name missing")
+		}
+		if _, ok := names[d.Name]; ok {
+			return fmt.Errorf("duplicate dimension name %s", d.Name)
+		}
+		names[d.Name] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	unknown := "unknown"
+	testCases := []struct {
+		name   string
+		expect *Config
+	}{
+		{
+			name: "",
+			expect: &Config{
+				AggregationTemporality: cumulative,
+				DimensionsCacheSize:    defaultDimensionsCacheSize,
+				MetricsFlushInterval:   15 * time.Second,
+			},
+		},
+		{
+			name: "full",
+			expect: &Config{
+				AggregationTemporality: delta,
+				DimensionsCacheSize:    500,
+				MetricsFlushInterval:   30 * time.Second,
+				Metrics: map[string]MetricInfo{
+					"http.request.duration": {
+						Description: "Duration of HTTP requests.",
+						Unit:        "ms",
+						Type:        metricTypeHistogram,
+						Value:       `attributes["duration_ms"]`,
+						Conditions:  []string{`attributes["http.method"] != nil`},
+						Dimensions: []Dimension{
+							{Name: "http.method"},
+							{Name: "http.route", Default: &unknown},
+						},
+						Buckets: []float64{10, 100, 1000},
+					},
+					"body.duration": {
+						Unit:      "ms",
+						Type:      metricTypeSum,
+						Value:     "body",
+						Pattern:   `took (\d+)ms`,
+						Monotonic: true,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(component.NewIDWithName(typeStr, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	validMetric := func(modify func(*MetricInfo)) map[string]MetricInfo {
+		info := MetricInfo{
+			Type:  metricTypeSum,
+			Value: `attributes["bytes"]`,
+		}
+		modify(&info)
+		return map[string]MetricInfo{"my.metric": info}
+	}
+
+	testCases := []struct {
+		name   string
+		input  *Config
+		expect string
+	}{
+		{
+			name:   "no_metrics",
+			input:  createDefaultConfig().(*Config),
+			expect: "at least one metric must be configured",
+		},
+		{
+			name: "invalid_temporality",
+			input: &Config{
+				AggregationTemporality: "AGGREGATION_TEMPORALITY_UNSPECIFIED",
+				MetricsFlushInterval:   time.Second,
+				Metrics:                validMetric(func(*MetricInfo) {}),
+			},
+			expect: `invalid aggregation_temporality: "AGGREGATION_TEMPORALITY_UNSPECIFIED"`,
+		},
+		{
+			name: "invalid_flush_interval",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				Metrics:                validMetric(func(*MetricInfo) {}),
+			},
+			expect: "invalid metrics_flush_interval: 0s, the interval should be positive",
+		},
+		{
+			name: "missing_metric_name",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics:                map[string]MetricInfo{"": {Type: metricTypeSum, Value: "body"}},
+			},
+			expect: "metrics: metric name missing",
+		},
+		{
+			name: "invalid_type",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics:                validMetric(func(info *MetricInfo) { info.Type = "summary" }),
+			},
+			expect: `metric "my.metric": invalid type "summary"`,
+		},
+		{
+			name: "missing_value",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics:                validMetric(func(info *MetricInfo) { info.Value = "" }),
+			},
+			expect: `metric "my.metric": value missing`,
+		},
+		{
+			name: "invalid_value",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics:                validMetric(func(info *MetricInfo) { info.Value = "invalid value" }),
+			},
+			expect: `metric "my.metric": value: unable to parse OTTL statement`,
+		},
+		{
+			name: "invalid_pattern",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics:                validMetric(func(info *MetricInfo) { info.Pattern = "took (" }),
+			},
+			expect: `metric "my.metric": pattern: error parsing regexp`,
+		},
+		{
+			name: "pattern_without_capture_group",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics:                validMetric(func(info *MetricInfo) { info.Pattern = `took \d+ms` }),
+			},
+			expect: `metric "my.metric": pattern must contain a capture group`,
+		},
+		{
+			name: "invalid_condition",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics:                validMetric(func(info *MetricInfo) { info.Conditions = []string{"invalid condition"} }),
+			},
+			expect: `metric "my.metric": condition: unable to parse OTTL condition`,
+		},
+		{
+			name: "duplicate_dimension",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics: validMetric(func(info *MetricInfo) {
+					info.Dimensions = []Dimension{{Name: "http.method"}, {Name: "http.method"}}
+				}),
+			},
+			expect: `metric "my.metric": duplicate dimension name http.method`,
+		},
+		{
+			name: "unsorted_buckets",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics: validMetric(func(info *MetricInfo) {
+					info.Type = metricTypeHistogram
+					info.Buckets = []float64{100, 10}
+				}),
+			},
+			expect: `metric "my.metric": buckets must be sorted in ascending order`,
+		},
+		{
+			name: "invalid_dimensions_cache_size",
+			input: &Config{
+				AggregationTemporality: cumulative,
+				MetricsFlushInterval:   time.Second,
+				Metrics:                validMetric(func(*MetricInfo) {}),
+			},
+			expect: "invalid cache size: 0, the maximum number of the items in the cache should be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.input.Validate()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expect)
+		})
+	}
+}
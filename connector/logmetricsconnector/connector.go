@@ -0,0 +1,500 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/tilinna/clock"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const (
+	scopeName = "otelcol/logmetricsconnector"
+
+	defaultDimensionsCacheSize = 1000
+)
+
+type connectorImp struct {
+	lock   sync.Mutex
+	logger *zap.Logger
+	config Config
+
+	metricsConsumer consumer.Metrics
+
+	// Metric definitions, sorted by name.
+	metrics []*metricDef
+
+	// The starting time of the data points.
+	startTimestamp pcommon.Timestamp
+
+	// Aggregated data points, keyed by the hash of the resource attributes.
+	resources map[[16]byte]*resourceMetrics
+
+	// The keys of the aggregated data points, by recency of update. The evicted data
+	// points are still emitted on the next flush, and dropped afterwards.
+	dataPointKeys *simplelru.LRU
+	evictedKeys   map[dataPointKey]struct{}
+
+	ticker  *clock.Ticker
+	done    chan struct{}
+	started bool
+
+	shutdownOnce sync.Once
+}
+
+// metricDef is the parsed form of a configured metric.
+type metricDef struct {
+	name string
+	info MetricInfo
+
+	// A nil expression matches every log record.
+	matchExpr  expr.BoolExpr[ottllog.TransformContext]
+	value      *ottl.Statement[ottllog.TransformContext]
+	pattern    *regexp.Regexp
+	dimensions []dimension
+	bounds     []float64
+}
+
+type dimension struct {
+	name  string
+	value *pcommon.Value
+}
+
+func newDimensions(cfgDims []Dimension) []dimension {
+	if len(cfgDims) == 0 {
+		return nil
+	}
+	dims := make([]dimension, len(cfgDims))
+	for i := range cfgDims {
+		dims[i].name = cfgDims[i].Name
+		if cfgDims[i].Default != nil {
+			val := pcommon.NewValueStr(*cfgDims[i].Default)
+			dims[i].value = &val
+		}
+	}
+	return dims
+}
+
+type resourceMetrics struct {
+	attributes pcommon.Map
+	// Data points keyed by metric name, then by the hash of the data point attributes.
+	dataPoints map[string]map[[16]byte]*dataPoint
+}
+
+// dataPointKey identifies a data point across the resources and the metrics.
+type dataPointKey struct {
+	resource   [16]byte
+	metric     string
+	attributes [16]byte
+}
+
+type dataPoint struct {
+	attributes pcommon.Map
+
+	count        uint64
+	sum          float64
+	last         float64
+	bucketCounts []uint64
+}
+
+// observe records a measurement according to the type of the metric.
+func (dp *dataPoint) observe(def *metricDef, value float64) {
+	dp.count++
+	dp.sum += value
+	dp.last = value
+	if def.info.Type == metricTypeHistogram {
+		// Binary search to find the value bucket index.
+		dp.bucketCounts[sort.SearchFloat64s(def.bounds, value)]++
+	}
+}
+
+func newConnector(logger *zap.Logger, config component.Config, ticker *clock.Ticker) (*connectorImp, error) {
+	cfg := config.(*Config)
+
+	parser, err := newLogParser(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(cfg.Metrics))
+	for name := range cfg.Metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	defs := make([]*metricDef, 0, len(names))
+	for _, name := range names {
+		info := cfg.Metrics[name]
+		def := &metricDef{
+			name:       name,
+			info:       info,
+			dimensions: newDimensions(info.Dimensions),
+			bounds:     defaultHistogramBuckets,
+		}
+		if info.Buckets != nil {
+			def.bounds = info.Buckets
+		}
+		// Errors checked in Config.Validate()
+		def.value, _ = parseValue(parser, info.Value)
+		if len(info.Conditions) > 0 {
+			def.matchExpr, _ = parser.ParseConditions(info.Conditions)
+		}
+		if info.Pattern != "" {
+			def.pattern = regexp.MustCompile(info.Pattern)
+		}
+		defs = append(defs, def)
+	}
+
+	evictedKeys := make(map[dataPointKey]struct{})
+	dataPointKeys, err := simplelru.NewLRU(cfg.DimensionsCacheSize, func(key any, _ any) {
+		evictedKeys[key.(dataPointKey)] = struct{}{}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectorImp{
+		logger:         logger,
+		config:         *cfg,
+		metrics:        defs,
+		startTimestamp: pcommon.NewTimestampFromTime(time.Now()),
+		resources:      make(map[[16]byte]*resourceMetrics),
+		dataPointKeys:  dataPointKeys,
+		evictedKeys:    evictedKeys,
+		ticker:         ticker,
+		done:           make(chan struct{}),
+	}, nil
+}
+
+// Start implements the component.Component interface.
+func (p *connectorImp) Start(ctx context.Context, _ component.Host) error {
+	p.logger.Info("Starting logmetricsconnector")
+
+	p.started = true
+	go func() {
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-p.ticker.C:
+				p.exportMetrics(ctx)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Shutdown implements the component.Component interface.
+func (p *connectorImp) Shutdown(context.Context) error {
+	p.shutdownOnce.Do(func() {
+		p.logger.Info("Shutting down logmetricsconnector")
+		if p.started {
+			p.logger.Info("Stopping ticker")
+			p.ticker.Stop()
+			p.done <- struct{}{}
+			p.started = false
+		}
+	})
+	return nil
+}
+
+// Capabilities implements the consumer interface.
+func (p *connectorImp) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeLogs implements the consumer.Logs interface.
+// It aggregates the values extracted from the log records, which are emitted on the next flush.
+// The log records failing to be evaluated are logged and skipped rather than returned as an error,
+// as the values of the other log records are already aggregated and a retry would count them twice.
+func (p *connectorImp) ConsumeLogs(ctx context.Context, logs plog.Logs) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var errors error
+	failed := 0
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		resourceLog := logs.ResourceLogs().At(i)
+		resourceAttrs := resourceLog.Resource().Attributes()
+
+		for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
+			scopeLog := resourceLog.ScopeLogs().At(j)
+
+			for k := 0; k < scopeLog.LogRecords().Len(); k++ {
+				logRecord := scopeLog.LogRecords().At(k)
+				lCtx := ottllog.NewTransformContext(logRecord, scopeLog.Scope(), resourceLog.Resource())
+
+				for _, def := range p.metrics {
+					value, ok, err := def.extract(ctx, lCtx)
+					if err != nil {
+						failed++
+						errors = multierr.Append(errors, fmt.Errorf("metric %q: %w", def.name, err))
+						continue
+					}
+					if !ok {
+						continue
+					}
+					p.getOrCreateDataPoint(def, resourceAttrs, logRecord.Attributes()).observe(def, value)
+				}
+			}
+		}
+	}
+	if errors != nil {
+		p.logger.Warn("Failed to extract values from log records", zap.Int("failed", failed), zap.Error(errors))
+	}
+	return nil
+}
+
+// extract returns the value of the metric for the log record. It returns false if
+// the log record does not match the conditions or no numeric value could be extracted.
+func (def *metricDef) extract(ctx context.Context, lCtx ottllog.TransformContext) (float64, bool, error) {
+	if def.matchExpr != nil {
+		match, err := def.matchExpr.Eval(ctx, lCtx)
+		if err != nil || !match {
+			return 0, false, err
+		}
+	}
+
+	raw, _, err := def.value.Execute(ctx, lCtx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if def.pattern != nil {
+		str, ok := toString(raw)
+		if !ok {
+			return 0, false, nil
+		}
+		matches := def.pattern.FindStringSubmatch(str)
+		if matches == nil {
+			return 0, false, nil
+		}
+		raw = matches[1]
+	}
+	return toFloat(raw)
+}
+
+func toString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+func toFloat(v interface{}) (float64, bool, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, true, nil
+	case int64:
+		return float64(v), true, nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, false, nil
+		}
+		return f, true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+func (p *connectorImp) getOrCreateDataPoint(def *metricDef, resourceAttrs pcommon.Map, logAttrs pcommon.Map) *dataPoint {
+	resourceKey := pdatautil.MapHash(resourceAttrs)
+	rm, ok := p.resources[resourceKey]
+	if !ok {
+		rm = &resourceMetrics{
+			attributes: pcommon.NewMap(),
+			dataPoints: make(map[string]map[[16]byte]*dataPoint),
+		}
+		resourceAttrs.CopyTo(rm.attributes)
+		p.resources[resourceKey] = rm
+	}
+
+	dps, ok := rm.dataPoints[def.name]
+	if !ok {
+		dps = make(map[[16]byte]*dataPoint)
+		rm.dataPoints[def.name] = dps
+	}
+
+	attrs := buildAttributes(def.dimensions, logAttrs, resourceAttrs)
+	attrsKey := pdatautil.MapHash(attrs)
+	key := dataPointKey{resource: resourceKey, metric: def.name, attributes: attrsKey}
+	p.dataPointKeys.Add(key, struct{}{})
+	delete(p.evictedKeys, key)
+
+	dp, ok := dps[attrsKey]
+	if !ok {
+		dp = &dataPoint{attributes: attrs}
+		if def.info.Type == metricTypeHistogram {
+			dp.bucketCounts = make([]uint64, len(def.bounds)+1)
+		}
+		dps[attrsKey] = dp
+	}
+	return dp
+}
+
+// buildAttributes returns the dimensions of a data point. The values are fetched from the log record
+// attributes, then from the resource attributes, falling back to the default value of the dimension.
+// Dimensions without a value are omitted.
+func buildAttributes(dimensions []dimension, logAttrs pcommon.Map, resourceAttrs pcommon.Map) pcommon.Map {
+	attrs := pcommon.NewMap()
+	attrs.EnsureCapacity(len(dimensions))
+	for _, d := range dimensions {
+		if v, ok := logAttrs.Get(d.name); ok {
+			v.CopyTo(attrs.PutEmpty(d.name))
+		} else if v, ok = resourceAttrs.Get(d.name); ok {
+			v.CopyTo(attrs.PutEmpty(d.name))
+		} else if d.value != nil {
+			d.value.CopyTo(attrs.PutEmpty(d.name))
+		}
+	}
+	return attrs
+}
+
+func (p *connectorImp) exportMetrics(ctx context.Context) {
+	p.lock.Lock()
+
+	if len(p.resources) == 0 {
+		p.lock.Unlock()
+		return
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	m := p.buildMetrics(now)
+
+	// If delta metrics, reset accumulated data
+	if p.config.GetAggregationTemporality() == pmetric.AggregationTemporalityDelta {
+		p.resources = make(map[[16]byte]*resourceMetrics)
+		p.dataPointKeys.Purge()
+		p.startTimestamp = now
+	} else {
+		p.removeEvictedDataPoints()
+	}
+	for key := range p.evictedKeys {
+		delete(p.evictedKeys, key)
+	}
+
+	// This component no longer needs to read the metrics once built, so it is safe to unlock.
+	p.lock.Unlock()
+
+	if err := p.metricsConsumer.ConsumeMetrics(ctx, m); err != nil {
+		p.logger.Error("Failed ConsumeMetrics", zap.Error(err))
+		return
+	}
+}
+
+// removeEvictedDataPoints drops the data points evicted from the cache of keys,
+// along with the metrics and resources left without data points.
+func (p *connectorImp) removeEvictedDataPoints() {
+	for key := range p.evictedKeys {
+		rm, ok := p.resources[key.resource]
+		if !ok {
+			continue
+		}
+		dps := rm.dataPoints[key.metric]
+		delete(dps, key.attributes)
+		if len(dps) == 0 {
+			delete(rm.dataPoints, key.metric)
+		}
+		if len(rm.dataPoints) == 0 {
+			delete(p.resources, key.resource)
+		}
+	}
+}
+
+// buildMetrics builds the metrics object from the aggregated data points.
+func (p *connectorImp) buildMetrics(timestamp pcommon.Timestamp) pmetric.Metrics {
+	m := pmetric.NewMetrics()
+	temporality := p.config.GetAggregationTemporality()
+	for _, rm := range p.resources {
+		resourceMetrics := m.ResourceMetrics().AppendEmpty()
+		rm.attributes.CopyTo(resourceMetrics.Resource().Attributes())
+		scopeMetrics := resourceMetrics.ScopeMetrics().AppendEmpty()
+		scopeMetrics.Scope().SetName(scopeName)
+
+		for _, def := range p.metrics {
+			dps, ok := rm.dataPoints[def.name]
+			if !ok {
+				continue
+			}
+			metric := scopeMetrics.Metrics().AppendEmpty()
+			metric.SetName(def.name)
+			metric.SetDescription(def.info.Description)
+			metric.SetUnit(def.info.Unit)
+
+			switch def.info.Type {
+			case metricTypeSum:
+				sum := metric.SetEmptySum()
+				sum.SetAggregationTemporality(temporality)
+				sum.SetIsMonotonic(def.info.Monotonic)
+				for _, dp := range dps {
+					ndp := sum.DataPoints().AppendEmpty()
+					dp.attributes.CopyTo(ndp.Attributes())
+					ndp.SetStartTimestamp(p.startTimestamp)
+					ndp.SetTimestamp(timestamp)
+					ndp.SetDoubleValue(dp.sum)
+				}
+			case metricTypeGauge:
+				gauge := metric.SetEmptyGauge()
+				for _, dp := range dps {
+					ndp := gauge.DataPoints().AppendEmpty()
+					dp.attributes.CopyTo(ndp.Attributes())
+					ndp.SetTimestamp(timestamp)
+					ndp.SetDoubleValue(dp.last)
+				}
+			case metricTypeHistogram:
+				histogram := metric.SetEmptyHistogram()
+				histogram.SetAggregationTemporality(temporality)
+				for _, dp := range dps {
+					hdp := histogram.DataPoints().AppendEmpty()
+					dp.attributes.CopyTo(hdp.Attributes())
+					hdp.SetStartTimestamp(p.startTimestamp)
+					hdp.SetTimestamp(timestamp)
+					hdp.SetCount(dp.count)
+					hdp.SetSum(dp.sum)
+					hdp.ExplicitBounds().FromRaw(def.bounds)
+					hdp.BucketCounts().FromRaw(dp.bucketCounts)
+				}
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,287 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tilinna/clock"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+func testConfig(aggregationTemporality string) *Config {
+	unknown := "unknown"
+	none := "none"
+	return &Config{
+		AggregationTemporality: aggregationTemporality,
+		DimensionsCacheSize:    defaultDimensionsCacheSize,
+		MetricsFlushInterval:   time.Minute,
+		Metrics: map[string]MetricInfo{
+			"http.request.duration": {
+				Description: "Duration of HTTP requests.",
+				Unit:        "ms",
+				Type:        metricTypeHistogram,
+				Value:       `attributes["duration_ms"]`,
+				Conditions:  []string{`attributes["http.method"] != nil`},
+				Dimensions: []Dimension{
+					{Name: "http.method"},
+					{Name: "http.route", Default: &unknown},
+				},
+				Buckets: []float64{10, 100, 1000},
+			},
+			"body.duration": {
+				Description: "Duration parsed from the log body.",
+				Unit:        "ms",
+				Type:        metricTypeSum,
+				Value:       "body",
+				Pattern:     `took (\d+)ms`,
+				Monotonic:   true,
+				Dimensions:  []Dimension{{Name: "service.name"}},
+			},
+			"payload.size": {
+				Description: "Size of the last payload.",
+				Unit:        "By",
+				Type:        metricTypeGauge,
+				Value:       `attributes["bytes"]`,
+				Conditions:  []string{`attributes["bytes"] != nil`},
+				Dimensions:  []Dimension{{Name: "http.method", Default: &none}},
+			},
+		},
+	}
+}
+
+func TestLogsToMetrics(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         *Config
+		consumes    int
+		flushes     int
+		wantMetrics int
+	}{
+		{
+			name:        "cumulative",
+			cfg:         testConfig(cumulative),
+			consumes:    2,
+			flushes:     2,
+			wantMetrics: 2,
+		},
+		{
+			name:        "delta",
+			cfg:         testConfig(delta),
+			consumes:    2,
+			flushes:     2,
+			wantMetrics: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.cfg.Validate())
+
+			mockClock := clock.NewMock(time.Now())
+			ctx := clock.Context(context.Background(), mockClock)
+
+			factory := NewFactory()
+			sink := &consumertest.MetricsSink{}
+			conn, err := factory.CreateLogsToMetrics(ctx, connectortest.NewNopCreateSettings(), tc.cfg, sink)
+			require.NoError(t, err)
+			require.NotNil(t, conn)
+			assert.False(t, conn.Capabilities().MutatesData)
+
+			require.NoError(t, conn.Start(ctx, componenttest.NewNopHost()))
+			defer func() {
+				assert.NoError(t, conn.Shutdown(ctx))
+			}()
+
+			for i := 0; i < tc.consumes; i++ {
+				testLogs, err := golden.ReadLogs(filepath.Join("testdata", "logs", "input.json"))
+				require.NoError(t, err)
+				assert.NoError(t, conn.ConsumeLogs(ctx, testLogs))
+			}
+			assert.Equal(t, 0, len(sink.AllMetrics()))
+
+			for i := 0; i < tc.flushes; i++ {
+				mockClock.Add(tc.cfg.MetricsFlushInterval)
+				// The ticker is consumed asynchronously, so wait for the flush to be emitted.
+				if i < tc.wantMetrics {
+					want := i + 1
+					require.Eventually(t, func() bool {
+						return len(sink.AllMetrics()) == want
+					}, time.Second, time.Millisecond)
+				}
+			}
+
+			allMetrics := sink.AllMetrics()
+			require.Equal(t, tc.wantMetrics, len(allMetrics))
+
+			// golden.WriteMetrics(t, filepath.Join("testdata", "logs", tc.name+".json"), allMetrics[0])
+			expected, err := golden.ReadMetrics(filepath.Join("testdata", "logs", tc.name+".json"))
+			require.NoError(t, err)
+			for _, actual := range allMetrics {
+				assert.NoError(t, pmetrictest.CompareMetrics(expected, actual,
+					pmetrictest.IgnoreTimestamp(),
+					pmetrictest.IgnoreStartTimestamp(),
+					pmetrictest.IgnoreResourceMetricsOrder(),
+					pmetrictest.IgnoreMetricsOrder(),
+					pmetrictest.IgnoreMetricDataPointsOrder()))
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	cfg := testConfig(cumulative)
+	cfg.Metrics = map[string]MetricInfo{
+		"body.duration": cfg.Metrics["body.duration"],
+	}
+	require.NoError(t, cfg.Validate())
+
+	conn, err := newConnector(zap.NewNop(), cfg, clock.NewMock(time.Now()).NewTicker(time.Minute))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		body   string
+		want   float64
+		wantOK bool
+	}{
+		{body: "GET /cart took 12ms", want: 12, wantOK: true},
+		{body: "took 7ms and took 9ms", want: 7, wantOK: true},
+		{body: "cache warmed", wantOK: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.body, func(t *testing.T) {
+			logRecord := plog.NewLogRecord()
+			logRecord.Body().SetStr(tc.body)
+			lCtx := ottllog.NewTransformContext(logRecord, pcommon.NewInstrumentationScope(), pcommon.NewResource())
+
+			got, ok, err := conn.metrics[0].extract(context.Background(), lCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestConsumeLogsSkipsFailedLogRecords(t *testing.T) {
+	cfg := &Config{
+		AggregationTemporality: cumulative,
+		DimensionsCacheSize:    defaultDimensionsCacheSize,
+		MetricsFlushInterval:   time.Minute,
+		Metrics: map[string]MetricInfo{
+			"prefix.value": {
+				Type:  metricTypeSum,
+				Value: "Substring(body, 0, 2)",
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	core, observed := observer.New(zap.WarnLevel)
+	conn, err := newConnector(zap.New(core), cfg, clock.NewMock(time.Now()).NewTicker(time.Minute))
+	require.NoError(t, err)
+	sink := &consumertest.MetricsSink{}
+	conn.metricsConsumer = sink
+
+	logs := plog.NewLogs()
+	logRecords := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	logRecords.AppendEmpty().Body().SetStr("42 requests")
+	// The body is too short for the substring.
+	logRecords.AppendEmpty().Body().SetStr("4")
+	logRecords.AppendEmpty().Body().SetStr("08 requests")
+
+	// The failure isn't returned, so that the aggregated values aren't counted twice on retry.
+	require.NoError(t, conn.ConsumeLogs(context.Background(), logs))
+	require.Equal(t, 1, observed.Len())
+	assert.Equal(t, int64(1), observed.All()[0].ContextMap()["failed"])
+
+	conn.exportMetrics(context.Background())
+	require.Len(t, sink.AllMetrics(), 1)
+	dataPoints := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	require.Equal(t, 1, dataPoints.Len())
+	assert.Equal(t, float64(50), dataPoints.At(0).DoubleValue())
+}
+
+func TestEvictedDataPointsAreDroppedAfterFlush(t *testing.T) {
+	cfg := &Config{
+		AggregationTemporality: cumulative,
+		DimensionsCacheSize:    2,
+		MetricsFlushInterval:   time.Minute,
+		Metrics: map[string]MetricInfo{
+			"payload.size": {
+				Type:       metricTypeSum,
+				Value:      `attributes["bytes"]`,
+				Dimensions: []Dimension{{Name: "tenant"}},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	conn, err := newConnector(zap.NewNop(), cfg, clock.NewMock(time.Now()).NewTicker(time.Minute))
+	require.NoError(t, err)
+	sink := &consumertest.MetricsSink{}
+	conn.metricsConsumer = sink
+
+	consume := func(tenants ...string) {
+		logs := plog.NewLogs()
+		logRecords := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		for _, tenant := range tenants {
+			logRecord := logRecords.AppendEmpty()
+			logRecord.Attributes().PutStr("tenant", tenant)
+			logRecord.Attributes().PutInt("bytes", 1)
+		}
+		require.NoError(t, conn.ConsumeLogs(context.Background(), logs))
+	}
+	tenants := func(i int) []string {
+		var tenants []string
+		dataPoints := sink.AllMetrics()[i].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+		for j := 0; j < dataPoints.Len(); j++ {
+			tenant, _ := dataPoints.At(j).Attributes().Get("tenant")
+			tenants = append(tenants, tenant.Str())
+		}
+		return tenants
+	}
+
+	// The data point of acme is evicted, but still emitted on the next flush.
+	consume("acme", "globex", "initech")
+	conn.exportMetrics(context.Background())
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.ElementsMatch(t, []string{"acme", "globex", "initech"}, tenants(0))
+
+	// The data point of globex is evicted, and updated again before the flush.
+	consume("umbrella", "globex")
+	conn.exportMetrics(context.Background())
+	require.Len(t, sink.AllMetrics(), 2)
+	assert.ElementsMatch(t, []string{"globex", "initech", "umbrella"}, tenants(1))
+
+	conn.exportMetrics(context.Background())
+	require.Len(t, sink.AllMetrics(), 3)
+	assert.ElementsMatch(t, []string{"globex", "umbrella"}, tenants(2))
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logmetricsconnector"
+
+import (
+	"context"
+	"time"
+
+	"github.com/tilinna/clock"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+const (
+	// The value of "type" key in configuration.
+	typeStr = "logmetrics"
+	// The stability level of the connector.
+	stability = component.StabilityLevelDevelopment
+)
+
+// NewFactory creates a factory for the logmetrics connector.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		connector.WithLogsToMetrics(createLogsToMetricsConnector, stability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		AggregationTemporality: cumulative,
+		DimensionsCacheSize:    defaultDimensionsCacheSize,
+		MetricsFlushInterval:   15 * time.Second,
+	}
+}
+
+func createLogsToMetricsConnector(ctx context.Context, params connector.CreateSettings, cfg component.Config, nextConsumer consumer.Metrics) (connector.Logs, error) {
+	c, err := newConnector(params.Logger, cfg, metricsTicker(ctx, cfg))
+	if err != nil {
+		return nil, err
+	}
+	c.metricsConsumer = nextConsumer
+	return c, nil
+}
+
+func metricsTicker(ctx context.Context, cfg component.Config) *clock.Ticker {
+	return clock.FromContext(ctx).NewTicker(cfg.(*Config).MetricsFlushInterval)
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanlogsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanlogsconnector"
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// Config for the connector
+type Config struct {
+	// SpanEvents configures the log records emitted for span events.
+	SpanEvents SpanEventsConfig `mapstructure:"spanevents"`
+	// Spans configures the log records emitted for spans.
+	Spans SpansConfig `mapstructure:"spans"`
+
+	// CopySpanAttributes copies the attributes of the span onto each emitted log record.
+	CopySpanAttributes bool `mapstructure:"copy_span_attributes"`
+	// CopyEventAttributes copies the attributes of the span event onto the log record emitted for it.
+	CopyEventAttributes bool `mapstructure:"copy_event_attributes"`
+}
+
+// SpanEventsConfig for span events
+type SpanEventsConfig struct {
+	Enabled    bool     `mapstructure:"enabled"`
+	Conditions []string `mapstructure:"conditions"`
+}
+
+// SpansConfig for spans
+type SpansConfig struct {
+	Enabled    bool     `mapstructure:"enabled"`
+	Conditions []string `mapstructure:"conditions"`
+}
+
+func (c *Config) Validate() error {
+	if !c.SpanEvents.Enabled && !c.Spans.Enabled {
+		return fmt.Errorf("at least one of spanevents or spans must be enabled")
+	}
+	if len(c.SpanEvents.Conditions) > 0 {
+		parser, err := newSpanEventParser(zap.NewNop())
+		if err != nil {
+			return err
+		}
+		if _, err = parser.ParseConditions(c.SpanEvents.Conditions); err != nil {
+			return fmt.Errorf("spanevents condition: %w", err)
+		}
+	}
+	if len(c.Spans.Conditions) > 0 {
+		parser, err := newSpanParser(zap.NewNop())
+		if err != nil {
+			return err
+		}
+		if _, err = parser.ParseConditions(c.Spans.Conditions); err != nil {
+			return fmt.Errorf("spans condition: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanlogsconnector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect *Config
+	}{
+		{
+			name: "",
+			expect: &Config{
+				SpanEvents: SpanEventsConfig{
+					Enabled: true,
+				},
+				CopySpanAttributes:  true,
+				CopyEventAttributes: true,
+			},
+		},
+		{
+			name: "spans",
+			expect: &Config{
+				Spans: SpansConfig{
+					Enabled:    true,
+					Conditions: []string{`status.code == STATUS_CODE_ERROR`},
+				},
+				CopySpanAttributes:  true,
+				CopyEventAttributes: true,
+			},
+		},
+		{
+			name: "exceptions",
+			expect: &Config{
+				SpanEvents: SpanEventsConfig{
+					Enabled:    true,
+					Conditions: []string{`name == "exception"`},
+				},
+				CopyEventAttributes: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(component.NewIDWithName(typeStr, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  *Config
+		expect string
+	}{
+		{
+			name:   "nothing_enabled",
+			input:  &Config{},
+			expect: "at least one of spanevents or spans must be enabled",
+		},
+		{
+			name: "invalid_condition_span",
+			input: &Config{
+				Spans: SpansConfig{
+					Enabled:    true,
+					Conditions: []string{"invalid condition"},
+				},
+			},
+			expect: "spans condition: unable to parse OTTL condition",
+		},
+		{
+			name: "invalid_condition_spanevent",
+			input: &Config{
+				SpanEvents: SpanEventsConfig{
+					Enabled:    true,
+					Conditions: []string{"invalid condition"},
+				},
+			},
+			expect: "spanevents condition: unable to parse OTTL condition",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.input.Validate()
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expect)
+		})
+	}
+}
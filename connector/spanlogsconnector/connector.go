@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanlogsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanlogsconnector"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+)
+
+const scopeName = "otelcol/spanlogsconnector"
+
+// spanLogs emits a log record for each span event, and optionally
+// for each span, onto a logs pipeline.
+type spanLogs struct {
+	logger       *zap.Logger
+	logsConsumer consumer.Logs
+	component.StartFunc
+	component.ShutdownFunc
+
+	config *Config
+
+	// A nil expression matches everything.
+	spanEventsMatchExpr expr.BoolExpr[ottlspanevent.TransformContext]
+	spansMatchExpr      expr.BoolExpr[ottlspan.TransformContext]
+}
+
+func (c *spanLogs) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces implements the consumer.Traces interface.
+// The spans and span events failing to be evaluated are logged and skipped rather than returned
+// as an error, so that the log records of the other ones are still emitted.
+func (c *spanLogs) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	var errors error
+	failed := 0
+	logs := plog.NewLogs()
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		resourceSpan := td.ResourceSpans().At(i)
+
+		resourceLogs := logs.ResourceLogs().AppendEmpty()
+		resourceSpan.Resource().CopyTo(resourceLogs.Resource())
+		resourceLogs.SetSchemaUrl(resourceSpan.SchemaUrl())
+		scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+		scopeLogs.Scope().SetName(scopeName)
+		logRecords := scopeLogs.LogRecords()
+
+		for j := 0; j < resourceSpan.ScopeSpans().Len(); j++ {
+			scopeSpan := resourceSpan.ScopeSpans().At(j)
+
+			for k := 0; k < scopeSpan.Spans().Len(); k++ {
+				span := scopeSpan.Spans().At(k)
+
+				if c.config.Spans.Enabled {
+					sCtx := ottlspan.NewTransformContext(span, scopeSpan.Scope(), resourceSpan.Resource())
+					match, err := evalMatch(ctx, c.spansMatchExpr, sCtx)
+					if err != nil {
+						failed++
+						errors = multierr.Append(errors, err)
+					} else if match {
+						c.spanToLogRecord(span, logRecords.AppendEmpty())
+					}
+				}
+
+				if !c.config.SpanEvents.Enabled {
+					continue
+				}
+				for l := 0; l < span.Events().Len(); l++ {
+					event := span.Events().At(l)
+					eCtx := ottlspanevent.NewTransformContext(event, span, scopeSpan.Scope(), resourceSpan.Resource())
+					match, err := evalMatch(ctx, c.spanEventsMatchExpr, eCtx)
+					if err != nil {
+						failed++
+						errors = multierr.Append(errors, err)
+					} else if match {
+						c.spanEventToLogRecord(span, event, logRecords.AppendEmpty())
+					}
+				}
+			}
+		}
+	}
+	if errors != nil {
+		c.logger.Warn("Failed to evaluate the conditions of spans or span events", zap.Int("failed", failed), zap.Error(errors))
+	}
+
+	// Drop resources for which no log records were emitted.
+	logs.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		return rl.ScopeLogs().At(0).LogRecords().Len() == 0
+	})
+	if logs.ResourceLogs().Len() == 0 {
+		return nil
+	}
+	return c.logsConsumer.ConsumeLogs(ctx, logs)
+}
+
+func evalMatch[K any](ctx context.Context, matchExpr expr.BoolExpr[K], tCtx K) (bool, error) {
+	if matchExpr == nil {
+		return true, nil
+	}
+	return matchExpr.Eval(ctx, tCtx)
+}
+
+func (c *spanLogs) spanToLogRecord(span ptrace.Span, lr plog.LogRecord) {
+	setSpanContext(span, lr)
+	lr.SetTimestamp(span.StartTimestamp())
+	lr.Body().SetStr(span.Name())
+	if c.config.CopySpanAttributes {
+		span.Attributes().CopyTo(lr.Attributes())
+	}
+}
+
+func (c *spanLogs) spanEventToLogRecord(span ptrace.Span, event ptrace.SpanEvent, lr plog.LogRecord) {
+	setSpanContext(span, lr)
+	lr.SetTimestamp(event.Timestamp())
+	lr.Body().SetStr(event.Name())
+	if c.config.CopySpanAttributes {
+		span.Attributes().CopyTo(lr.Attributes())
+	}
+	if c.config.CopyEventAttributes {
+		// Event attributes take precedence over span attributes with the same key.
+		event.Attributes().Range(func(k string, v pcommon.Value) bool {
+			v.CopyTo(lr.Attributes().PutEmpty(k))
+			return true
+		})
+	}
+}
+
+// setSpanContext sets the trace context and the severity, derived from
+// the span status, of the log record.
+func setSpanContext(span ptrace.Span, lr plog.LogRecord) {
+	lr.SetTraceID(span.TraceID())
+	lr.SetSpanID(span.SpanID())
+	if span.Status().Code() == ptrace.StatusCodeError {
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+		lr.SetSeverityText("ERROR")
+		return
+	}
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetSeverityText("INFO")
+}
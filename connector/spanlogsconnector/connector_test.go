@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanlogsconnector
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+)
+
+func TestTracesToLogs(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  *Config
+	}{
+		{
+			name: "default",
+			cfg:  createDefaultConfig().(*Config),
+		},
+		{
+			name: "error_spans",
+			cfg: &Config{
+				Spans: SpansConfig{
+					Enabled:    true,
+					Conditions: []string{`status.code == STATUS_CODE_ERROR`},
+				},
+				CopySpanAttributes: true,
+			},
+		},
+		{
+			name: "exceptions",
+			cfg: &Config{
+				SpanEvents: SpanEventsConfig{
+					Enabled:    true,
+					Conditions: []string{`name == "exception"`},
+				},
+				CopyEventAttributes: true,
+			},
+		},
+		{
+			name: "spans_and_span_events",
+			cfg: &Config{
+				SpanEvents: SpanEventsConfig{
+					Enabled:    true,
+					Conditions: []string{`resource.attributes["resource-attr"] == "resource-attr-val-2"`},
+				},
+				Spans: SpansConfig{
+					Enabled: true,
+				},
+				CopySpanAttributes:  true,
+				CopyEventAttributes: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.cfg.Validate())
+			factory := NewFactory()
+			sink := &consumertest.LogsSink{}
+			conn, err := factory.CreateTracesToLogs(context.Background(),
+				connectortest.NewNopCreateSettings(), tc.cfg, sink)
+			require.NoError(t, err)
+			require.NotNil(t, conn)
+			assert.False(t, conn.Capabilities().MutatesData)
+
+			require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				assert.NoError(t, conn.Shutdown(context.Background()))
+			}()
+
+			testSpans, err := golden.ReadTraces(filepath.Join("testdata", "traces", "input.json"))
+			assert.NoError(t, err)
+			assert.NoError(t, conn.ConsumeTraces(context.Background(), testSpans))
+
+			allLogs := sink.AllLogs()
+			assert.Equal(t, 1, len(allLogs))
+
+			// golden.WriteLogs(t, filepath.Join("testdata", "traces", tc.name+".json"), allLogs[0])
+			expected, err := golden.ReadLogs(filepath.Join("testdata", "traces", tc.name+".json"))
+			assert.NoError(t, err)
+			assert.NoError(t, plogtest.CompareLogs(expected, allLogs[0]))
+		})
+	}
+}
+
+func TestTracesToLogsNoMatch(t *testing.T) {
+	cfg := &Config{
+		SpanEvents: SpanEventsConfig{
+			Enabled:    true,
+			Conditions: []string{`name == "does-not-exist"`},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	sink := &consumertest.LogsSink{}
+	conn, err := NewFactory().CreateTracesToLogs(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	testSpans, err := golden.ReadTraces(filepath.Join("testdata", "traces", "input.json"))
+	require.NoError(t, err)
+	assert.NoError(t, conn.ConsumeTraces(context.Background(), testSpans))
+	assert.Equal(t, 0, len(sink.AllLogs()))
+}
+
+func TestTracesToLogsSkipsFailedSpans(t *testing.T) {
+	cfg := &Config{
+		Spans: SpansConfig{
+			Enabled:    true,
+			Conditions: []string{`Substring(name, 0, 5) == "long-"`},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	core, observed := observer.New(zap.WarnLevel)
+	set := connectortest.NewNopCreateSettings()
+	set.TelemetrySettings.Logger = zap.New(core)
+	sink := &consumertest.LogsSink{}
+	conn, err := NewFactory().CreateTracesToLogs(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+
+	traces := ptrace.NewTraces()
+	spans := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	// The name is too short for the substring.
+	spans.AppendEmpty().SetName("ab")
+	spans.AppendEmpty().SetName("long-span")
+
+	require.NoError(t, conn.ConsumeTraces(context.Background(), traces))
+	require.Equal(t, 1, observed.Len())
+	assert.Equal(t, int64(1), observed.All()[0].ContextMap()["failed"])
+
+	require.Len(t, sink.AllLogs(), 1)
+	logRecords := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, logRecords.Len())
+	assert.Equal(t, "long-span", logRecords.At(0).Body().Str())
+}
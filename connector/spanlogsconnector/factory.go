@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanlogsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanlogsconnector"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+)
+
+const (
+	typeStr   = "spanlogs"
+	stability = component.StabilityLevelDevelopment
+)
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		connector.WithTracesToLogs(createTracesToLogs, stability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		SpanEvents: SpanEventsConfig{
+			Enabled: true,
+		},
+		CopySpanAttributes:  true,
+		CopyEventAttributes: true,
+	}
+}
+
+// createTracesToLogs creates a traces to logs connector based on provided config.
+func createTracesToLogs(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (connector.Traces, error) {
+	c := cfg.(*Config)
+
+	sl := &spanLogs{
+		logger:       set.TelemetrySettings.Logger,
+		logsConsumer: nextConsumer,
+		config:       c,
+	}
+
+	if len(c.SpanEvents.Conditions) > 0 {
+		spanEventParser, err := newSpanEventParser(set.TelemetrySettings.Logger)
+		if err != nil {
+			return nil, err
+		}
+		// Error checked in Config.Validate()
+		sl.spanEventsMatchExpr, _ = spanEventParser.ParseConditions(c.SpanEvents.Conditions)
+	}
+
+	if len(c.Spans.Conditions) > 0 {
+		spanParser, err := newSpanParser(set.TelemetrySettings.Logger)
+		if err != nil {
+			return nil, err
+		}
+		// Error checked in Config.Validate()
+		sl.spansMatchExpr, _ = spanParser.ParseConditions(c.Spans.Conditions)
+	}
+
+	return sl, nil
+}
@@ -36,6 +36,18 @@ type Config struct {
 	// The name of the kafka topic to export to (default otlp_spans for traces, otlp_metrics for metrics)
 	Topic string `mapstructure:"topic"`
 
+	// TopicFromAttribute is the name of a resource attribute holding the topic
+	// to export each resource to. Resources without the attribute are exported
+	// to Topic.
+	TopicFromAttribute string `mapstructure:"topic_from_attribute"`
+
+	// PartitionBy sets the key of produced messages so that related data lands
+	// on the same partition. The options are:
+	//   "" -> messages are produced without a key ( default )
+	//   "trace_id" -> traces are keyed by trace ID, only valid for traces.
+	//   "resource" -> messages are keyed by a hash of the resource attributes.
+	PartitionBy string `mapstructure:"partition_by"`
+
 	// Encoding of messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 
@@ -111,6 +123,12 @@ func (cfg *Config) Validate() error {
 		return err
 	}
 
+	switch cfg.PartitionBy {
+	case "", partitionByTraceID, partitionByResource:
+	default:
+		return fmt.Errorf("partition_by should be one of '%s' or '%s'. configured value %v", partitionByTraceID, partitionByResource, cfg.PartitionBy)
+	}
+
 	return nil
 }
 
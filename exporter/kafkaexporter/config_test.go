@@ -58,9 +58,11 @@ func TestLoadConfig(t *testing.T) {
 					NumConsumers: 2,
 					QueueSize:    10,
 				},
-				Topic:    "spans",
-				Encoding: "otlp_proto",
-				Brokers:  []string{"foo:123", "bar:456"},
+				Topic:              "spans",
+				TopicFromAttribute: "kafka.topic",
+				PartitionBy:        "resource",
+				Encoding:           "otlp_proto",
+				Brokers:            []string{"foo:123", "bar:456"},
 				Authentication: Authentication{
 					PlainText: &PlainTextConfig{
 						Username: "jdoe",
@@ -156,3 +158,16 @@ func Test_saramaProducerCompressionCodec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate_err_partition_by(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression: "none",
+		},
+		PartitionBy: "span_id",
+	}
+
+	err := config.Validate()
+	assert.Error(t, err)
+	assert.Equal(t, err.Error(), "partition_by should be one of 'trace_id' or 'resource'. configured value span_id")
+}
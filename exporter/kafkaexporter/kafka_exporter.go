@@ -28,12 +28,15 @@ import (
 	"go.uber.org/zap"
 )
 
-var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
+var (
+	errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
+	errPartitionByTraceID   = fmt.Errorf("partition_by '%s' is only supported for traces", partitionByTraceID)
+)
 
 // kafkaTracesProducer uses sarama to produce trace messages to Kafka.
 type kafkaTracesProducer struct {
 	producer  sarama.SyncProducer
-	topic     string
+	router    router
 	marshaler TracesMarshaler
 	logger    *zap.Logger
 }
@@ -48,11 +51,16 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for _, batch := range e.router.splitTraces(td) {
+		batchMessages, err := e.marshaler.Marshal(batch.traces, batch.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		setKey(batchMessages, batch.key)
+		messages = append(messages, batchMessages...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -72,17 +80,22 @@ func (e *kafkaTracesProducer) Close(context.Context) error {
 // kafkaMetricsProducer uses sarama to produce metrics messages to kafka
 type kafkaMetricsProducer struct {
 	producer  sarama.SyncProducer
-	topic     string
+	router    router
 	marshaler MetricsMarshaler
 	logger    *zap.Logger
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for _, batch := range e.router.splitMetrics(md) {
+		batchMessages, err := e.marshaler.Marshal(batch.metrics, batch.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		setKey(batchMessages, batch.key)
+		messages = append(messages, batchMessages...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -102,17 +115,22 @@ func (e *kafkaMetricsProducer) Close(context.Context) error {
 // kafkaLogsProducer uses sarama to produce logs messages to kafka
 type kafkaLogsProducer struct {
 	producer  sarama.SyncProducer
-	topic     string
+	router    router
 	marshaler LogsMarshaler
 	logger    *zap.Logger
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for _, batch := range e.router.splitLogs(ld) {
+		batchMessages, err := e.marshaler.Marshal(batch.logs, batch.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		setKey(batchMessages, batch.key)
+		messages = append(messages, batchMessages...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -173,6 +191,9 @@ func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers m
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	if config.PartitionBy == partitionByTraceID {
+		return nil, errPartitionByTraceID
+	}
 	producer, err := newSaramaProducer(config)
 	if err != nil {
 		return nil, err
@@ -180,7 +201,7 @@ func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers m
 
 	return &kafkaMetricsProducer{
 		producer:  producer,
-		topic:     config.Topic,
+		router:    newRouter(config),
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
@@ -199,7 +220,7 @@ func newTracesExporter(config Config, set exporter.CreateSettings, marshalers ma
 	}
 	return &kafkaTracesProducer{
 		producer:  producer,
-		topic:     config.Topic,
+		router:    newRouter(config),
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
@@ -210,6 +231,9 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	if config.PartitionBy == partitionByTraceID {
+		return nil, errPartitionByTraceID
+	}
 	producer, err := newSaramaProducer(config)
 	if err != nil {
 		return nil, err
@@ -217,7 +241,7 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 
 	return &kafkaLogsProducer{
 		producer:  producer,
-		topic:     config.Topic,
+		router:    newRouter(config),
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
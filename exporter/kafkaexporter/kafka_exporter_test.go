@@ -89,6 +89,20 @@ func TestNewLogsExporter_err_traces_encoding(t *testing.T) {
 	assert.Nil(t, mexp)
 }
 
+func TestNewMetricsExporter_err_partition_by_trace_id(t *testing.T) {
+	c := Config{Encoding: defaultEncoding, PartitionBy: partitionByTraceID}
+	mexp, err := newMetricsExporter(c, exportertest.NewNopCreateSettings(), metricsMarshalers())
+	assert.ErrorIs(t, err, errPartitionByTraceID)
+	assert.Nil(t, mexp)
+}
+
+func TestNewLogsExporter_err_partition_by_trace_id(t *testing.T) {
+	c := Config{Encoding: defaultEncoding, PartitionBy: partitionByTraceID}
+	lexp, err := newLogsExporter(c, exportertest.NewNopCreateSettings(), logsMarshalers())
+	assert.ErrorIs(t, err, errPartitionByTraceID)
+	assert.Nil(t, lexp)
+}
+
 func TestNewExporter_err_auth_type(t *testing.T) {
 	c := Config{
 		ProtocolVersion: "2.0.0",
@@ -151,6 +165,52 @@ func TestTracesPusher(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTracesPusher_routing(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	expectMessage := func(topic string, key string) {
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+			if msg.Topic != topic {
+				return fmt.Errorf("unexpected topic %q, expected %q", msg.Topic, topic)
+			}
+			msgKey, err := msg.Key.Encode()
+			if err != nil {
+				return err
+			}
+			if string(msgKey) != key {
+				return fmt.Errorf("unexpected key %q, expected %q", msgKey, key)
+			}
+			return nil
+		})
+	}
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("kafka.topic", "checkout_spans")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetTraceID([16]byte{1})
+	spans.AppendEmpty().SetTraceID([16]byte{2})
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetTraceID([16]byte{1})
+
+	expectMessage("checkout_spans", "01000000000000000000000000000000")
+	expectMessage("checkout_spans", "02000000000000000000000000000000")
+	expectMessage("otlp_spans", "01000000000000000000000000000000")
+
+	p := kafkaTracesProducer{
+		producer: producer,
+		router: newRouter(Config{
+			Topic:              defaultTracesTopic,
+			TopicFromAttribute: "kafka.topic",
+			PartitionBy:        partitionByTraceID,
+		}),
+		marshaler: newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	require.NoError(t, p.tracesPusher(context.Background(), td))
+}
+
 func TestTracesPusher_err(t *testing.T) {
 	c := sarama.NewConfig()
 	producer := mocks.NewSyncProducer(t, c)
@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"encoding/hex"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const (
+	partitionByTraceID  = "trace_id"
+	partitionByResource = "resource"
+)
+
+// destination identifies the topic and message key a part of a batch is
+// produced with.
+type destination struct {
+	topic string
+	key   string
+}
+
+// router decides the destination of each resource of a batch.
+type router struct {
+	topic              string
+	topicFromAttribute string
+	partitionBy        string
+}
+
+func newRouter(config Config) router {
+	return router{
+		topic:              config.Topic,
+		topicFromAttribute: config.TopicFromAttribute,
+		partitionBy:        config.PartitionBy,
+	}
+}
+
+// isStatic returns true when every resource goes to the same topic without a
+// key, so batches can be marshaled without being split.
+func (r router) isStatic() bool {
+	return r.topicFromAttribute == "" && r.partitionBy == ""
+}
+
+func (r router) topicFor(resource pcommon.Resource) string {
+	if r.topicFromAttribute != "" {
+		if v, ok := resource.Attributes().Get(r.topicFromAttribute); ok && v.AsString() != "" {
+			return v.AsString()
+		}
+	}
+	return r.topic
+}
+
+func (r router) destinationFor(resource pcommon.Resource) destination {
+	d := destination{topic: r.topicFor(resource)}
+	if r.partitionBy == partitionByResource {
+		hash := pdatautil.MapHash(resource.Attributes())
+		d.key = hex.EncodeToString(hash[:])
+	}
+	return d
+}
+
+type tracesBatch struct {
+	destination
+	traces ptrace.Traces
+}
+
+// splitTraces splits td by destination, keeping the order in which
+// destinations are first seen.
+func (r router) splitTraces(td ptrace.Traces) []tracesBatch {
+	if r.isStatic() {
+		return []tracesBatch{{destination: destination{topic: r.topic}, traces: td}}
+	}
+
+	var batches []tracesBatch
+	indexes := map[destination]int{}
+	appendTo := func(d destination, rs ptrace.ResourceSpans) {
+		idx, ok := indexes[d]
+		if !ok {
+			idx = len(batches)
+			indexes[d] = idx
+			batches = append(batches, tracesBatch{destination: d, traces: ptrace.NewTraces()})
+		}
+		rs.CopyTo(batches[idx].traces.ResourceSpans().AppendEmpty())
+	}
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		d := r.destinationFor(rs.Resource())
+		if r.partitionBy != partitionByTraceID {
+			appendTo(d, rs)
+			continue
+		}
+
+		single := ptrace.NewTraces()
+		rs.CopyTo(single.ResourceSpans().AppendEmpty())
+		for _, trace := range batchpersignal.SplitTraces(single) {
+			traceRS := trace.ResourceSpans().At(0)
+			d.key = traceRS.ScopeSpans().At(0).Spans().At(0).TraceID().String()
+			appendTo(d, traceRS)
+		}
+	}
+	return batches
+}
+
+type metricsBatch struct {
+	destination
+	metrics pmetric.Metrics
+}
+
+// splitMetrics splits md by destination, keeping the order in which
+// destinations are first seen.
+func (r router) splitMetrics(md pmetric.Metrics) []metricsBatch {
+	if r.isStatic() {
+		return []metricsBatch{{destination: destination{topic: r.topic}, metrics: md}}
+	}
+
+	var batches []metricsBatch
+	indexes := map[destination]int{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		d := r.destinationFor(rm.Resource())
+		idx, ok := indexes[d]
+		if !ok {
+			idx = len(batches)
+			indexes[d] = idx
+			batches = append(batches, metricsBatch{destination: d, metrics: pmetric.NewMetrics()})
+		}
+		rm.CopyTo(batches[idx].metrics.ResourceMetrics().AppendEmpty())
+	}
+	return batches
+}
+
+type logsBatch struct {
+	destination
+	logs plog.Logs
+}
+
+// splitLogs splits ld by destination, keeping the order in which
+// destinations are first seen.
+func (r router) splitLogs(ld plog.Logs) []logsBatch {
+	if r.isStatic() {
+		return []logsBatch{{destination: destination{topic: r.topic}, logs: ld}}
+	}
+
+	var batches []logsBatch
+	indexes := map[destination]int{}
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		d := r.destinationFor(rl.Resource())
+		idx, ok := indexes[d]
+		if !ok {
+			idx = len(batches)
+			indexes[d] = idx
+			batches = append(batches, logsBatch{destination: d, logs: plog.NewLogs()})
+		}
+		rl.CopyTo(batches[idx].logs.ResourceLogs().AppendEmpty())
+	}
+	return batches
+}
+
+// setKey sets the key of messages which weren't already keyed by their
+// marshaler.
+func setKey(messages []*sarama.ProducerMessage, key string) {
+	if key == "" {
+		return
+	}
+	for _, m := range messages {
+		if m.Key == nil {
+			m.Key = sarama.StringEncoder(key)
+		}
+	}
+}
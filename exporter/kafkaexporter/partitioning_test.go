@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaexporter
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestRouterStatic(t *testing.T) {
+	r := router{topic: "otlp_spans"}
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("kafka.topic", "ignored")
+
+	batches := r.splitTraces(td)
+	require.Len(t, batches, 1)
+	assert.Equal(t, destination{topic: "otlp_spans"}, batches[0].destination)
+	assert.Equal(t, td, batches[0].traces)
+}
+
+func TestRouterSplitTracesByTopic(t *testing.T) {
+	r := router{topic: "otlp_spans", topicFromAttribute: "kafka.topic"}
+	td := ptrace.NewTraces()
+	for _, topic := range []string{"a", "", "b", "a"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		if topic != "" {
+			rs.Resource().Attributes().PutStr("kafka.topic", topic)
+		}
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(topic)
+	}
+
+	batches := r.splitTraces(td)
+	require.Len(t, batches, 3)
+	assert.Equal(t, destination{topic: "a"}, batches[0].destination)
+	assert.Equal(t, 2, batches[0].traces.ResourceSpans().Len())
+	assert.Equal(t, destination{topic: "otlp_spans"}, batches[1].destination)
+	assert.Equal(t, 1, batches[1].traces.ResourceSpans().Len())
+	assert.Equal(t, destination{topic: "b"}, batches[2].destination)
+	assert.Equal(t, 1, batches[2].traces.ResourceSpans().Len())
+}
+
+func TestRouterSplitTracesByTraceID(t *testing.T) {
+	r := router{topic: "otlp_spans", partitionBy: partitionByTraceID}
+	td := ptrace.NewTraces()
+	for i := 0; i < 2; i++ {
+		spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+		spans.AppendEmpty().SetTraceID([16]byte{1})
+		spans.AppendEmpty().SetTraceID([16]byte{2})
+	}
+
+	batches := r.splitTraces(td)
+	require.Len(t, batches, 2)
+	assert.Equal(t, "01000000000000000000000000000000", batches[0].key)
+	assert.Equal(t, 2, batches[0].traces.SpanCount())
+	assert.Equal(t, "02000000000000000000000000000000", batches[1].key)
+	assert.Equal(t, 2, batches[1].traces.SpanCount())
+}
+
+func TestRouterSplitMetricsByResource(t *testing.T) {
+	r := router{topic: "otlp_metrics", partitionBy: partitionByResource}
+	md := pmetric.NewMetrics()
+	for _, service := range []string{"cart", "checkout", "cart"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", service)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName(service)
+	}
+
+	batches := r.splitMetrics(md)
+	require.Len(t, batches, 2)
+	assert.Equal(t, 2, batches[0].metrics.ResourceMetrics().Len())
+	assert.Equal(t, 1, batches[1].metrics.ResourceMetrics().Len())
+	assert.Len(t, batches[0].key, 32)
+	assert.NotEqual(t, batches[0].key, batches[1].key)
+}
+
+func TestRouterSplitLogsByTopicAndResource(t *testing.T) {
+	r := router{topic: "otlp_logs", topicFromAttribute: "kafka.topic", partitionBy: partitionByResource}
+	ld := plog.NewLogs()
+	for _, service := range []string{"cart", "checkout"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		rl.Resource().Attributes().PutStr("kafka.topic", "app_logs")
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	}
+
+	batches := r.splitLogs(ld)
+	require.Len(t, batches, 2)
+	for _, batch := range batches {
+		assert.Equal(t, "app_logs", batch.topic)
+		assert.Equal(t, 1, batch.logs.LogRecordCount())
+	}
+	assert.NotEqual(t, batches[0].key, batches[1].key)
+}
+
+func TestSetKey(t *testing.T) {
+	keyed := &sarama.ProducerMessage{Key: sarama.StringEncoder("existing")}
+	unkeyed := &sarama.ProducerMessage{}
+
+	setKey([]*sarama.ProducerMessage{keyed, unkeyed}, "")
+	assert.Nil(t, unkeyed.Key)
+
+	setKey([]*sarama.ProducerMessage{keyed, unkeyed}, "resource")
+	assert.Equal(t, sarama.StringEncoder("existing"), keyed.Key)
+	assert.Equal(t, sarama.StringEncoder("resource"), unkeyed.Key)
+}
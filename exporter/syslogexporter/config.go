@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	networkTCP = "tcp"
+	networkUDP = "udp"
+
+	protocolRFC5424 = "rfc5424"
+	protocolRFC3164 = "rfc3164"
+)
+
+// Config defines configuration for the syslog exporter.
+type Config struct {
+	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	exporterhelper.RetrySettings   `mapstructure:"retry_on_failure"`
+
+	// Endpoint is the host:port of the syslog server.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Network is the transport used to reach the server, either "tcp" or "udp".
+	Network string `mapstructure:"network"`
+
+	// Protocol is the syslog message format, either "rfc5424" or "rfc3164".
+	Protocol string `mapstructure:"protocol"`
+
+	// EnableOctetCounting frames TCP messages with their length as described in
+	// RFC6587 section 3.4.1. When false, messages are terminated by a newline.
+	EnableOctetCounting bool `mapstructure:"enable_octet_counting"`
+
+	// Facility is used to compute the message priority when a log record
+	// doesn't carry a "facility" or "priority" attribute.
+	Facility int `mapstructure:"facility"`
+
+	// TLS enables TLS for TCP connections when set.
+	TLS *configtls.TLSClientSetting `mapstructure:"tls"`
+}
+
+// Validate checks if the exporter configuration is valid.
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return errors.New(`"endpoint" must be specified`)
+	}
+
+	switch c.Network {
+	case networkTCP:
+	case networkUDP:
+		if c.TLS != nil {
+			return errors.New(`"tls" is only supported with the "tcp" network`)
+		}
+	default:
+		return fmt.Errorf(`unsupported network %q, must be one of %q or %q`, c.Network, networkTCP, networkUDP)
+	}
+
+	switch c.Protocol {
+	case protocolRFC5424, protocolRFC3164:
+	default:
+		return fmt.Errorf(`unsupported protocol %q, must be one of %q or %q`, c.Protocol, protocolRFC5424, protocolRFC3164)
+	}
+
+	if c.Facility < 0 || c.Facility > 23 {
+		return fmt.Errorf(`"facility" must be between 0 and 23, got %d`, c.Facility)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultCfg := createDefaultConfig().(*Config)
+	defaultCfg.Endpoint = "syslog.example.com:514"
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewIDWithName(typeStr, ""),
+			expected: defaultCfg,
+		},
+		{
+			id: component.NewIDWithName(typeStr, "allsettings"),
+			expected: &Config{
+				TimeoutSettings: exporterhelper.TimeoutSettings{
+					Timeout: 10 * time.Second,
+				},
+				RetrySettings: exporterhelper.RetrySettings{
+					Enabled:             false,
+					InitialInterval:     99 * time.Second,
+					MaxInterval:         199 * time.Second,
+					MaxElapsedTime:      299 * time.Minute,
+					RandomizationFactor: backoff.DefaultRandomizationFactor,
+					Multiplier:          backoff.DefaultMultiplier,
+				},
+				QueueSettings: exporterhelper.QueueSettings{
+					Enabled:      false,
+					NumConsumers: 7,
+					QueueSize:    17,
+				},
+				Endpoint:            "syslog.example.com:6514",
+				Network:             networkTCP,
+				Protocol:            protocolRFC3164,
+				EnableOctetCounting: false,
+				Facility:            16,
+				TLS: &configtls.TLSClientSetting{
+					TLSSetting: configtls.TLSSetting{
+						CAFile: "ca.pem",
+					},
+					InsecureSkipVerify: true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		err    string
+	}{
+		{
+			name:   "missing endpoint",
+			modify: func(cfg *Config) { cfg.Endpoint = "" },
+			err:    `"endpoint" must be specified`,
+		},
+		{
+			name:   "unsupported network",
+			modify: func(cfg *Config) { cfg.Network = "unix" },
+			err:    `unsupported network "unix", must be one of "tcp" or "udp"`,
+		},
+		{
+			name: "tls over udp",
+			modify: func(cfg *Config) {
+				cfg.Network = networkUDP
+				cfg.TLS = &configtls.TLSClientSetting{}
+			},
+			err: `"tls" is only supported with the "tcp" network`,
+		},
+		{
+			name:   "unsupported protocol",
+			modify: func(cfg *Config) { cfg.Protocol = "rfc1234" },
+			err:    `unsupported protocol "rfc1234", must be one of "rfc5424" or "rfc3164"`,
+		},
+		{
+			name:   "facility out of range",
+			modify: func(cfg *Config) { cfg.Facility = 24 },
+			err:    `"facility" must be between 0 and 23, got 24`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "localhost:514"
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.err)
+		})
+	}
+}
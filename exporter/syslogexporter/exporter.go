@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+type syslogExporter struct {
+	config    *Config
+	logger    *zap.Logger
+	tlsConfig *tls.Config
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newSyslogExporter(config *Config, logger *zap.Logger) *syslogExporter {
+	return &syslogExporter{
+		config: config,
+		logger: logger,
+	}
+}
+
+func (se *syslogExporter) start(context.Context, component.Host) (err error) {
+	if se.config.TLS != nil {
+		se.tlsConfig, err = se.config.TLS.LoadTLSConfig()
+	}
+	return err
+}
+
+func (se *syslogExporter) shutdown(context.Context) error {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	return se.closeConn()
+}
+
+func (se *syslogExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				msg := newMessage(rl.Resource(), lrs.At(k), se.config.Facility)
+				if err := se.send(ctx, se.format(msg)); err != nil {
+					// Only the records that were not sent yet are retried.
+					return consumererror.NewLogs(err, remainingLogs(ld, i, j, k))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (se *syslogExporter) format(msg message) string {
+	if se.config.Protocol == protocolRFC3164 {
+		return msg.rfc3164()
+	}
+	return msg.rfc5424()
+}
+
+// send writes a single message, framing it for stream transports and
+// connecting first if there is no open connection.
+func (se *syslogExporter) send(ctx context.Context, msg string) error {
+	if se.conn == nil {
+		if err := se.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case se.config.Network == networkUDP:
+	case se.config.EnableOctetCounting:
+		msg = strconv.Itoa(len(msg)) + " " + msg
+	default:
+		msg += "\n"
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := se.conn.SetWriteDeadline(deadline); err != nil {
+			return se.failWrite(err)
+		}
+	}
+	if _, err := se.conn.Write([]byte(msg)); err != nil {
+		return se.failWrite(err)
+	}
+	return nil
+}
+
+func (se *syslogExporter) connect(ctx context.Context) (err error) {
+	dialer := &net.Dialer{}
+	if se.tlsConfig != nil {
+		se.conn, err = (&tls.Dialer{NetDialer: dialer, Config: se.tlsConfig}).DialContext(ctx, se.config.Network, se.config.Endpoint)
+	} else {
+		se.conn, err = dialer.DialContext(ctx, se.config.Network, se.config.Endpoint)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", se.config.Endpoint, err)
+	}
+	return nil
+}
+
+// failWrite drops the current connection so that the next attempt reconnects.
+func (se *syslogExporter) failWrite(err error) error {
+	if closeErr := se.closeConn(); closeErr != nil {
+		se.logger.Debug("Failed to close syslog connection", zap.Error(closeErr))
+	}
+	return fmt.Errorf("failed to write to %s: %w", se.config.Endpoint, err)
+}
+
+func (se *syslogExporter) closeConn() error {
+	if se.conn == nil {
+		return nil
+	}
+	err := se.conn.Close()
+	se.conn = nil
+	return err
+}
+
+// remainingLogs returns a copy of ld holding only the log records starting at
+// the given resource, scope and record indexes.
+func remainingLogs(ld plog.Logs, resourceIdx, scopeIdx, recordIdx int) plog.Logs {
+	remaining := plog.NewLogs()
+	rls := ld.ResourceLogs()
+	for i := resourceIdx; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		newRL := remaining.ResourceLogs().AppendEmpty()
+		rl.Resource().CopyTo(newRL.Resource())
+		newRL.SetSchemaUrl(rl.SchemaUrl())
+
+		sls := rl.ScopeLogs()
+		firstScope := 0
+		if i == resourceIdx {
+			firstScope = scopeIdx
+		}
+		for j := firstScope; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			newSL := newRL.ScopeLogs().AppendEmpty()
+			sl.Scope().CopyTo(newSL.Scope())
+			newSL.SetSchemaUrl(sl.SchemaUrl())
+
+			lrs := sl.LogRecords()
+			firstRecord := 0
+			if i == resourceIdx && j == scopeIdx {
+				firstRecord = recordIdx
+			}
+			for k := firstRecord; k < lrs.Len(); k++ {
+				lrs.At(k).CopyTo(newSL.LogRecords().AppendEmpty())
+			}
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func newTestLogs(bodies ...string) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("host.name", "web-1")
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, body := range bodies {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Attributes().PutStr("appname", "app")
+		lr.SetTimestamp(1678197909000000000)
+		lr.Body().SetStr(body)
+	}
+	return ld
+}
+
+func newTestExporter(t *testing.T, cfg *Config) *syslogExporter {
+	exp := newSyslogExporter(cfg, zap.NewNop())
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, exp.shutdown(context.Background())) })
+	return exp
+}
+
+func TestPushLogsTCP(t *testing.T) {
+	tests := []struct {
+		name           string
+		octetCounting  bool
+		expectedFrames []string
+	}{
+		{
+			name:          "octet counting",
+			octetCounting: true,
+			expectedFrames: []string{
+				"55 <14>1 2023-03-07T14:05:09.000000Z web-1 app - - - first",
+				"56 <14>1 2023-03-07T14:05:09.000000Z web-1 app - - - second",
+			},
+		},
+		{
+			name: "non-transparent framing",
+			expectedFrames: []string{
+				"<14>1 2023-03-07T14:05:09.000000Z web-1 app - - - first\n",
+				"<14>1 2023-03-07T14:05:09.000000Z web-1 app - - - second\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", "localhost:0")
+			require.NoError(t, err)
+			defer ln.Close()
+
+			received := make(chan string, 1)
+			go func() {
+				conn, acceptErr := ln.Accept()
+				if acceptErr != nil {
+					return
+				}
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				var data []byte
+				for len(data) < len(tt.expectedFrames[0])+len(tt.expectedFrames[1]) {
+					b, readErr := r.ReadByte()
+					if readErr != nil {
+						break
+					}
+					data = append(data, b)
+				}
+				received <- string(data)
+			}()
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = ln.Addr().String()
+			cfg.EnableOctetCounting = tt.octetCounting
+			exp := newTestExporter(t, cfg)
+
+			require.NoError(t, exp.pushLogs(context.Background(), newTestLogs("first", "second")))
+			assert.Equal(t, tt.expectedFrames[0]+tt.expectedFrames[1], <-received)
+		})
+	}
+}
+
+func TestPushLogsUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "localhost:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = conn.LocalAddr().String()
+	cfg.Network = networkUDP
+	cfg.Protocol = protocolRFC3164
+	exp := newTestExporter(t, cfg)
+
+	require.NoError(t, exp.pushLogs(context.Background(), newTestLogs("first", "second")))
+
+	buf := make([]byte, 1024)
+	for _, expected := range []string{
+		"<14>Mar  7 14:05:09 web-1 app: first",
+		"<14>Mar  7 14:05:09 web-1 app: second",
+	} {
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(buf[:n]))
+	}
+}
+
+func TestPushLogsConnectionFailure(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	endpoint := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	exp := newTestExporter(t, cfg)
+
+	err = exp.pushLogs(context.Background(), newTestLogs("first", "second"))
+	require.Error(t, err)
+
+	var logsErr consumererror.Logs
+	require.ErrorAs(t, err, &logsErr)
+	assert.Equal(t, 2, logsErr.GetLogs().LogRecordCount())
+}
+
+func TestRemainingLogs(t *testing.T) {
+	ld := newTestLogs("a", "b", "c")
+	sl := ld.ResourceLogs().At(0).ScopeLogs().AppendEmpty()
+	sl.LogRecords().AppendEmpty().Body().SetStr("d")
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("e")
+
+	remaining := remainingLogs(ld, 0, 0, 1)
+	assert.Equal(t, 4, remaining.LogRecordCount())
+	assert.Equal(t, "web-1", remaining.ResourceLogs().At(0).Resource().Attributes().AsRaw()["host.name"])
+	assert.Equal(t, "b", remaining.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	assert.Equal(t, "e", remaining.ResourceLogs().At(1).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
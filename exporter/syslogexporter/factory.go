@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	typeStr = "syslog"
+	// The stability level of the exporter.
+	stability = component.StabilityLevelDevelopment
+
+	defaultTimeout = 5 * time.Second
+	// defaultFacility is the "user-level messages" facility.
+	defaultFacility = 1
+)
+
+// NewFactory creates a factory for the syslog exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		exporter.WithLogs(createLogsExporter, stability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TimeoutSettings:     exporterhelper.TimeoutSettings{Timeout: defaultTimeout},
+		RetrySettings:       exporterhelper.NewDefaultRetrySettings(),
+		QueueSettings:       exporterhelper.NewDefaultQueueSettings(),
+		Network:             networkTCP,
+		Protocol:            protocolRFC5424,
+		EnableOctetCounting: true,
+		Facility:            defaultFacility,
+	}
+}
+
+func createLogsExporter(ctx context.Context, settings exporter.CreateSettings, cfg component.Config) (exporter.Logs, error) {
+	expCfg := cfg.(*Config)
+	exp := newSyslogExporter(expCfg, settings.Logger)
+
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		settings,
+		cfg,
+		exp.pushLogs,
+		exporterhelper.WithTimeout(expCfg.TimeoutSettings),
+		exporterhelper.WithRetry(expCfg.RetrySettings),
+		exporterhelper.WithQueue(expCfg.QueueSettings),
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithShutdown(exp.shutdown),
+	)
+}
@@ -0,0 +1,275 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// Attribute names match the fields produced by the stanza syslog parser so
+// that logs received by the syslog receiver can be forwarded unchanged.
+const (
+	priorityAttr       = "priority"
+	facilityAttr       = "facility"
+	severityAttr       = "severity"
+	hostnameAttr       = "hostname"
+	appnameAttr        = "appname"
+	procIDAttr         = "proc_id"
+	msgIDAttr          = "msg_id"
+	messageAttr        = "message"
+	structuredDataAttr = "structured_data"
+)
+
+const (
+	nilValue = "-"
+
+	// Maximum field lengths defined by RFC5424 section 6.
+	maxHostnameLen = 255
+	maxAppnameLen  = 48
+	maxProcIDLen   = 128
+	maxMsgIDLen    = 32
+
+	rfc5424Timestamp = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// message holds the fields of a single syslog message.
+type message struct {
+	priority       int
+	timestamp      time.Time
+	hostname       string
+	appname        string
+	procID         string
+	msgID          string
+	structuredData map[string]map[string]string
+	msg            string
+}
+
+// newMessage builds a syslog message from a log record and its resource.
+// Fields set by the stanza syslog parser take precedence over values derived
+// from the record itself.
+func newMessage(resource pcommon.Resource, lr plog.LogRecord, defaultFacility int) message {
+	attrs := lr.Attributes()
+	m := message{
+		timestamp:      recordTimestamp(lr),
+		hostname:       stringAttr(attrs, hostnameAttr),
+		appname:        stringAttr(attrs, appnameAttr),
+		procID:         stringAttr(attrs, procIDAttr),
+		msgID:          stringAttr(attrs, msgIDAttr),
+		structuredData: structuredData(attrs),
+	}
+
+	if m.hostname == "" {
+		m.hostname = stringAttr(resource.Attributes(), conventions.AttributeHostName)
+	}
+	if m.appname == "" {
+		m.appname = stringAttr(resource.Attributes(), conventions.AttributeServiceName)
+	}
+
+	if msg, ok := attrs.Get(messageAttr); ok {
+		m.msg = msg.AsString()
+	} else {
+		m.msg = lr.Body().AsString()
+	}
+
+	if priority, ok := intAttr(attrs, priorityAttr); ok && priority >= 0 && priority <= 191 {
+		m.priority = priority
+		return m
+	}
+
+	facility, ok := intAttr(attrs, facilityAttr)
+	if !ok || facility < 0 || facility > 23 {
+		facility = defaultFacility
+	}
+	severity, ok := intAttr(attrs, severityAttr)
+	if !ok || severity < 0 || severity > 7 {
+		severity = toSyslogSeverity(lr.SeverityNumber())
+	}
+	m.priority = facility*8 + severity
+	return m
+}
+
+// rfc5424 formats the message as described in RFC5424 section 6.
+func (m message) rfc5424() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%d>1 %s %s %s %s %s ",
+		m.priority,
+		m.timestamp.UTC().Format(rfc5424Timestamp),
+		headerField(m.hostname, maxHostnameLen),
+		headerField(m.appname, maxAppnameLen),
+		headerField(m.procID, maxProcIDLen),
+		headerField(m.msgID, maxMsgIDLen),
+	)
+	writeStructuredData(&b, m.structuredData)
+	if m.msg != "" {
+		b.WriteByte(' ')
+		b.WriteString(m.msg)
+	}
+	return b.String()
+}
+
+// rfc3164 formats the message as described in RFC3164 section 4.1. The
+// timestamp is always written in UTC since the format carries no zone.
+func (m message) rfc3164() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%d>%s %s ",
+		m.priority,
+		m.timestamp.UTC().Format(time.Stamp),
+		headerField(m.hostname, maxHostnameLen),
+	)
+	if m.appname != "" {
+		b.WriteString(headerField(m.appname, maxAppnameLen))
+		if m.procID != "" {
+			fmt.Fprintf(&b, "[%s]", headerField(m.procID, maxProcIDLen))
+		}
+		b.WriteString(": ")
+	}
+	b.WriteString(m.msg)
+	return b.String()
+}
+
+func writeStructuredData(b *strings.Builder, sd map[string]map[string]string) {
+	if len(sd) == 0 {
+		b.WriteString(nilValue)
+		return
+	}
+
+	ids := make([]string, 0, len(sd))
+	for id := range sd {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		params := sd[id]
+		names := make([]string, 0, len(params))
+		for name := range params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		b.WriteByte('[')
+		b.WriteString(id)
+		for _, name := range names {
+			fmt.Fprintf(b, " %s=\"%s\"", name, escapeParamValue(params[name]))
+		}
+		b.WriteByte(']')
+	}
+}
+
+var paramValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+func escapeParamValue(v string) string {
+	return paramValueEscaper.Replace(v)
+}
+
+// headerField returns the value of a header field, replacing empty values
+// with the nil value and characters that would break the header layout.
+func headerField(v string, maxLen int) string {
+	if v == "" {
+		return nilValue
+	}
+	v = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return '_'
+		}
+		return r
+	}, v)
+	if len(v) > maxLen {
+		v = v[:maxLen]
+	}
+	return v
+}
+
+func recordTimestamp(lr plog.LogRecord) time.Time {
+	if ts := lr.Timestamp(); ts != 0 {
+		return ts.AsTime()
+	}
+	if ts := lr.ObservedTimestamp(); ts != 0 {
+		return ts.AsTime()
+	}
+	return time.Now()
+}
+
+func stringAttr(attrs pcommon.Map, key string) string {
+	if v, ok := attrs.Get(key); ok {
+		return v.AsString()
+	}
+	return ""
+}
+
+func intAttr(attrs pcommon.Map, key string) (int, bool) {
+	v, ok := attrs.Get(key)
+	if !ok {
+		return 0, false
+	}
+	switch v.Type() {
+	case pcommon.ValueTypeInt:
+		return int(v.Int()), true
+	case pcommon.ValueTypeDouble:
+		return int(v.Double()), true
+	case pcommon.ValueTypeStr:
+		i, err := strconv.Atoi(v.Str())
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// structuredData reads the structured data map written by the stanza syslog
+// parser, which maps SD-IDs to maps of parameter names and values.
+func structuredData(attrs pcommon.Map) map[string]map[string]string {
+	v, ok := attrs.Get(structuredDataAttr)
+	if !ok || v.Type() != pcommon.ValueTypeMap {
+		return nil
+	}
+
+	sd := make(map[string]map[string]string, v.Map().Len())
+	v.Map().Range(func(id string, params pcommon.Value) bool {
+		elem := map[string]string{}
+		if params.Type() == pcommon.ValueTypeMap {
+			params.Map().Range(func(name string, value pcommon.Value) bool {
+				elem[name] = value.AsString()
+				return true
+			})
+		}
+		sd[id] = elem
+		return true
+	})
+	return sd
+}
+
+// toSyslogSeverity maps a log record severity to a syslog severity code.
+func toSyslogSeverity(sev plog.SeverityNumber) int {
+	switch {
+	case sev >= plog.SeverityNumberFatal:
+		return 2 // critical
+	case sev >= plog.SeverityNumberError:
+		return 3 // error
+	case sev >= plog.SeverityNumberWarn:
+		return 4 // warning
+	case sev >= plog.SeverityNumberInfo, sev == plog.SeverityNumberUnspecified:
+		return 6 // informational
+	default:
+		return 7 // debug
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+var testTime = time.Date(2023, time.March, 7, 14, 5, 9, 123456000, time.UTC)
+
+func newTestRecord() (pcommon.Resource, plog.LogRecord) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("host.name", "web-1")
+	resource.Attributes().PutStr("service.name", "checkout")
+
+	lr := plog.NewLogRecord()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(testTime))
+	lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	lr.Body().SetStr("payment declined")
+	return resource, lr
+}
+
+func TestNewMessageFromRecord(t *testing.T) {
+	resource, lr := newTestRecord()
+	msg := newMessage(resource, lr, defaultFacility)
+
+	assert.Equal(t, "<12>1 2023-03-07T14:05:09.123456Z web-1 checkout - - - payment declined", msg.rfc5424())
+	assert.Equal(t, "<12>Mar  7 14:05:09 web-1 checkout: payment declined", msg.rfc3164())
+}
+
+func TestNewMessageFromParsedAttributes(t *testing.T) {
+	resource, lr := newTestRecord()
+	attrs := lr.Attributes()
+	attrs.PutInt("priority", 86)
+	attrs.PutStr("hostname", "192.0.2.1")
+	attrs.PutStr("appname", "sshd")
+	attrs.PutStr("proc_id", "8449")
+	attrs.PutStr("msg_id", "ID47")
+	attrs.PutStr("message", "Accepted publickey for alice")
+	sd := attrs.PutEmptyMap("structured_data")
+	origin := sd.PutEmptyMap("origin@32473")
+	origin.PutStr("software", "sshd")
+	origin.PutStr("note", `a "quoted" \ value]`)
+	sd.PutEmptyMap("meta@32473").PutStr("sequence", "1")
+
+	msg := newMessage(resource, lr, defaultFacility)
+
+	assert.Equal(t,
+		`<86>1 2023-03-07T14:05:09.123456Z 192.0.2.1 sshd 8449 ID47 [meta@32473 sequence="1"][origin@32473 note="a \"quoted\" \\ value\]" software="sshd"] Accepted publickey for alice`,
+		msg.rfc5424())
+	assert.Equal(t, "<86>Mar  7 14:05:09 192.0.2.1 sshd[8449]: Accepted publickey for alice", msg.rfc3164())
+}
+
+func TestNewMessagePriority(t *testing.T) {
+	tests := []struct {
+		name     string
+		attrs    map[string]interface{}
+		severity plog.SeverityNumber
+		expected int
+	}{
+		{
+			name:     "default facility and severity",
+			expected: 14,
+		},
+		{
+			name:     "severity number",
+			severity: plog.SeverityNumberFatal,
+			expected: 10,
+		},
+		{
+			name:     "facility and severity attributes",
+			attrs:    map[string]interface{}{"facility": 4, "severity": 2},
+			severity: plog.SeverityNumberDebug,
+			expected: 34,
+		},
+		{
+			name:     "priority attribute",
+			attrs:    map[string]interface{}{"priority": "165", "facility": 4},
+			expected: 165,
+		},
+		{
+			name:     "invalid priority attribute",
+			attrs:    map[string]interface{}{"priority": 200},
+			severity: plog.SeverityNumberError,
+			expected: 11,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			assert.NoError(t, lr.Attributes().FromRaw(tt.attrs))
+			lr.SetSeverityNumber(tt.severity)
+			assert.Equal(t, tt.expected, newMessage(pcommon.NewResource(), lr, defaultFacility).priority)
+		})
+	}
+}
+
+func TestHeaderField(t *testing.T) {
+	assert.Equal(t, "-", headerField("", maxMsgIDLen))
+	assert.Equal(t, "my_app", headerField("my app", maxAppnameLen))
+	assert.Equal(t, "abc", headerField("abcdef", 3))
+}
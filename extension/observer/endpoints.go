@@ -40,6 +40,8 @@ const (
 	HostPortType EndpointType = "hostport"
 	// ContainerType is a container endpoint.
 	ContainerType EndpointType = "container"
+	// ProcessType is a process endpoint.
+	ProcessType EndpointType = "process"
 )
 
 var (
@@ -48,6 +50,7 @@ var (
 	_ EndpointDetails = (*K8sNode)(nil)
 	_ EndpointDetails = (*HostPort)(nil)
 	_ EndpointDetails = (*Container)(nil)
+	_ EndpointDetails = (*Process)(nil)
 )
 
 // EndpointDetails provides additional context about an endpoint such as a Pod or Port.
@@ -164,6 +167,17 @@ type HostPort struct {
 	ProcessName string
 	// Command used to invoke the process using the Endpoint.
 	Command string
+	// PID of the process using the Endpoint. Zero if the socket couldn't be
+	// mapped back to a process.
+	PID int32
+	// Executable is the path of the executable of the process using the Endpoint.
+	Executable string
+	// Username of the owner of the process using the Endpoint.
+	Username string
+	// Cgroup is the cgroup path of the process using the Endpoint.
+	Cgroup string
+	// ContainerID is the id of the container the process runs in, if any.
+	ContainerID string
 	// Port number of the endpoint.
 	Port uint16
 	// Transport is the transport protocol used by the Endpoint. (TCP or UDP).
@@ -176,6 +190,11 @@ func (h *HostPort) Env() EndpointEnv {
 	return map[string]interface{}{
 		"process_name": h.ProcessName,
 		"command":      h.Command,
+		"pid":          h.PID,
+		"executable":   h.Executable,
+		"username":     h.Username,
+		"cgroup":       h.Cgroup,
+		"container_id": h.ContainerID,
 		"is_ipv6":      h.IsIPv6,
 		"port":         h.Port,
 		"transport":    h.Transport,
@@ -186,6 +205,41 @@ func (h *HostPort) Type() EndpointType {
 	return HostPortType
 }
 
+// Process is a process discovered on a host, whether or not it is listening
+// on any socket.
+type Process struct {
+	// PID of the process.
+	PID int32
+	// ProcessName is the name of the process.
+	ProcessName string
+	// Executable is the path of the process executable.
+	Executable string
+	// Command used to invoke the process.
+	Command string
+	// Username of the owner of the process.
+	Username string
+	// Cgroup is the cgroup path of the process.
+	Cgroup string
+	// ContainerID is the id of the container the process runs in, if any.
+	ContainerID string
+}
+
+func (p *Process) Env() EndpointEnv {
+	return map[string]interface{}{
+		"pid":          p.PID,
+		"process_name": p.ProcessName,
+		"executable":   p.Executable,
+		"command":      p.Command,
+		"username":     p.Username,
+		"cgroup":       p.Cgroup,
+		"container_id": p.ContainerID,
+	}
+}
+
+func (p *Process) Type() EndpointType {
+	return ProcessType
+}
+
 // Container is a discovered container
 type Container struct {
 	// Name is the primary name of the container
@@ -120,6 +120,11 @@ func TestEndpointEnv(t *testing.T) {
 				"id":           "port_id",
 				"process_name": "process_name",
 				"command":      "./cmd --config config.yaml",
+				"pid":          int32(0),
+				"executable":   "",
+				"username":     "",
+				"cgroup":       "",
+				"container_id": "",
 				"is_ipv6":      true,
 				"port":         uint16(2379),
 				"transport":    ProtocolUDP,
@@ -163,6 +168,32 @@ func TestEndpointEnv(t *testing.T) {
 				"endpoint": "127.0.0.1",
 			},
 		},
+		{
+			name: "Process",
+			endpoint: Endpoint{
+				ID: EndpointID("process_endpoint_id"),
+				Details: &Process{
+					PID:         1234,
+					ProcessName: "postgres",
+					Executable:  "/usr/lib/postgresql/14/bin/postgres",
+					Command:     "/usr/lib/postgresql/14/bin/postgres -D /var/lib/postgresql/14/main",
+					Username:    "postgres",
+					Cgroup:      "/system.slice/postgresql@14-main.service",
+				},
+			},
+			want: EndpointEnv{
+				"type":         "process",
+				"id":           "process_endpoint_id",
+				"endpoint":     "",
+				"pid":          int32(1234),
+				"process_name": "postgres",
+				"executable":   "/usr/lib/postgresql/14/bin/postgres",
+				"command":      "/usr/lib/postgresql/14/bin/postgres -D /var/lib/postgresql/14/main",
+				"username":     "postgres",
+				"cgroup":       "/system.slice/postgresql@14-main.service",
+				"container_id": "",
+			},
+		},
 		{
 			name: "Kubernetes Node",
 			endpoint: Endpoint{
@@ -39,7 +39,8 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(typeStr, "all_settings"),
 			expected: &Config{
-				RefreshInterval: 20 * time.Second,
+				RefreshInterval:  20 * time.Second,
+				ProcessEndpoints: true,
 			},
 		},
 	}
@@ -15,9 +15,16 @@
 package hostobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/hostobserver"
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
 	"runtime"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/shirou/gopsutil/v3/net"
@@ -34,11 +41,13 @@ type hostObserver struct {
 }
 
 type endpointsLister struct {
-	logger       *zap.Logger
-	observerName string
+	logger           *zap.Logger
+	observerName     string
+	processEndpoints bool
 
 	// For testing
 	getConnections        func() ([]net.ConnectionStat, error)
+	getPids               func() ([]int32, error)
 	getProcess            func(pid int32) (*process.Process, error)
 	collectProcessDetails func(proc *process.Process) (*processDetails, error)
 }
@@ -51,7 +60,9 @@ func newObserver(params extension.CreateSettings, config *Config) (extension.Ext
 			endpointsLister{
 				logger:                params.Logger,
 				observerName:          params.ID.String(),
+				processEndpoints:      config.ProcessEndpoints,
 				getConnections:        getConnections,
+				getPids:               process.Pids,
 				getProcess:            process.NewProcess,
 				collectProcessDetails: collectProcessDetails,
 			},
@@ -73,13 +84,20 @@ func (h *hostObserver) Shutdown(context.Context) error {
 }
 
 func (e endpointsLister) ListEndpoints() []observer.Endpoint {
+	var endpoints []observer.Endpoint
+
 	conns, err := e.getConnections()
 	if err != nil {
 		e.logger.Error("Could not get local network listeners", zap.Error(err))
-		return nil
+	} else {
+		endpoints = e.collectEndpoints(conns)
 	}
 
-	return e.collectEndpoints(conns)
+	if e.processEndpoints {
+		endpoints = append(endpoints, e.collectProcessEndpoints()...)
+	}
+
+	return endpoints
 }
 
 func getConnections() (conns []net.ConnectionStat, err error) {
@@ -171,6 +189,11 @@ func (e endpointsLister) collectEndpoints(conns []net.ConnectionStat) []observer
 				Details: &observer.HostPort{
 					ProcessName: pd.name,
 					Command:     pd.args,
+					PID:         pid,
+					Executable:  pd.exe,
+					Username:    pd.username,
+					Cgroup:      pd.cgroup,
+					ContainerID: pd.containerID,
 					Port:        cd.port,
 					Transport:   cd.transport,
 					// TODO: Move this field to observer.Endpoint and
@@ -185,6 +208,48 @@ func (e endpointsLister) collectEndpoints(conns []net.ConnectionStat) []observer
 	return endpoints
 }
 
+// collectProcessEndpoints returns an endpoint for every process running on
+// the host, regardless of whether it is listening on any socket.
+func (e endpointsLister) collectProcessEndpoints() []observer.Endpoint {
+	pids, err := e.getPids()
+	if err != nil {
+		e.logger.Error("Could not list processes", zap.Error(err))
+		return nil
+	}
+
+	endpoints := make([]observer.Endpoint, 0, len(pids))
+	for _, pid := range pids {
+		proc, err := e.getProcess(pid)
+		if err != nil {
+			e.logger.Debug("Could not examine process (it might have terminated already)", zap.Int32("pid", pid))
+			continue
+		}
+
+		pd, err := e.collectProcessDetails(proc)
+		if err != nil {
+			e.logger.Debug("Failed collecting process details (skipping)",
+				zap.Int32("pid", pid), zap.Error(err),
+			)
+			continue
+		}
+
+		endpoints = append(endpoints, observer.Endpoint{
+			ID: observer.EndpointID(fmt.Sprintf("(%s)process-%d-%d", e.observerName, pid, pd.createTime)),
+			Details: &observer.Process{
+				PID:         pid,
+				ProcessName: pd.name,
+				Executable:  pd.exe,
+				Command:     pd.args,
+				Username:    pd.username,
+				Cgroup:      pd.cgroup,
+				ContainerID: pd.containerID,
+			},
+		})
+	}
+
+	return endpoints
+}
+
 type connectionDetails struct {
 	ip        string
 	isIPv6    bool
@@ -223,8 +288,15 @@ func collectConnectionDetails(c *net.ConnectionStat) connectionDetails {
 }
 
 type processDetails struct {
-	name string
-	args string
+	name        string
+	args        string
+	exe         string
+	username    string
+	cgroup      string
+	containerID string
+	// createTime is the start time of the process in milliseconds since the epoch, which
+	// tells apart processes reusing the same pid.
+	createTime int64
 }
 
 func collectProcessDetails(proc *process.Process) (*processDetails, error) {
@@ -238,12 +310,77 @@ func collectProcessDetails(proc *process.Process) (*processDetails, error) {
 		return nil, fmt.Errorf("could not get process args: %w", err)
 	}
 
+	createTime, err := proc.CreateTime()
+	if err != nil {
+		return nil, fmt.Errorf("could not get process create time: %w", err)
+	}
+
+	// The remaining details are best effort since they commonly require
+	// more privileges than the process name and command line.
+	exe, _ := proc.Exe()
+	username, _ := proc.Username()
+	cgroup, _ := readCgroup(proc.Pid)
+
 	return &processDetails{
-		name: name,
-		args: args,
+		name:        name,
+		args:        args,
+		exe:         exe,
+		username:    username,
+		cgroup:      cgroup,
+		containerID: containerIDFromCgroup(cgroup),
+		createTime:  createTime,
 	}, nil
 }
 
+// readCgroup returns the cgroup path of the process. The unified (v2)
+// hierarchy is preferred, otherwise the path of the first hierarchy listed is
+// used.
+func readCgroup(pid int32) (string, error) {
+	hostProc := os.Getenv("HOST_PROC")
+	if hostProc == "" {
+		hostProc = "/proc"
+	}
+
+	f, err := os.Open(filepath.Join(hostProc, strconv.Itoa(int(pid)), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return parseCgroup(f)
+}
+
+func parseCgroup(r io.Reader) (string, error) {
+	var cgroup string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// Each line is formatted as hierarchy-ID:controller-list:cgroup-path
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "" {
+			return fields[2], nil
+		}
+		if cgroup == "" {
+			cgroup = fields[2]
+		}
+	}
+	return cgroup, scanner.Err()
+}
+
+// containerIDRe matches the 64 character ids used by docker, containerd and
+// cri-o in the cgroup paths of containerized processes.
+var containerIDRe = regexp.MustCompile(`[0-9a-f]{64}`)
+
+func containerIDFromCgroup(cgroup string) string {
+	ids := containerIDRe.FindAllString(cgroup, -1)
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[len(ids)-1]
+}
+
 func portTypeToProtocol(t uint32) observer.Transport {
 	switch t {
 	case syscall.SOCK_STREAM:
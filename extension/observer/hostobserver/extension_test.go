@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -120,6 +121,7 @@ func TestHostObserver(t *testing.T) {
 				details, ok := actualEndpoint.Details.(*observer.HostPort)
 				assert.True(t, ok, "failed to get Endpoint.Details")
 				assert.Equal(t, filepath.Base(exe), details.ProcessName)
+				assert.Equal(t, int32(selfPid), details.PID)
 				assert.Equal(t, tt.protocol, details.Transport)
 				assert.Equal(t, isIPv6, details.IsIPv6)
 
@@ -549,3 +551,115 @@ func TestCollectEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectProcessEndpoints(t *testing.T) {
+	e := endpointsLister{
+		logger:       zap.NewNop(),
+		observerName: "host_observer/1",
+		getPids: func() ([]int32, error) {
+			return []int32{1, 42, 99}, nil
+		},
+		getProcess: func(pid int32) (*process.Process, error) {
+			if pid == 99 {
+				return nil, errors.New("no such process")
+			}
+			return &process.Process{Pid: pid}, nil
+		},
+		collectProcessDetails: func(proc *process.Process) (*processDetails, error) {
+			if proc.Pid == 1 {
+				return nil, errors.New("permission denied")
+			}
+			return &processDetails{
+				name:        "redis-server",
+				args:        "redis-server *:6379",
+				exe:         "/usr/bin/redis-server",
+				username:    "redis",
+				cgroup:      "/kubepods/burstable/pod1234/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+				containerID: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+				createTime:  1675245600000,
+			}, nil
+		},
+	}
+
+	assert.Equal(t, []observer.Endpoint{
+		{
+			ID: observer.EndpointID("(host_observer/1)process-42-1675245600000"),
+			Details: &observer.Process{
+				PID:         42,
+				ProcessName: "redis-server",
+				Executable:  "/usr/bin/redis-server",
+				Command:     "redis-server *:6379",
+				Username:    "redis",
+				Cgroup:      "/kubepods/burstable/pod1234/0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+				ContainerID: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			},
+		},
+	}, e.collectProcessEndpoints())
+}
+
+func TestListEndpointsWithProcessEndpoints(t *testing.T) {
+	e := endpointsLister{
+		logger:           zap.NewNop(),
+		processEndpoints: true,
+		getConnections: func() ([]psnet.ConnectionStat, error) {
+			return nil, errors.New("fails to list connections")
+		},
+		getPids: func() ([]int32, error) {
+			return []int32{42}, nil
+		},
+		getProcess: func(pid int32) (*process.Process, error) {
+			return &process.Process{Pid: pid}, nil
+		},
+		collectProcessDetails: func(proc *process.Process) (*processDetails, error) {
+			return &processDetails{name: "redis-server"}, nil
+		},
+	}
+
+	endpoints := e.ListEndpoints()
+	require.Len(t, endpoints, 1)
+	assert.Equal(t, observer.ProcessType, endpoints[0].Details.Type())
+}
+
+func TestParseCgroup(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "unified hierarchy",
+			content:  "0::/system.slice/docker-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.scope\n",
+			expected: "/system.slice/docker-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.scope",
+		},
+		{
+			name:     "hybrid hierarchy",
+			content:  "12:memory:/user.slice\n1:name=systemd:/user.slice/session-1.scope\n0::/user.slice/session-1.scope\n",
+			expected: "/user.slice/session-1.scope",
+		},
+		{
+			name:     "legacy hierarchy",
+			content:  "12:memory:/docker/abc\n11:cpu,cpuacct:/docker/abc\n",
+			expected: "/docker/abc",
+		},
+		{
+			name:    "empty",
+			content: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cgroup, err := parseCgroup(strings.NewReader(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cgroup)
+		})
+	}
+}
+
+func TestContainerIDFromCgroup(t *testing.T) {
+	id := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	assert.Equal(t, id, containerIDFromCgroup("/system.slice/docker-"+id+".scope"))
+	assert.Equal(t, id, containerIDFromCgroup("/kubepods/besteffort/pod5b1a/"+id))
+	assert.Equal(t, id, containerIDFromCgroup("/kubepods.slice/kubepods-pod.slice/crio-"+id+".scope"))
+	assert.Equal(t, "", containerIDFromCgroup("/user.slice/session-1.scope"))
+}
@@ -69,6 +69,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/skywalkingexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tanzuobservabilityexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/tencentcloudlogserviceexporter"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/zipkinexporter"
@@ -429,6 +430,14 @@ func TestDefaultExporters(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			exporter: "syslog",
+			getConfigFn: func() component.Config {
+				cfg := expFactories["syslog"].CreateDefaultConfig().(*syslogexporter.Config)
+				cfg.Endpoint = endpoint
+				return cfg
+			},
+		},
 		{
 			exporter: "tanzuobservability",
 			getConfigFn: func() component.Config {
@@ -148,6 +148,10 @@ func TestDefaultReceivers(t *testing.T) {
 		{
 			receiver: "datadog",
 		},
+		{
+			receiver:     "docker_logs",
+			skipLifecyle: true, // Requires a running docker daemon
+		},
 		{
 			receiver:     "docker_stats",
 			skipLifecyle: true,
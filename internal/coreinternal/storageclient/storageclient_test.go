@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storageclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+type mockHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *mockHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+type mockStorageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	client storage.Client
+}
+
+func (e *mockStorageExtension) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return e.client, nil
+}
+
+type nonStorageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func TestGet(t *testing.T) {
+	storageID := component.NewID("storage")
+	componentID := component.NewID("receiver")
+	client := storage.NewNopClient()
+
+	host := &mockHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{
+			storageID:                &mockStorageExtension{client: client},
+			component.NewID("other"): &nonStorageExtension{},
+		},
+	}
+
+	got, err := Get(context.Background(), host, nil, componentID)
+	require.NoError(t, err)
+	assert.NotNil(t, got)
+
+	got, err = Get(context.Background(), host, &storageID, componentID)
+	require.NoError(t, err)
+	assert.Equal(t, client, got)
+
+	missingID := component.NewID("missing")
+	_, err = Get(context.Background(), host, &missingID, componentID)
+	assert.EqualError(t, err, "storage extension 'missing' not found")
+
+	otherID := component.NewID("other")
+	_, err = Get(context.Background(), host, &otherID, componentID)
+	assert.EqualError(t, err, "non-storage extension 'other' found")
+}
@@ -205,6 +205,13 @@ func (dc *Client) toStatsJSON(
 	return &statsJSON, nil
 }
 
+// ContainerLogs streams the logs of the container with the given id. When the container
+// was not started with a TTY, stdout and stderr are multiplexed into a single stream
+// of frames as described by the Docker Engine API. Caller must close the returned reader.
+func (dc *Client) ContainerLogs(ctx context.Context, cid string, options dtypes.ContainerLogsOptions) (io.ReadCloser, error) {
+	return dc.client.ContainerLogs(ctx, cid, options)
+}
+
 // Events exposes the underlying Docker clients Events channel.
 // Caller should close the events channel by canceling the context.
 // If an error occurs, processing stops and caller must reinvoke this method.
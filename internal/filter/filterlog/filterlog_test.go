@@ -66,14 +66,14 @@ func TestLogRecord_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 				Config:     *createConfig("wrong_match_type"),
 				Attributes: []filterconfig.Attribute{{Key: "abc", Value: "def"}},
 			},
-			errorString: "error creating attribute filters: unrecognized match_type: 'wrong_match_type', valid types are: [regexp strict]",
+			errorString: "error creating attribute filters: unrecognized match_type: 'wrong_match_type', valid types are: [regexp strict glob prefix]",
 		},
 		{
 			name: "missing_match_type",
 			property: filterconfig.MatchProperties{
 				Attributes: []filterconfig.Attribute{{Key: "abc", Value: "def"}},
 			},
-			errorString: "error creating attribute filters: unrecognized match_type: '', valid types are: [regexp strict]",
+			errorString: "error creating attribute filters: unrecognized match_type: '', valid types are: [regexp strict glob prefix]",
 		},
 		{
 			name: "invalid_regexp_pattern",
@@ -64,11 +64,11 @@ func NewAttributesMatcher(config filterset.Config, attributes []filterconfig.Att
 			}
 
 			switch config.MatchType {
-			case filterset.Regexp:
+			case filterset.Regexp, filterset.Glob, filterset.Prefix:
 				if val.Type() != pcommon.ValueTypeStr {
 					return nil, fmt.Errorf(
 						"%s=%s for %q only supports Str, but found %s",
-						filterset.MatchTypeFieldName, filterset.Regexp, attribute.Key, val.Type(),
+						filterset.MatchTypeFieldName, config.MatchType, attribute.Key, val.Type(),
 					)
 				}
 
@@ -52,6 +52,38 @@ func TestMatchAttributes(t *testing.T) {
 	assert.False(t, matcher.Match(notMatchingMap))
 }
 
+func TestMatchAttributesPatterns(t *testing.T) {
+	tests := []struct {
+		matchType filterset.MatchType
+		value     string
+	}{
+		{matchType: filterset.Regexp, value: "^/api/.*"},
+		{matchType: filterset.Glob, value: "/api/*"},
+		{matchType: filterset.Prefix, value: "/api/"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.matchType), func(t *testing.T) {
+			matcher, err := NewAttributesMatcher(filterset.Config{MatchType: tt.matchType}, []filterconfig.Attribute{
+				{Key: "http.target", Value: tt.value},
+			})
+			require.NoError(t, err)
+
+			matchingMap := pcommon.NewMap()
+			matchingMap.PutStr("http.target", "/api/users")
+			notMatchingMap := pcommon.NewMap()
+			notMatchingMap.PutStr("http.target", "/health")
+
+			assert.True(t, matcher.Match(matchingMap))
+			assert.False(t, matcher.Match(notMatchingMap))
+
+			_, err = NewAttributesMatcher(filterset.Config{MatchType: tt.matchType}, []filterconfig.Attribute{
+				{Key: "http.status_code", Value: 200},
+			})
+			assert.EqualError(t, err, "match_type="+string(tt.matchType)+` for "http.status_code" only supports Str, but found Int`)
+		})
+	}
+}
+
 func BenchmarkMatchAttributes(b *testing.B) {
 	matchCfg := filterset.Config{MatchType: filterset.Strict}
 	attrsCfg := []filterconfig.Attribute{
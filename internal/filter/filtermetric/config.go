@@ -32,6 +32,8 @@ type MatchType string
 const (
 	Regexp           = MatchType(filterset.Regexp)
 	Strict           = MatchType(filterset.Strict)
+	Glob             = MatchType(filterset.Glob)
+	Prefix           = MatchType(filterset.Prefix)
 	Expr   MatchType = "expr"
 )
 
@@ -43,6 +43,15 @@ var (
 		".*/suffix",
 		"(a|b)",
 	}
+
+	globFilters = []string{
+		"http.*",
+		"*.duration",
+	}
+
+	prefixFilters = []string{
+		"system.cpu",
+	}
 )
 
 func createMetric(name string) pmetric.Metric {
@@ -76,6 +85,30 @@ func TestMatcherMatches(t *testing.T) {
 			metric:      createMetric("exact_string_match"),
 			shouldMatch: true,
 		},
+		{
+			name:        "globNameMatch",
+			cfg:         createConfig(globFilters, filterset.Glob),
+			metric:      createMetric("rpc.server.duration"),
+			shouldMatch: true,
+		},
+		{
+			name:        "globNameMismatch",
+			cfg:         createConfig(globFilters, filterset.Glob),
+			metric:      createMetric("rpc.server.requests"),
+			shouldMatch: false,
+		},
+		{
+			name:        "prefixNameMatch",
+			cfg:         createConfig(prefixFilters, filterset.Prefix),
+			metric:      createMetric("system.cpu.time"),
+			shouldMatch: true,
+		},
+		{
+			name:        "prefixNameMismatch",
+			cfg:         createConfig(prefixFilters, filterset.Prefix),
+			metric:      createMetric("system.memory.usage"),
+			shouldMatch: false,
+		},
 		{
 			name:        "strictNameMismatch",
 			cfg:         createConfig(regexpFilters, filterset.Regexp),
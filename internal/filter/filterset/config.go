@@ -17,6 +17,8 @@ package filterset // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"fmt"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset/glob"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset/prefix"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset/regexp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset/strict"
 )
@@ -29,12 +31,17 @@ const (
 	Regexp MatchType = "regexp"
 	// Strict is the FilterType for filtering by exact string matches.
 	Strict MatchType = "strict"
+	// Glob is the FilterType for filtering by glob pattern matches, where `*` matches any
+	// sequence of characters and `?` matches any single character.
+	Glob MatchType = "glob"
+	// Prefix is the FilterType for filtering by string prefix matches.
+	Prefix MatchType = "prefix"
 	// MatchTypeFieldName is the mapstructure field name for MatchType field.
 	MatchTypeFieldName = "match_type"
 )
 
 var (
-	validMatchTypes = []MatchType{Regexp, Strict}
+	validMatchTypes = []MatchType{Regexp, Strict, Glob, Prefix}
 )
 
 // Config configures the matching behavior of a FilterSet.
@@ -55,6 +62,14 @@ func CreateFilterSet(filters []string, cfg *Config) (FilterSet, error) {
 	case Strict:
 		// Strict FilterSets do not have any extra configuration options, so call the constructor directly.
 		return strict.NewFilterSet(filters), nil
+	case Glob:
+		fs, err := glob.NewFilterSet(filters)
+		if err != nil {
+			return nil, err
+		}
+		return fs, nil
+	case Prefix:
+		return prefix.NewFilterSet(filters), nil
 	default:
 		return nil, NewUnrecognizedMatchTypeError(cfg.MatchType)
 	}
@@ -55,6 +55,12 @@ func TestConfig(t *testing.T) {
 		"strict/default": {
 			MatchType: Strict,
 		},
+		"glob/default": {
+			MatchType: Glob,
+		},
+		"prefix/default": {
+			MatchType: Prefix,
+		},
 	}
 
 	for testName, actualCfg := range actualConfigs {
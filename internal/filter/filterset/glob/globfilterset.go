@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glob // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset/glob"
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// FilterSet encapsulates a set of glob pattern filters. In a pattern, `*` matches any
+// sequence of characters, `?` matches any single character and `\` escapes the next character.
+// FilterSet is exported for convenience, but has unexported fields and should be constructed through NewFilterSet.
+//
+// Patterns are indexed by their literal prefix and, for patterns made of a single
+// leading `*` followed by a literal, by their literal suffix, so that matching a string
+// only evaluates the patterns whose literal prefix or suffix it shares.
+type FilterSet struct {
+	matchAll bool
+	exact    map[string]struct{}
+	prefixes *node
+	suffixes *node
+}
+
+type node struct {
+	children map[byte]*node
+	// terminal is true when any string reaching this node matches.
+	terminal bool
+	// patterns are the remaining elements of the patterns whose literal prefix ends at this node.
+	patterns [][]element
+}
+
+type elementKind int
+
+const (
+	literalElement elementKind = iota
+	starElement
+	anyCharElement
+)
+
+type element struct {
+	kind    elementKind
+	literal string
+}
+
+// NewFilterSet constructs a FilterSet of glob pattern matches.
+func NewFilterSet(filters []string) (*FilterSet, error) {
+	fs := &FilterSet{
+		exact:    make(map[string]struct{}),
+		prefixes: &node{},
+		suffixes: &node{},
+	}
+	for _, f := range filters {
+		elements, err := parse(f)
+		if err != nil {
+			return nil, err
+		}
+		fs.add(elements)
+	}
+	return fs, nil
+}
+
+// parse splits a glob pattern into literal and wildcard elements.
+func parse(pattern string) ([]element, error) {
+	var elements []element
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			elements = append(elements, element{kind: literalElement, literal: literal.String()})
+			literal.Reset()
+		}
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '\\':
+			if i+1 == len(pattern) {
+				return nil, fmt.Errorf("invalid glob pattern %q: trailing escape character", pattern)
+			}
+			i++
+			literal.WriteByte(pattern[i])
+		case '*':
+			flush()
+			// Consecutive stars are equivalent to a single one.
+			if len(elements) == 0 || elements[len(elements)-1].kind != starElement {
+				elements = append(elements, element{kind: starElement})
+			}
+		case '?':
+			flush()
+			elements = append(elements, element{kind: anyCharElement})
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	flush()
+	return elements, nil
+}
+
+func (gfs *FilterSet) add(elements []element) {
+	switch {
+	case len(elements) == 0:
+		gfs.exact[""] = struct{}{}
+	case len(elements) == 1 && elements[0].kind == literalElement:
+		gfs.exact[elements[0].literal] = struct{}{}
+	case len(elements) == 1 && elements[0].kind == starElement:
+		gfs.matchAll = true
+	case len(elements) == 2 && elements[0].kind == literalElement && elements[1].kind == starElement:
+		gfs.prefixes.insert(elements[0].literal).terminal = true
+	case len(elements) == 2 && elements[0].kind == starElement && elements[1].kind == literalElement:
+		gfs.suffixes.insert(reverse(elements[1].literal)).terminal = true
+	case elements[0].kind == literalElement:
+		n := gfs.prefixes.insert(elements[0].literal)
+		n.patterns = append(n.patterns, elements[1:])
+	default:
+		gfs.prefixes.patterns = append(gfs.prefixes.patterns, elements)
+	}
+}
+
+func (n *node) insert(key string) *node {
+	for i := 0; i < len(key); i++ {
+		child, ok := n.children[key[i]]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[byte]*node)
+			}
+			child = &node{}
+			n.children[key[i]] = child
+		}
+		n = child
+	}
+	return n
+}
+
+func reverse(s string) string {
+	b := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		b[len(s)-1-i] = s[i]
+	}
+	return string(b)
+}
+
+// Matches returns true if the given string matches any of the FilterSet's filters.
+func (gfs *FilterSet) Matches(toMatch string) bool {
+	if gfs.matchAll {
+		return true
+	}
+	if _, ok := gfs.exact[toMatch]; ok {
+		return true
+	}
+
+	n := gfs.prefixes
+	for i := 0; n != nil; i++ {
+		if n.terminal {
+			return true
+		}
+		for _, pattern := range n.patterns {
+			if match(pattern, toMatch[i:]) {
+				return true
+			}
+		}
+		if i == len(toMatch) {
+			break
+		}
+		n = n.children[toMatch[i]]
+	}
+
+	n = gfs.suffixes
+	for i := len(toMatch) - 1; n != nil; i-- {
+		if n.terminal {
+			return true
+		}
+		if i < 0 {
+			break
+		}
+		n = n.children[toMatch[i]]
+	}
+	return false
+}
+
+// match reports whether s matches the pattern elements. It only backtracks to the
+// last star, which is sufficient since all other elements have a fixed length.
+func match(elements []element, s string) bool {
+	ei, si := 0, 0
+	starEi, starSi := -1, 0
+	for {
+		if ei < len(elements) {
+			e := elements[ei]
+			switch e.kind {
+			case starElement:
+				starEi, starSi = ei, si
+				ei++
+				continue
+			case anyCharElement:
+				if si < len(s) {
+					_, size := utf8.DecodeRuneInString(s[si:])
+					si += size
+					ei++
+					continue
+				}
+			case literalElement:
+				if strings.HasPrefix(s[si:], e.literal) {
+					si += len(e.literal)
+					ei++
+					continue
+				}
+			}
+		} else if si == len(s) {
+			return true
+		}
+
+		if starEi < 0 || starSi == len(s) {
+			return false
+		}
+		_, size := utf8.DecodeRuneInString(s[starSi:])
+		starSi += size
+		ei, si = starEi+1, starSi
+	}
+}
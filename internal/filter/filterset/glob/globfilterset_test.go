@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glob
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	validGlobFilters = []string{
+		"exact_string_match",
+		"http.*",
+		"*.duration",
+		"system.*.time",
+		"process.?pu",
+		"*cache*",
+		`literal\*star`,
+	}
+)
+
+func TestNewGlobFilterSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []string
+		success bool
+	}{
+		{
+			name:    "validFilters",
+			filters: validGlobFilters,
+			success: true,
+		},
+		{
+			name:    "trailingEscape",
+			filters: []string{`invalid\`},
+			success: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs, err := NewFilterSet(test.filters)
+			assert.Equal(t, test.success, fs != nil)
+			assert.Equal(t, test.success, err == nil)
+		})
+	}
+}
+
+func TestGlobMatches(t *testing.T) {
+	fs, err := NewFilterSet(validGlobFilters)
+	require.NoError(t, err)
+
+	matches := []string{
+		"exact_string_match",
+		"http.",
+		"http.server.duration",
+		"rpc.client.duration",
+		".duration",
+		"system.cpu.time",
+		"system.disk.io.time",
+		"system..time",
+		"process.cpu",
+		"process.gpu",
+		"process.µpu",
+		"redis.cache.hits",
+		"cache",
+		"literal*star",
+	}
+
+	for _, m := range matches {
+		t.Run(m, func(t *testing.T) {
+			assert.True(t, fs.Matches(m))
+		})
+	}
+
+	mismatches := []string{
+		"",
+		"exact_string_match_not",
+		"http",
+		"https.requests",
+		"rpc.client.durations",
+		"system.cpu.times",
+		"system.time",
+		"process.pu",
+		"process.cpus",
+		"literal_star",
+		"literalstar",
+	}
+
+	for _, m := range mismatches {
+		t.Run(m, func(t *testing.T) {
+			assert.False(t, fs.Matches(m))
+		})
+	}
+}
+
+func TestGlobMatchesSpecialPatterns(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		matches    []string
+		mismatches []string
+	}{
+		{
+			pattern: "*",
+			matches: []string{"", "anything"},
+		},
+		{
+			pattern:    "",
+			matches:    []string{""},
+			mismatches: []string{"a"},
+		},
+		{
+			pattern:    "a**b",
+			matches:    []string{"ab", "axxb"},
+			mismatches: []string{"a", "ba"},
+		},
+		{
+			pattern:    "*a?c*",
+			matches:    []string{"abc", "xxabcxx", "aaac"},
+			mismatches: []string{"ac", "abd"},
+		},
+		{
+			pattern:    "a*b*c",
+			matches:    []string{"abc", "abbbc", "axbxcxbc"},
+			mismatches: []string{"abcx", "acb"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			fs, err := NewFilterSet([]string{tt.pattern})
+			require.NoError(t, err)
+			for _, m := range tt.matches {
+				assert.True(t, fs.Matches(m), m)
+			}
+			for _, m := range tt.mismatches {
+				assert.False(t, fs.Matches(m), m)
+			}
+		})
+	}
+}
+
+func BenchmarkGlobMatches(b *testing.B) {
+	filters := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		filters = append(filters, "metric."+strconv.Itoa(i)+".*", "*.suffix"+strconv.Itoa(i))
+	}
+	fs, err := NewFilterSet(filters)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fs.Matches("metric.999.requests")
+		fs.Matches("other.metric.suffix999")
+		fs.Matches("no.match")
+	}
+}
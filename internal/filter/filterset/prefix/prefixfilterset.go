@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prefix // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset/prefix"
+
+// FilterSet encapsulates a set of prefix string match filters.
+// FilterSet is exported for convenience, but has unexported fields and should be constructed through NewFilterSet.
+//
+// The prefixes are stored in a trie so that matching a string takes time
+// proportional to its length, regardless of the number of filters.
+type FilterSet struct {
+	root *node
+}
+
+type node struct {
+	children map[byte]*node
+	terminal bool
+}
+
+// NewFilterSet constructs a FilterSet of prefix string matches.
+func NewFilterSet(filters []string) *FilterSet {
+	fs := &FilterSet{root: &node{}}
+	for _, f := range filters {
+		fs.add(f)
+	}
+	return fs
+}
+
+func (pfs *FilterSet) add(prefix string) {
+	n := pfs.root
+	for i := 0; i < len(prefix); i++ {
+		if n.terminal {
+			// A shorter prefix already matches everything below this node.
+			return
+		}
+		child, ok := n.children[prefix[i]]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[byte]*node)
+			}
+			child = &node{}
+			n.children[prefix[i]] = child
+		}
+		n = child
+	}
+	n.terminal = true
+	n.children = nil
+}
+
+// Matches returns true if the given string starts with any of the FilterSet's filters.
+func (pfs *FilterSet) Matches(toMatch string) bool {
+	n := pfs.root
+	for i := 0; ; i++ {
+		if n.terminal {
+			return true
+		}
+		if i == len(toMatch) {
+			return false
+		}
+		child, ok := n.children[toMatch[i]]
+		if !ok {
+			return false
+		}
+		n = child
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prefix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	validPrefixFilters = []string{
+		"http.",
+		"http.server.duration",
+		"system.cpu",
+		"(a|b)",
+	}
+)
+
+func TestNewPrefixFilterSet(t *testing.T) {
+	fs := NewFilterSet(validPrefixFilters)
+	assert.NotNil(t, fs)
+}
+
+func TestPrefixMatches(t *testing.T) {
+	fs := NewFilterSet(validPrefixFilters)
+	assert.NotNil(t, fs)
+
+	matches := []string{
+		"http.",
+		"http.client.duration",
+		"http.server.duration",
+		"system.cpu",
+		"system.cpu.time",
+		"system.cpuinfo",
+		"(a|b)c",
+	}
+
+	for _, m := range matches {
+		t.Run(m, func(t *testing.T) {
+			assert.True(t, fs.Matches(m))
+		})
+	}
+
+	mismatches := []string{
+		"",
+		"http",
+		"https.client",
+		"system.cp",
+		"system.memory",
+		"a",
+	}
+
+	for _, m := range mismatches {
+		t.Run(m, func(t *testing.T) {
+			assert.False(t, fs.Matches(m))
+		})
+	}
+}
+
+func TestPrefixMatchesEmptyPrefix(t *testing.T) {
+	assert.True(t, NewFilterSet([]string{"system.", ""}).Matches("anything"))
+	assert.True(t, NewFilterSet([]string{""}).Matches(""))
+	assert.False(t, NewFilterSet(nil).Matches(""))
+}
@@ -68,14 +68,14 @@ func TestSpan_validateMatchesConfiguration_InvalidConfig(t *testing.T) {
 				Config:   *createConfig("wrong_match_type"),
 				Services: []string{"abc"},
 			},
-			errorString: "error creating service name filters: unrecognized match_type: 'wrong_match_type', valid types are: [regexp strict]",
+			errorString: "error creating service name filters: unrecognized match_type: 'wrong_match_type', valid types are: [regexp strict glob prefix]",
 		},
 		{
 			name: "missing_match_type",
 			property: filterconfig.MatchProperties{
 				Services: []string{"abc"},
 			},
-			errorString: "error creating service name filters: unrecognized match_type: '', valid types are: [regexp strict]",
+			errorString: "error creating service name filters: unrecognized match_type: '', valid types are: [regexp strict glob prefix]",
 		},
 		{
 			name: "invalid_regexp_pattern_service",
@@ -108,6 +108,14 @@ func NewStatements(statements []*ottl.Statement[TransformContext], telemetrySett
 	return s
 }
 
+func NewStatementGroups(groups []*ottl.StatementGroup[TransformContext], telemetrySettings component.TelemetrySettings, options ...StatementsOption) ottl.Statements[TransformContext] {
+	s := ottl.NewStatementGroups(groups, telemetrySettings)
+	for _, op := range options {
+		op(&s)
+	}
+	return s
+}
+
 var symbolTable = map[ottl.EnumSymbol]ottl.Enum{
 	"FLAG_NONE":              0,
 	"FLAG_NO_RECORDED_VALUE": 1,
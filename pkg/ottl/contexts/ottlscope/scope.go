@@ -88,6 +88,14 @@ func NewStatements(statements []*ottl.Statement[TransformContext], telemetrySett
 	return s
 }
 
+func NewStatementGroups(groups []*ottl.StatementGroup[TransformContext], telemetrySettings component.TelemetrySettings, options ...StatementsOption) ottl.Statements[TransformContext] {
+	s := ottl.NewStatementGroups(groups, telemetrySettings)
+	for _, op := range options {
+		op(&s)
+	}
+	return s
+}
+
 func parseEnum(val *ottl.EnumSymbol) (*ottl.Enum, error) {
 	return nil, fmt.Errorf("instrumentation scope context does not provide Enum support")
 }
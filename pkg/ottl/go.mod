@@ -7,6 +7,7 @@ require (
 	github.com/gobwas/glob v0.2.3
 	github.com/iancoleman/strcase v0.2.0
 	github.com/json-iterator/go v1.1.12
+	github.com/observiq/ctimefmt v1.0.0
 	github.com/stretchr/testify v1.8.1
 	go.opentelemetry.io/collector/component v0.72.0
 	go.opentelemetry.io/collector/pdata v1.0.0-rc6
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Base64Encode(t *testing.T) {
+	exprFunc, err := Base64Encode[interface{}](&ottl.StandardTypeGetter[interface{}, string]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return "hello world", nil
+		},
+	})
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "aGVsbG8gd29ybGQ=", result)
+}
+
+func Test_Base64Decode(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+		err      bool
+	}{
+		{
+			name:     "valid",
+			value:    "aGVsbG8gd29ybGQ=",
+			expected: "hello world",
+		},
+		{
+			name:  "invalid base64",
+			value: "aGVsbG8gd29ybGQ",
+			err:   true,
+		},
+		{
+			name:  "not a string",
+			value: int64(1),
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Base64Decode[interface{}](&ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// ExtractPatterns returns a map of the named capture groups of the pattern to the values
+// they matched in the target string. The map is empty if the pattern doesn't match.
+func ExtractPatterns[K any](target ottl.StringGetter[K], pattern string) (ottl.ExprFunc[K], error) {
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to ExtractPatterns is not a valid pattern: %w", err)
+	}
+
+	namedCaptureGroups := 0
+	for _, groupName := range r.SubexpNames() {
+		if groupName != "" {
+			namedCaptureGroups++
+		}
+	}
+	if namedCaptureGroups == 0 {
+		return nil, fmt.Errorf("at least 1 named capture group must be supplied in the given regex")
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		result := pcommon.NewMap()
+		matches := r.FindStringSubmatch(val)
+		if matches == nil {
+			return result, nil
+		}
+		for i, subexp := range r.SubexpNames() {
+			if i == 0 || subexp == "" {
+				continue
+			}
+			result.PutStr(subexp, matches[i])
+		}
+		return result, nil
+	}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ExtractPatterns(t *testing.T) {
+	target := &ottl.StandardTypeGetter[interface{}, string]{
+		Getter: func(context.Context, interface{}) (interface{}, error) {
+			return `a=b c=d`, nil
+		},
+	}
+	tests := []struct {
+		name    string
+		pattern string
+		want    func(pcommon.Map)
+	}{
+		{
+			name:    "extract patterns",
+			pattern: `^a=(?P<a>\w+)\s+c=(?P<c>\w+)$`,
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("a", "b")
+				expectedMap.PutStr("c", "d")
+			},
+		},
+		{
+			name:    "unnamed groups are ignored",
+			pattern: `^a=(\w+)\s+c=(?P<c>\w+)$`,
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("c", "d")
+			},
+		},
+		{
+			name:    "no match",
+			pattern: `^x=(?P<x>\w+)$`,
+			want:    func(expectedMap pcommon.Map) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ExtractPatterns[interface{}](target, tt.pattern)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			assert.NoError(t, err)
+
+			expected := pcommon.NewMap()
+			tt.want(expected)
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
+func Test_ExtractPatterns_InvalidPattern(t *testing.T) {
+	target := &ottl.StandardTypeGetter[interface{}, string]{}
+	_, err := ExtractPatterns[interface{}](target, `(?P<a`)
+	assert.Error(t, err)
+	_, err = ExtractPatterns[interface{}](target, `^a=(\w+)$`)
+	assert.Error(t, err)
+}
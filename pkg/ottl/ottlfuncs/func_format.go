@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Format formats the values according to the printf-style format specifier, see fmt.Sprintf.
+func Format[K any](format string, vals []ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		args := make([]interface{}, len(vals))
+		for i, v := range vals {
+			val, err := v.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			switch val := val.(type) {
+			case pcommon.Map:
+				args[i] = val.AsRaw()
+			case pcommon.Slice:
+				args[i] = val.AsRaw()
+			default:
+				args[i] = val
+			}
+		}
+		return fmt.Sprintf(format, args...), nil
+	}, nil
+}
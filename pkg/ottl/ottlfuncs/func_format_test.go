@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Format(t *testing.T) {
+	getter := func(v interface{}) ottl.Getter[interface{}] {
+		return &ottl.StandardGetSetter[interface{}]{
+			Getter: func(context.Context, interface{}) (interface{}, error) {
+				return v, nil
+			},
+		}
+	}
+	m := pcommon.NewMap()
+	m.PutStr("a", "b")
+
+	tests := []struct {
+		name     string
+		format   string
+		vals     []ottl.Getter[interface{}]
+		expected string
+	}{
+		{
+			name:     "strings and numbers",
+			format:   "%s-%d-%.2f",
+			vals:     []ottl.Getter[interface{}]{getter("a"), getter(int64(1)), getter(2.5)},
+			expected: "a-1-2.50",
+		},
+		{
+			name:     "map",
+			format:   "%v",
+			vals:     []ottl.Getter[interface{}]{getter(m)},
+			expected: "map[a:b]",
+		},
+		{
+			name:     "no values",
+			format:   "static",
+			expected: "static",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Format[interface{}](tt.format, tt.vals)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
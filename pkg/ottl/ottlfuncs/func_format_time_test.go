@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_FormatTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		format   string
+		expected interface{}
+		err      bool
+	}{
+		{
+			name:     "date",
+			value:    time.Date(2023, 2, 20, 13, 45, 1, 0, time.UTC).UnixNano(),
+			format:   "%Y-%m-%d",
+			expected: "2023-02-20",
+		},
+		{
+			name:     "date time with milliseconds",
+			value:    time.Date(2023, 2, 20, 13, 45, 1, 123000000, time.UTC).UnixNano(),
+			format:   "%Y-%m-%dT%H:%M:%S.%L",
+			expected: "2023-02-20T13:45:01.123",
+		},
+		{
+			name:   "not an int",
+			value:  "2023-02-20",
+			format: "%Y-%m-%d",
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := FormatTime[interface{}](&ottl.StandardTypeGetter[interface{}, int64]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.format)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_FormatTime_InvalidFormat(t *testing.T) {
+	_, err := FormatTime[interface{}](&ottl.StandardTypeGetter[interface{}, int64]{}, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Len returns the number of characters of a string, the number of bytes of a byte slice,
+// or the number of elements of a map or slice.
+func Len[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		switch v := val.(type) {
+		case string:
+			return int64(utf8.RuneCountInString(v)), nil
+		case []byte:
+			return int64(len(v)), nil
+		case pcommon.Map:
+			return int64(v.Len()), nil
+		case pcommon.Slice:
+			return int64(v.Len()), nil
+		case map[string]interface{}:
+			return int64(len(v)), nil
+		case []interface{}:
+			return int64(len(v)), nil
+		case []string:
+			return int64(len(v)), nil
+		case []int64:
+			return int64(len(v)), nil
+		case []float64:
+			return int64(len(v)), nil
+		case []bool:
+			return int64(len(v)), nil
+		}
+		return nil, fmt.Errorf("unsupported type for Len: %T", val)
+	}, nil
+}
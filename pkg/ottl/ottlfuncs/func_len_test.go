@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Len(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutStr("a", "b")
+	m.PutInt("c", 1)
+	s := pcommon.NewSlice()
+	s.AppendEmpty().SetStr("a")
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+		err      bool
+	}{
+		{
+			name:     "string",
+			value:    "héllo",
+			expected: int64(5),
+		},
+		{
+			name:     "bytes",
+			value:    []byte{1, 2, 3},
+			expected: int64(3),
+		},
+		{
+			name:     "map",
+			value:    m,
+			expected: int64(2),
+		},
+		{
+			name:     "slice",
+			value:    s,
+			expected: int64(1),
+		},
+		{
+			name:     "string slice",
+			value:    []string{"a", "b"},
+			expected: int64(2),
+		},
+		{
+			name:  "int",
+			value: int64(1),
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Len[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/observiq/ctimefmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Time parses the target string with the given strptime format and returns the number of
+// nanoseconds since the Unix epoch, the representation used by the time_unix_nano paths.
+func Time[K any](target ottl.StringGetter[K], format string) (ottl.ExprFunc[K], error) {
+	if format == "" {
+		return nil, fmt.Errorf("format cannot be empty")
+	}
+	// Validate the format once instead of failing on every item.
+	if _, err := ctimefmt.ToNative(format); err != nil {
+		return nil, fmt.Errorf("invalid time format %q: %w", format, err)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		t, err := ctimefmt.Parse(format, val)
+		if err != nil {
+			return nil, err
+		}
+		return t.UnixNano(), nil
+	}, nil
+}
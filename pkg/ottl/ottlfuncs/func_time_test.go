@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Time(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		format   string
+		expected interface{}
+		err      bool
+	}{
+		{
+			name:     "date time",
+			value:    "2023-02-20 13:45:01",
+			format:   "%Y-%m-%d %H:%M:%S",
+			expected: time.Date(2023, 2, 20, 13, 45, 1, 0, time.UTC).UnixNano(),
+		},
+		{
+			name:     "with timezone offset",
+			value:    "20/Feb/2023:13:45:01 +0100",
+			format:   "%d/%b/%Y:%H:%M:%S %z",
+			expected: time.Date(2023, 2, 20, 12, 45, 1, 0, time.UTC).UnixNano(),
+		},
+		{
+			name:   "not matching the format",
+			value:  "yesterday",
+			format: "%Y-%m-%d",
+			err:    true,
+		},
+		{
+			name:   "not a string",
+			value:  int64(1),
+			format: "%Y-%m-%d",
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Time[interface{}](&ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(context.Context, interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.format)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Time_InvalidFormat(t *testing.T) {
+	target := &ottl.StandardTypeGetter[interface{}, string]{}
+	_, err := Time[interface{}](target, "")
+	assert.Error(t, err)
+	_, err = Time[interface{}](target, "%Y-%m-%d %Q")
+	assert.Error(t, err)
+}
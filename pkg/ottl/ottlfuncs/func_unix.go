@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Unix returns the number of nanoseconds since the Unix epoch of the time described by a number
+// of seconds and nanoseconds since the Unix epoch, like time.Unix.
+func Unix[K any](seconds ottl.IntGetter[K], nanoseconds ottl.IntGetter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		sec, err := seconds.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		nsec, err := nanoseconds.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return time.Unix(sec, nsec).UnixNano(), nil
+	}, nil
+}
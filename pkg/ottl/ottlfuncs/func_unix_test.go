@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Unix(t *testing.T) {
+	intGetter := func(v interface{}) ottl.IntGetter[interface{}] {
+		return &ottl.StandardTypeGetter[interface{}, int64]{
+			Getter: func(context.Context, interface{}) (interface{}, error) {
+				return v, nil
+			},
+		}
+	}
+
+	exprFunc, err := Unix[interface{}](intGetter(int64(1676900701)), intGetter(int64(500)))
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1676900701000000500), result)
+
+	exprFunc, err = Unix[interface{}](intGetter("1676900701"), intGetter(int64(0)))
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -76,6 +77,20 @@ func (s *Statement[K]) Execute(ctx context.Context, tCtx K) (any, bool, error) {
 	return result, condition, nil
 }
 
+// ErrStop is returned by an editor to stop executing the remaining statements and statement groups
+// of the Statements it is executed by, for the current item. Other Statements executed for the same
+// item are not affected. It is not reported as an error by Statements.
+var ErrStop = errors.New("stop executing statements")
+
+// StatementGroup holds a list of statements sharing a list of conditions. The statements of the group
+// are only executed if any of the conditions is met. The conditions are evaluated once, before
+// executing the first statement of the group.
+type StatementGroup[K any] struct {
+	condition      BoolExpr[K]
+	statements     []*Statement[K]
+	origConditions []string
+}
+
 func NewParser[K any](
 	functions map[string]interface{},
 	pathParser PathExpressionParser[K],
@@ -139,8 +154,62 @@ func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
 	}, nil
 }
 
+// ParseStatementGroup parses the given conditions and statements into a StatementGroup.
+// The statements of a group without conditions are always executed.
+func (p *Parser[K]) ParseStatementGroup(conditions []string, statements []string) (*StatementGroup[K], error) {
+	condition, err := p.ParseConditions(conditions)
+	if err != nil {
+		return nil, err
+	}
+	parsedStatements, err := p.ParseStatements(statements)
+	if err != nil {
+		return nil, err
+	}
+	return &StatementGroup[K]{
+		condition:      condition,
+		statements:     parsedStatements,
+		origConditions: conditions,
+	}, nil
+}
+
+// ParseConditions parses the given conditions into a BoolExpr that is true if any of the
+// conditions is true, or that is always true if there are no conditions.
+func (p *Parser[K]) ParseConditions(conditions []string) (BoolExpr[K], error) {
+	if len(conditions) == 0 {
+		return BoolExpr[K]{alwaysTrue[K]}, nil
+	}
+	var exprs []BoolExpr[K]
+	for _, condition := range conditions {
+		parsed, err := parseCondition(condition)
+		if err != nil {
+			return BoolExpr[K]{}, err
+		}
+		expr, err := p.newBoolExpr(parsed)
+		if err != nil {
+			return BoolExpr[K]{}, err
+		}
+		exprs = append(exprs, expr)
+	}
+	return orFuncs(exprs), nil
+}
+
 var parser = newParser[parsedStatement]()
 
+var conditionParser = newParser[booleanExpression]()
+
+func parseCondition(raw string) (*booleanExpression, error) {
+	parsed, err := conditionParser.ParseString("", raw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse OTTL condition: %w", err)
+	}
+	err = parsed.checkForCustomError()
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
 func parseStatement(raw string) (*parsedStatement, error) {
 	parsed, err := parser.ParseString("", raw)
 
@@ -171,9 +240,9 @@ func newParser[G any]() *participle.Parser[G] {
 	return parser
 }
 
-// Statements represents a list of statements that will be executed sequentially for a TransformContext.
+// Statements represents a list of statement groups that will be executed sequentially for a TransformContext.
 type Statements[K any] struct {
-	statements        []*Statement[K]
+	groups            []*StatementGroup[K]
 	errorMode         ErrorMode
 	telemetrySettings component.TelemetrySettings
 }
@@ -187,8 +256,16 @@ func WithErrorMode[K any](errorMode ErrorMode) StatementsOption[K] {
 }
 
 func NewStatements[K any](statements []*Statement[K], telemetrySettings component.TelemetrySettings, options ...StatementsOption[K]) Statements[K] {
+	group := &StatementGroup[K]{
+		condition:  BoolExpr[K]{alwaysTrue[K]},
+		statements: statements,
+	}
+	return NewStatementGroups([]*StatementGroup[K]{group}, telemetrySettings, options...)
+}
+
+func NewStatementGroups[K any](groups []*StatementGroup[K], telemetrySettings component.TelemetrySettings, options ...StatementsOption[K]) Statements[K] {
 	s := Statements[K]{
-		statements:        statements,
+		groups:            groups,
 		telemetrySettings: telemetrySettings,
 	}
 	for _, op := range options {
@@ -198,16 +275,48 @@ func NewStatements[K any](statements []*Statement[K], telemetrySettings componen
 }
 
 // Execute is a function that will execute all the statements in the Statements list.
+// Execution stops for the current TransformContext when a statement returns ErrStop.
 func (s *Statements[K]) Execute(ctx context.Context, tCtx K) error {
-	for _, statement := range s.statements {
+	for _, group := range s.groups {
+		stop, err := s.executeGroup(ctx, tCtx, group)
+		if err != nil {
+			return err
+		}
+		if stop {
+			return nil
+		}
+	}
+	return nil
+}
+
+// executeGroup executes the statements of the group if its conditions are met.
+// Returns true if a statement requested to stop the execution.
+func (s *Statements[K]) executeGroup(ctx context.Context, tCtx K, group *StatementGroup[K]) (bool, error) {
+	condition, err := group.condition.Eval(ctx, tCtx)
+	if err != nil {
+		if s.errorMode == PropagateError {
+			err = fmt.Errorf("failed to evaluate statement group conditions: %v, %w", group.origConditions, err)
+			return false, err
+		}
+		s.telemetrySettings.Logger.Warn("failed to evaluate statement group conditions", zap.Error(err), zap.Strings("conditions", group.origConditions))
+		return false, nil
+	}
+	if !condition {
+		return false, nil
+	}
+
+	for _, statement := range group.statements {
 		_, _, err := statement.Execute(ctx, tCtx)
+		if errors.Is(err, ErrStop) {
+			return true, nil
+		}
 		if err != nil {
 			if s.errorMode == PropagateError {
 				err = fmt.Errorf("failed to execute statement: %v, %w", statement.origText, err)
-				return err
+				return false, err
 			}
 			s.telemetrySettings.Logger.Warn("failed to execute statement", zap.Error(err), zap.String("statement", statement.origText))
 		}
 	}
-	return nil
+	return false, nil
 }
@@ -1363,10 +1363,15 @@ func Test_Execute_Error(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			statements := Statements[interface{}]{
-				statements: []*Statement[interface{}]{
+				groups: []*StatementGroup[interface{}]{
 					{
-						condition: BoolExpr[any]{tt.condition},
-						function:  Expr[any]{exprFunc: tt.function},
+						condition: BoolExpr[any]{alwaysTrue[any]},
+						statements: []*Statement[interface{}]{
+							{
+								condition: BoolExpr[any]{tt.condition},
+								function:  Expr[any]{exprFunc: tt.function},
+							},
+						},
 					},
 				},
 				errorMode:         tt.errorMode,
@@ -1382,3 +1387,249 @@ func Test_Execute_Error(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseCondition(t *testing.T) {
+	tests := []struct {
+		condition string
+		wantErr   bool
+	}{
+		{`name == "fido"`, false},
+		{`name == "fido" and attributes["x"] != nil`, false},
+		{`(name == "fido" or name == "rex") and true`, false},
+		{`not name == "fido"`, false},
+		{`name`, true},
+		{`name ==`, true},
+		{`name = "fido"`, true},
+		{`set(name, "fido")`, true},
+		{`name == "fido" where true`, true},
+		{`one() == 1`, true},
+	}
+	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
+	for _, tt := range tests {
+		name := pat.ReplaceAllString(tt.condition, "_")
+		t.Run(name, func(t *testing.T) {
+			_, err := parseCondition(tt.condition)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseCondition(%s) error = %v, wantErr %v", tt.condition, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_StatementGroups_Execute(t *testing.T) {
+	var executed []string
+	functions := map[string]interface{}{
+		"record": func(value string) (ExprFunc[any], error) {
+			return func(context.Context, any) (interface{}, error) {
+				executed = append(executed, value)
+				return nil, nil
+			}, nil
+		},
+		"fail": func() (ExprFunc[any], error) {
+			return func(context.Context, any) (interface{}, error) {
+				return nil, fmt.Errorf("failed")
+			}, nil
+		},
+		"stop": func() (ExprFunc[any], error) {
+			return func(context.Context, any) (interface{}, error) {
+				return nil, ErrStop
+			}, nil
+		},
+	}
+	p, err := NewParser[any](functions, testParsePath, componenttest.NewNopTelemetrySettings())
+	assert.NoError(t, err)
+
+	type group struct {
+		conditions []string
+		statements []string
+	}
+	tests := []struct {
+		name      string
+		groups    []group
+		tCtx      string
+		errorMode ErrorMode
+		expected  []string
+		wantErr   bool
+	}{
+		{
+			name: "group without conditions",
+			groups: []group{
+				{statements: []string{`record("a")`, `record("b")`}},
+			},
+			tCtx:     "fido",
+			expected: []string{"a", "b"},
+		},
+		{
+			name: "matching and non matching groups",
+			groups: []group{
+				{conditions: []string{`name == "rex"`}, statements: []string{`record("a")`}},
+				{conditions: []string{`name == "rex"`, `name == "fido"`}, statements: []string{`record("b")`, `record("c") where name == "rex"`}},
+				{statements: []string{`record("d")`}},
+			},
+			tCtx:     "fido",
+			expected: []string{"b", "d"},
+		},
+		{
+			name: "stop skips the remaining statements and groups",
+			groups: []group{
+				{statements: []string{`record("a")`, `stop() where name == "fido"`, `record("b")`}},
+				{statements: []string{`record("c")`}},
+			},
+			tCtx:     "fido",
+			expected: []string{"a"},
+		},
+		{
+			name: "stop not matched",
+			groups: []group{
+				{statements: []string{`record("a")`, `stop() where name == "rex"`, `record("b")`}},
+			},
+			tCtx:     "fido",
+			expected: []string{"a", "b"},
+		},
+		{
+			name: "stop is not an error",
+			groups: []group{
+				{statements: []string{`stop()`}},
+			},
+			tCtx:      "fido",
+			errorMode: PropagateError,
+		},
+		{
+			name: "ignore statement error",
+			groups: []group{
+				{statements: []string{`fail()`, `record("a")`}},
+			},
+			tCtx:      "fido",
+			errorMode: IgnoreError,
+			expected:  []string{"a"},
+		},
+		{
+			name: "propagate statement error",
+			groups: []group{
+				{statements: []string{`fail()`, `record("a")`}},
+			},
+			tCtx:      "fido",
+			errorMode: PropagateError,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executed = nil
+			var groups []*StatementGroup[any]
+			for _, g := range tt.groups {
+				parsed, err := p.ParseStatementGroup(g.conditions, g.statements)
+				assert.NoError(t, err)
+				groups = append(groups, parsed)
+			}
+			statements := NewStatementGroups(groups, componenttest.NewNopTelemetrySettings(), WithErrorMode[any](tt.errorMode))
+
+			err := statements.Execute(context.Background(), tt.tCtx)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, executed)
+		})
+	}
+}
+
+func Test_StatementGroups_Execute_ConditionError(t *testing.T) {
+	for _, errorMode := range []ErrorMode{IgnoreError, PropagateError} {
+		t.Run(string(errorMode), func(t *testing.T) {
+			executed := false
+			statements := Statements[any]{
+				groups: []*StatementGroup[any]{
+					{
+						condition: BoolExpr[any]{func(context.Context, any) (bool, error) {
+							return true, fmt.Errorf("test")
+						}},
+						statements: []*Statement[any]{
+							{
+								condition: BoolExpr[any]{alwaysTrue[any]},
+								function: Expr[any]{exprFunc: func(context.Context, any) (interface{}, error) {
+									executed = true
+									return nil, nil
+								}},
+							},
+						},
+						origConditions: []string{"test"},
+					},
+				},
+				errorMode:         errorMode,
+				telemetrySettings: componenttest.NewNopTelemetrySettings(),
+			}
+
+			err := statements.Execute(context.Background(), nil)
+			if errorMode == PropagateError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.False(t, executed, "statements must not run when the group conditions can't be evaluated")
+		})
+	}
+}
+
+func Test_ParseStatementGroup_Error(t *testing.T) {
+	p, err := NewParser[any](defaultFunctionsForTests(), testParsePath, componenttest.NewNopTelemetrySettings())
+	assert.NoError(t, err)
+
+	_, err = p.ParseStatementGroup([]string{`name ==`}, []string{`testing_string("a")`})
+	assert.Error(t, err)
+
+	_, err = p.ParseStatementGroup([]string{`name == "fido"`}, []string{`testing_string(`})
+	assert.Error(t, err)
+
+	_, err = p.ParseStatementGroup([]string{`bad_path == "fido"`}, nil)
+	assert.Error(t, err)
+}
+
+func Test_ParseConditions(t *testing.T) {
+	p, err := NewParser[any](defaultFunctionsForTests(), testParsePath, componenttest.NewNopTelemetrySettings())
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		conditions []string
+		tCtx       string
+		expected   bool
+	}{
+		{
+			name:     "no conditions",
+			tCtx:     "fido",
+			expected: true,
+		},
+		{
+			name:       "condition met",
+			conditions: []string{`name == "fido"`},
+			tCtx:       "fido",
+			expected:   true,
+		},
+		{
+			name:       "condition not met",
+			conditions: []string{`name == "fido"`},
+			tCtx:       "rex",
+			expected:   false,
+		},
+		{
+			name:       "any condition met",
+			conditions: []string{`name == "fido"`, `name == "rex"`},
+			tCtx:       "rex",
+			expected:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := p.ParseConditions(tt.conditions)
+			assert.NoError(t, err)
+			result, err := condition.Eval(context.Background(), tt.tCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	_, err = p.ParseConditions([]string{`name ==`})
+	assert.Error(t, err)
+}
@@ -0,0 +1,210 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/cef"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "cef_parser"
+
+	cefPrefix = "CEF:"
+
+	// headerFieldCount is the number of pipe separated fields preceding the extension.
+	headerFieldCount = 7
+)
+
+// headerFields are the names of the CEF header fields, in order.
+var headerFields = [headerFieldCount]string{
+	"version",
+	"device_vendor",
+	"device_product",
+	"device_version",
+	"device_event_class_id",
+	"name",
+	"severity",
+}
+
+// extensionKey matches valid keys of the extension. Custom extension keys
+// may contain dots, dashes and brackets besides alphanumerics.
+var extensionKey = regexp.MustCompile(`^[A-Za-z0-9_.\[\]-]+$`)
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new CEF parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new CEF parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a CEF parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+}
+
+// Build will build a CEF parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator: parserOperator,
+	}, nil
+}
+
+// Parser is an operator that parses ArcSight Common Event Format messages.
+type Parser struct {
+	helper.ParserOperator
+}
+
+// Process will parse an entry for a CEF message.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as a CEF message.
+func (p *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return parseCEF(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as CEF", value)
+	}
+}
+
+// parseCEF parses a message of the form
+//
+//	CEF:Version|Device Vendor|Device Product|Device Version|Device Event Class ID|Name|Severity|Extension
+//
+// Anything preceding the "CEF:" marker, such as a syslog header, is ignored.
+func parseCEF(input string) (map[string]interface{}, error) {
+	start := strings.Index(input, cefPrefix)
+	if start < 0 {
+		return nil, fmt.Errorf("missing %q prefix", cefPrefix)
+	}
+	input = input[start+len(cefPrefix):]
+
+	parsed := make(map[string]interface{}, headerFieldCount+1)
+	for i := 0; i < headerFieldCount; i++ {
+		end := indexUnescaped(input, '|')
+		if end < 0 {
+			return nil, fmt.Errorf("expected %d header fields, got %d", headerFieldCount, i)
+		}
+		parsed[headerFields[i]] = unescapeHeader(input[:end])
+		input = input[end+1:]
+	}
+
+	extensions, err := parseExtension(input)
+	if err != nil {
+		return nil, err
+	}
+	parsed["extensions"] = extensions
+
+	return parsed, nil
+}
+
+// parseExtension parses the space separated key=value pairs of the extension.
+// Values may contain unescaped spaces, so a value ends where the next key begins.
+func parseExtension(input string) (map[string]interface{}, error) {
+	extensions := make(map[string]interface{})
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return extensions, nil
+	}
+
+	type pair struct {
+		key        string
+		keyStart   int
+		valueStart int
+	}
+
+	var pairs []pair
+	for i := 0; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			i++
+		case '=':
+			keyStart := strings.LastIndexByte(input[:i], ' ') + 1
+			if len(pairs) > 0 && keyStart < pairs[len(pairs)-1].valueStart {
+				// an unescaped equal sign within a value
+				continue
+			}
+			key := input[keyStart:i]
+			if !extensionKey.MatchString(key) {
+				continue
+			}
+			pairs = append(pairs, pair{key: key, keyStart: keyStart, valueStart: i + 1})
+		}
+	}
+
+	if len(pairs) == 0 || pairs[0].keyStart != 0 {
+		return nil, fmt.Errorf("malformed extension: %q", input)
+	}
+
+	for i, p := range pairs {
+		valueEnd := len(input)
+		if i+1 < len(pairs) {
+			valueEnd = pairs[i+1].keyStart
+		}
+		extensions[p.key] = unescapeExtension(strings.TrimRight(input[p.valueStart:valueEnd], " "))
+	}
+	return extensions, nil
+}
+
+// indexUnescaped returns the index of the first occurrence of c in s that is
+// not preceded by a backslash escape, or -1 if there is none.
+func indexUnescaped(s string, c byte) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case c:
+			return i
+		}
+	}
+	return -1
+}
+
+var headerReplacer = strings.NewReplacer(`\\`, `\`, `\|`, `|`)
+
+func unescapeHeader(s string) string {
+	return headerReplacer.Replace(s)
+}
+
+var extensionReplacer = strings.NewReplacer(`\\`, `\`, `\=`, `=`, `\n`, "\n", `\r`, "\r", `\|`, `|`)
+
+func unescapeExtension(s string) string {
+	return extensionReplacer.Replace(s)
+}
@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cef
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("cef_parser")
+	require.True(t, ok, "expected cef_parser to be registered")
+	require.Equal(t, "cef_parser", builder().Type())
+}
+
+func TestConfigBuild(t *testing.T) {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	require.IsType(t, &Parser{}, op)
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	_, err := config.Build(testutil.Logger(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as CEF")
+}
+
+func TestParserInvalid(t *testing.T) {
+	cases := map[string]struct {
+		input       string
+		expectedErr string
+	}{
+		"missing prefix": {
+			input:       "0|Security|threatmanager|1.0|100|worm successfully stopped|10|",
+			expectedErr: `missing "CEF:" prefix`,
+		},
+		"missing header fields": {
+			input:       "CEF:0|Security|threatmanager|1.0",
+			expectedErr: "expected 7 header fields, got 3",
+		},
+		"malformed extension": {
+			input:       "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|no pairs here",
+			expectedErr: `malformed extension: "no pairs here"`,
+		},
+		"extension without leading key": {
+			input:       "CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|junk src=10.0.0.1",
+			expectedErr: `malformed extension: "junk src=10.0.0.1"`,
+		},
+	}
+
+	parser := newTestParser(t)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parser.parse(tc.input)
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  string
+		expect map[string]interface{}
+	}{
+		{
+			"spec-example",
+			"CEF:0|Security|threatmanager|1.0|100|worm successfully stopped|10|src=10.0.0.1 dst=2.1.2.2 spt=1232",
+			map[string]interface{}{
+				"version":               "0",
+				"device_vendor":         "Security",
+				"device_product":        "threatmanager",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "worm successfully stopped",
+				"severity":              "10",
+				"extensions": map[string]interface{}{
+					"src": "10.0.0.1",
+					"dst": "2.1.2.2",
+					"spt": "1232",
+				},
+			},
+		},
+		{
+			"syslog-prefix-and-empty-extension",
+			"Sep 19 08:26:10 host CEF:1|Vendor|Product|2.0|sig|Name|Low|",
+			map[string]interface{}{
+				"version":               "1",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "2.0",
+				"device_event_class_id": "sig",
+				"name":                  "Name",
+				"severity":              "Low",
+				"extensions":            map[string]interface{}{},
+			},
+		},
+		{
+			"escaped-header",
+			`CEF:0|security\|vendor|threat\\manager|1.0|100|detected a \| in message|10|src=10.0.0.1`,
+			map[string]interface{}{
+				"version":               "0",
+				"device_vendor":         "security|vendor",
+				"device_product":        `threat\manager`,
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "detected a | in message",
+				"severity":              "10",
+				"extensions": map[string]interface{}{
+					"src": "10.0.0.1",
+				},
+			},
+		},
+		{
+			"extension-values-with-spaces-and-escapes",
+			`CEF:0|Vendor|Product|1.0|100|Name|5|msg=Detected a threat.\nNo action needed. cs1Label=Rule Name cs1=a\=b c:\\temp act=blocked  `,
+			map[string]interface{}{
+				"version":               "0",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "Name",
+				"severity":              "5",
+				"extensions": map[string]interface{}{
+					"msg":      "Detected a threat.\nNo action needed.",
+					"cs1Label": "Rule Name",
+					"cs1":      `a=b c:\temp`,
+					"act":      "blocked",
+				},
+			},
+		},
+		{
+			"pipe-and-unescaped-equals-in-extension",
+			`CEF:0|Vendor|Product|1.0|100|Name|5|request=https://example.com/?a=1 suser=bob|admin flexString1=`,
+			map[string]interface{}{
+				"version":               "0",
+				"device_vendor":         "Vendor",
+				"device_product":        "Product",
+				"device_version":        "1.0",
+				"device_event_class_id": "100",
+				"name":                  "Name",
+				"severity":              "5",
+				"extensions": map[string]interface{}{
+					"request":     "https://example.com/?a=1",
+					"suser":       "bob|admin",
+					"flexString1": "",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.OutputIDs = []string{"fake"}
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			ots := time.Now()
+			input := &entry.Entry{Body: tc.input, ObservedTimestamp: ots}
+			expect := &entry.Entry{Body: tc.input, Attributes: tc.expect, ObservedTimestamp: ots}
+
+			require.NoError(t, op.Process(context.Background(), input))
+			fake.ExpectEntry(t, expect)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leef // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/leef"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	operatorType = "leef_parser"
+
+	leefPrefix = "LEEF:"
+
+	defaultDelimiter = "\t"
+)
+
+// headerFields are the names of the LEEF header fields common to all versions, in order.
+var headerFields = []string{
+	"version",
+	"vendor",
+	"product",
+	"product_version",
+	"event_id",
+}
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new LEEF parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new LEEF parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+	}
+}
+
+// Config is the configuration of a LEEF parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+}
+
+// Build will build a LEEF parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Parser{
+		ParserOperator: parserOperator,
+	}, nil
+}
+
+// Parser is an operator that parses IBM QRadar Log Event Extended Format messages.
+type Parser struct {
+	helper.ParserOperator
+}
+
+// Process will parse an entry for a LEEF message.
+func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return p.ParserOperator.ProcessWith(ctx, entry, p.parse)
+}
+
+// parse will parse a value as a LEEF message.
+func (p *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return parseLEEF(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as LEEF", value)
+	}
+}
+
+// parseLEEF parses a message of either form
+//
+//	LEEF:1.0|Vendor|Product|Version|EventID|key=value<tab>key=value
+//	LEEF:2.0|Vendor|Product|Version|EventID|DelimiterCharacter|key=value<delimiter>key=value
+//
+// Anything preceding the "LEEF:" marker, such as a syslog header, is ignored.
+func parseLEEF(input string) (map[string]interface{}, error) {
+	start := strings.Index(input, leefPrefix)
+	if start < 0 {
+		return nil, fmt.Errorf("missing %q prefix", leefPrefix)
+	}
+	input = input[start+len(leefPrefix):]
+
+	parsed := make(map[string]interface{}, len(headerFields)+1)
+	for i, field := range headerFields {
+		end := strings.IndexByte(input, '|')
+		if end < 0 {
+			return nil, fmt.Errorf("expected %d header fields, got %d", len(headerFields), i)
+		}
+		parsed[field] = input[:end]
+		input = input[end+1:]
+	}
+
+	delimiter := defaultDelimiter
+	if version := parsed["version"].(string); version != "1.0" && version != "1" {
+		end := strings.IndexByte(input, '|')
+		if end < 0 {
+			return nil, fmt.Errorf("missing delimiter header field for LEEF version %s", version)
+		}
+
+		var err error
+		delimiter, err = parseDelimiter(input[:end])
+		if err != nil {
+			return nil, err
+		}
+		input = input[end+1:]
+	}
+
+	attributes := make(map[string]interface{})
+	for _, pair := range strings.Split(input, delimiter) {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("expected %q to be a key=value pair", pair)
+		}
+		attributes[strings.TrimSpace(key)] = value
+	}
+	parsed["attributes"] = attributes
+
+	return parsed, nil
+}
+
+// parseDelimiter interprets the LEEF 2.0 delimiter header field, which is
+// either a single character or its hex code point such as "x09" or "0x09".
+// An empty field means the default tab delimiter.
+func parseDelimiter(field string) (string, error) {
+	switch {
+	case field == "":
+		return defaultDelimiter, nil
+	case len(field) == 1:
+		return field, nil
+	}
+
+	lower := strings.ToLower(field)
+	if !strings.HasPrefix(lower, "x") && !strings.HasPrefix(lower, "0x") {
+		return "", fmt.Errorf("invalid delimiter %q", field)
+	}
+	hex := lower[strings.IndexByte(lower, 'x')+1:]
+	code, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return "", fmt.Errorf("invalid delimiter %q: %w", field, err)
+	}
+	return string(rune(code)), nil
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leef
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("leef_parser")
+	require.True(t, ok, "expected leef_parser to be registered")
+	require.Equal(t, "leef_parser", builder().Type())
+}
+
+func TestConfigBuild(t *testing.T) {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	require.IsType(t, &Parser{}, op)
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	_, err := config.Build(testutil.Logger(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid `on_error` field")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as LEEF")
+}
+
+func TestParserInvalid(t *testing.T) {
+	cases := map[string]struct {
+		input       string
+		expectedErr string
+	}{
+		"missing prefix": {
+			input:       "1.0|Microsoft|MSExchange|4.0 SP1|15345|src=10.50.1.1",
+			expectedErr: `missing "LEEF:" prefix`,
+		},
+		"missing header fields": {
+			input:       "LEEF:1.0|Microsoft|MSExchange",
+			expectedErr: "expected 5 header fields, got 2",
+		},
+		"missing delimiter field": {
+			input:       "LEEF:2.0|Lancope|StealthWatch|1.0|41|src=10.0.1.8",
+			expectedErr: "missing delimiter header field for LEEF version 2.0",
+		},
+		"invalid delimiter": {
+			input:       "LEEF:2.0|Lancope|StealthWatch|1.0|41|ab|src=10.0.1.8",
+			expectedErr: `invalid delimiter "ab"`,
+		},
+		"invalid pair": {
+			input:       "LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345|src=10.50.1.1\tnovalue",
+			expectedErr: `expected "novalue" to be a key=value pair`,
+		},
+	}
+
+	parser := newTestParser(t)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parser.parse(tc.input)
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  string
+		expect map[string]interface{}
+	}{
+		{
+			"version-1",
+			"LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345|src=10.50.1.1\tdst=2.10.20.20\tspt=1200\tmsg=user logged in",
+			map[string]interface{}{
+				"version":         "1.0",
+				"vendor":          "Microsoft",
+				"product":         "MSExchange",
+				"product_version": "4.0 SP1",
+				"event_id":        "15345",
+				"attributes": map[string]interface{}{
+					"src": "10.50.1.1",
+					"dst": "2.10.20.20",
+					"spt": "1200",
+					"msg": "user logged in",
+				},
+			},
+		},
+		{
+			"version-2-character-delimiter",
+			"<13>Jan 18 11:07:53 host LEEF:2.0|Lancope|StealthWatch|1.0|41|^|src=10.0.1.8^dst=10.0.0.5^sev=5^url=https://example.com/?a=b",
+			map[string]interface{}{
+				"version":         "2.0",
+				"vendor":          "Lancope",
+				"product":         "StealthWatch",
+				"product_version": "1.0",
+				"event_id":        "41",
+				"attributes": map[string]interface{}{
+					"src": "10.0.1.8",
+					"dst": "10.0.0.5",
+					"sev": "5",
+					"url": "https://example.com/?a=b",
+				},
+			},
+		},
+		{
+			"version-2-hex-delimiter",
+			"LEEF:2.0|Vendor|Product|1.0|login|0x7c|usrName=alice|role=admin",
+			map[string]interface{}{
+				"version":         "2.0",
+				"vendor":          "Vendor",
+				"product":         "Product",
+				"product_version": "1.0",
+				"event_id":        "login",
+				"attributes": map[string]interface{}{
+					"usrName": "alice",
+					"role":    "admin",
+				},
+			},
+		},
+		{
+			"version-2-default-delimiter",
+			"LEEF:2.0|Vendor|Product|1.0|login||usrName=alice\t",
+			map[string]interface{}{
+				"version":         "2.0",
+				"vendor":          "Vendor",
+				"product":         "Product",
+				"product_version": "1.0",
+				"event_id":        "login",
+				"attributes": map[string]interface{}{
+					"usrName": "alice",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.OutputIDs = []string{"fake"}
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			ots := time.Now()
+			input := &entry.Entry{Body: tc.input, ObservedTimestamp: ots}
+			expect := &entry.Entry{Body: tc.input, Attributes: tc.expect, ObservedTimestamp: ots}
+
+			require.NoError(t, op.Process(context.Background(), input))
+			fake.ExpectEntry(t, expect)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xml // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/xml"
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "xml_parser"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new XML parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new XML parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig:    helper.NewParserConfig(operatorID, operatorType),
+		AttributePrefix: "@",
+		TextKey:         "#text",
+	}
+}
+
+// Config is the configuration of an XML parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+
+	AttributePrefix string `mapstructure:"attribute_prefix"`
+	TextKey         string `mapstructure:"text_key"`
+}
+
+// Build will build an XML parser operator.
+func (c Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.TextKey == "" {
+		return nil, errors.New("text_key is a required parameter")
+	}
+
+	if c.AttributePrefix == "" {
+		return nil, errors.New("attribute_prefix is a required parameter")
+	}
+
+	return &Parser{
+		ParserOperator:  parserOperator,
+		attributePrefix: c.AttributePrefix,
+		textKey:         c.TextKey,
+	}, nil
+}
+
+// Parser is an operator that parses XML.
+type Parser struct {
+	helper.ParserOperator
+	attributePrefix string
+	textKey         string
+}
+
+// element is an XML element that is being decoded.
+type element struct {
+	name     string
+	fields   map[string]interface{}
+	text     strings.Builder
+	children bool
+}
+
+// Process will parse an entry for XML.
+func (x *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	return x.ParserOperator.ProcessWith(ctx, entry, x.parse)
+}
+
+// parse will parse a value as XML.
+func (x *Parser) parse(value interface{}) (interface{}, error) {
+	switch m := value.(type) {
+	case string:
+		return x.parser(m)
+	default:
+		return nil, fmt.Errorf("type %T cannot be parsed as XML", value)
+	}
+}
+
+// parser converts an XML document into a map holding its root element.
+// Attributes are stored under their name prefixed with the attribute prefix,
+// child elements under their name, with repeated elements collected into a
+// slice. Elements with neither attributes nor children are stored as their
+// text content; otherwise any text content is stored under the text key.
+func (x *Parser) parser(input string) (map[string]interface{}, error) {
+	decoder := xml.NewDecoder(strings.NewReader(input))
+
+	var root map[string]interface{}
+	var stack []*element
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse XML: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if root != nil {
+				return nil, errors.New("parse XML: document has more than one root element")
+			}
+			el := &element{name: t.Name.Local, fields: map[string]interface{}{}}
+			for _, attr := range t.Attr {
+				el.fields[x.attributePrefix+attr.Name.Local] = attr.Value
+			}
+			if len(stack) > 0 {
+				stack[len(stack)-1].children = true
+			}
+			stack = append(stack, el)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		case xml.EndElement:
+			el := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			value := x.elementValue(el)
+			if len(stack) == 0 {
+				root = map[string]interface{}{el.name: value}
+				continue
+			}
+			addField(stack[len(stack)-1].fields, el.name, value)
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("parse XML: no root element found")
+	}
+	return root, nil
+}
+
+func (x *Parser) elementValue(el *element) interface{} {
+	text := strings.TrimSpace(el.text.String())
+	if len(el.fields) == 0 && !el.children {
+		return text
+	}
+	if text != "" {
+		el.fields[x.textKey] = text
+	}
+	return el.fields
+}
+
+// addField stores value under key, turning the field into a slice when the
+// key is repeated.
+func addField(fields map[string]interface{}, key string, value interface{}) {
+	existing, ok := fields[key]
+	if !ok {
+		fields[key] = value
+		return
+	}
+	if values, ok := existing.([]interface{}); ok {
+		fields[key] = append(values, value)
+		return
+	}
+	fields[key] = []interface{}{existing, value}
+}
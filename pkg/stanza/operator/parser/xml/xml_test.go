@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xml
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T) *Parser {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	return op.(*Parser)
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("xml_parser")
+	require.True(t, ok, "expected xml_parser to be registered")
+	require.Equal(t, "xml_parser", builder().Type())
+}
+
+func TestConfigBuild(t *testing.T) {
+	config := NewConfigWithID("test")
+	op, err := config.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	require.IsType(t, &Parser{}, op)
+}
+
+func TestConfigBuildFailure(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.OnError = "invalid_on_error"
+	_, err := config.Build(testutil.Logger(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid `on_error` field")
+}
+
+func TestConfigBuildMissingKeys(t *testing.T) {
+	config := NewConfigWithID("test")
+	config.TextKey = ""
+	_, err := config.Build(testutil.Logger(t))
+	require.EqualError(t, err, "text_key is a required parameter")
+
+	config = NewConfigWithID("test")
+	config.AttributePrefix = ""
+	_, err = config.Build(testutil.Logger(t))
+	require.EqualError(t, err, "attribute_prefix is a required parameter")
+}
+
+func TestParserInvalidType(t *testing.T) {
+	parser := newTestParser(t)
+	_, err := parser.parse([]int{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type []int cannot be parsed as XML")
+}
+
+func TestParserInvalidXML(t *testing.T) {
+	cases := map[string]string{
+		"empty":         "",
+		"text only":     "not xml",
+		"unclosed":      "<event><id>1</id>",
+		"mismatched":    "<event></log>",
+		"multiple root": "<a/><b/>",
+	}
+
+	parser := newTestParser(t)
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parser.parse(input)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParser(t *testing.T) {
+	cases := []struct {
+		name      string
+		configure func(*Config)
+		input     string
+		expect    map[string]interface{}
+	}{
+		{
+			"text",
+			func(*Config) {},
+			"<message>hello</message>",
+			map[string]interface{}{"message": "hello"},
+		},
+		{
+			"nested",
+			func(*Config) {},
+			`<?xml version="1.0" encoding="UTF-8"?>
+<event>
+  <!-- a comment -->
+  <id>42</id>
+  <user><name>alice</name></user>
+</event>`,
+			map[string]interface{}{
+				"event": map[string]interface{}{
+					"id":   "42",
+					"user": map[string]interface{}{"name": "alice"},
+				},
+			},
+		},
+		{
+			"attributes-and-text",
+			func(*Config) {},
+			`<log level="warn" source="app">disk <![CDATA[almost]]> full</log>`,
+			map[string]interface{}{
+				"log": map[string]interface{}{
+					"@level":  "warn",
+					"@source": "app",
+					"#text":   "disk almost full",
+				},
+			},
+		},
+		{
+			"repeated-elements",
+			func(*Config) {},
+			`<hosts><host>a</host><host>b</host><host>c</host></hosts>`,
+			map[string]interface{}{
+				"hosts": map[string]interface{}{
+					"host": []interface{}{"a", "b", "c"},
+				},
+			},
+		},
+		{
+			"empty-element",
+			func(*Config) {},
+			`<event><tags/></event>`,
+			map[string]interface{}{
+				"event": map[string]interface{}{"tags": ""},
+			},
+		},
+		{
+			"custom-keys",
+			func(cfg *Config) {
+				cfg.AttributePrefix = "attr_"
+				cfg.TextKey = "value"
+			},
+			`<metric name="cpu">0.5</metric>`,
+			map[string]interface{}{
+				"metric": map[string]interface{}{
+					"attr_name": "cpu",
+					"value":     "0.5",
+				},
+			},
+		},
+		{
+			"escaped",
+			func(*Config) {},
+			`<msg>a &lt; b &amp;&amp; c &gt; d</msg>`,
+			map[string]interface{}{"msg": "a < b && c > d"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.OutputIDs = []string{"fake"}
+			tc.configure(cfg)
+
+			op, err := cfg.Build(testutil.Logger(t))
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			ots := time.Now()
+			input := &entry.Entry{Body: tc.input, ObservedTimestamp: ots}
+			expect := &entry.Entry{Body: tc.input, Attributes: tc.expect, ObservedTimestamp: ots}
+
+			require.NoError(t, op.Process(context.Background(), input))
+			fake.ExpectEntry(t, expect)
+		})
+	}
+}
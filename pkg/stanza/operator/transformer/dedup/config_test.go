@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedup
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
+)
+
+func TestUnmarshal(t *testing.T) {
+	operatortest.ConfigUnmarshalTests{
+		DefaultConfig: NewConfig(),
+		TestsFile:     filepath.Join(".", "testdata", "config.yaml"),
+		Tests: []operatortest.ConfigUnmarshalTest{
+			{
+				Name:   "default",
+				Expect: NewConfig(),
+			},
+			{
+				Name: "fields",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Fields = []entry.Field{
+						entry.NewBodyField("message"),
+						entry.NewAttributeField("host"),
+					}
+					return cfg
+				}(),
+			},
+			{
+				Name: "count_field",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.CountField = entry.NewBodyField("repeats")
+					return cfg
+				}(),
+			},
+			{
+				Name: "interval",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Interval = time.Minute
+					return cfg
+				}(),
+			},
+			{
+				Name: "max_entries",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.MaxEntries = 50
+					return cfg
+				}(),
+			},
+		},
+	}.Run(t)
+}
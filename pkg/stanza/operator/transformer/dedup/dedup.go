@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedup // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/dedup"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "dedup"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new dedup config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new dedup config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		TransformerConfig: helper.NewTransformerConfig(operatorID, operatorType),
+		Fields:            []entry.Field{entry.NewBodyField()},
+		CountField:        entry.NewAttributeField("log_count"),
+		Interval:          10 * time.Second,
+		MaxEntries:        10000,
+	}
+}
+
+// Config is the configuration of a dedup operator
+type Config struct {
+	helper.TransformerConfig `mapstructure:",squash"`
+	Fields                   []entry.Field `mapstructure:"fields"`
+	CountField               entry.Field   `mapstructure:"count_field"`
+	Interval                 time.Duration `mapstructure:"interval"`
+	MaxEntries               int           `mapstructure:"max_entries"`
+}
+
+// Build creates a new Transformer from a config
+func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	transformer, err := c.TransformerConfig.Build(logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transformer config: %w", err)
+	}
+
+	if len(c.Fields) == 0 {
+		return nil, fmt.Errorf("dedup: 'fields' is empty")
+	}
+
+	if c.CountField.FieldInterface == nil {
+		return nil, fmt.Errorf("missing required argument 'count_field'")
+	}
+
+	if c.Interval <= 0 {
+		return nil, fmt.Errorf("'interval' must be positive")
+	}
+
+	if c.MaxEntries <= 0 {
+		return nil, fmt.Errorf("'max_entries' must be positive")
+	}
+
+	return &Transformer{
+		TransformerOperator: transformer,
+		fields:              c.Fields,
+		countField:          c.CountField,
+		interval:            c.Interval,
+		maxEntries:          c.MaxEntries,
+		chClose:             make(chan struct{}),
+		pending:             make(map[string]*pendingEntry),
+	}, nil
+}
+
+// Transformer is an operator that collapses identical entries received
+// within an interval into a single entry carrying the number of repeats
+type Transformer struct {
+	helper.TransformerOperator
+	fields     []entry.Field
+	countField entry.Field
+	interval   time.Duration
+	maxEntries int
+	chClose    chan struct{}
+	stopOnce   sync.Once
+	wg         sync.WaitGroup
+
+	sync.Mutex
+	ticker  *time.Ticker
+	pending map[string]*pendingEntry
+	order   []string
+}
+
+// pendingEntry is the first entry seen for a key in the current interval
+type pendingEntry struct {
+	entry *entry.Entry
+	count int64
+}
+
+func (d *Transformer) Start(_ operator.Persister) error {
+	ticker := time.NewTicker(d.interval)
+	d.Lock()
+	d.ticker = ticker
+	d.Unlock()
+
+	d.wg.Add(1)
+	go d.flushLoop(ticker)
+
+	return nil
+}
+
+func (d *Transformer) flushLoop(ticker *time.Ticker) {
+	defer d.wg.Done()
+	for {
+		select {
+		case <-ticker.C:
+			d.flush(context.Background())
+		case <-d.chClose:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// Stop emits all pending entries before stopping the operator
+func (d *Transformer) Stop() error {
+	d.stopOnce.Do(func() {
+		close(d.chClose)
+		d.wg.Wait()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		d.flush(ctx)
+	})
+
+	return nil
+}
+
+func (d *Transformer) Process(ctx context.Context, e *entry.Entry) error {
+	skip, err := d.Skip(ctx, e)
+	if err != nil {
+		return d.HandleEntryError(ctx, e, err)
+	}
+	if skip {
+		d.Write(ctx, e)
+		return nil
+	}
+
+	key, err := d.key(e)
+	if err != nil {
+		return d.HandleEntryError(ctx, e, err)
+	}
+
+	d.Lock()
+	if p, ok := d.pending[key]; ok {
+		p.count++
+		d.Unlock()
+		return nil
+	}
+
+	d.pending[key] = &pendingEntry{entry: e, count: 1}
+	d.order = append(d.order, key)
+	if len(d.pending) < d.maxEntries {
+		d.Unlock()
+		return nil
+	}
+	d.Unlock()
+
+	d.Warn("Number of distinct entries reached max_entries. Flushing early. Consider increasing max_entries parameter")
+	d.flush(ctx)
+	return nil
+}
+
+// key builds the deduplication key from the values of the configured fields
+func (d *Transformer) key(e *entry.Entry) (string, error) {
+	values := make([]interface{}, len(d.fields))
+	for i, field := range d.fields {
+		values[i], _ = e.Get(field)
+	}
+
+	key, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("compute dedup key: %w", err)
+	}
+	return string(key), nil
+}
+
+// flush writes every pending entry, in order of first arrival, with the
+// number of times it was seen set on the count field. The entries are
+// written after releasing the lock, so that a blocked output doesn't
+// block the entries being processed.
+func (d *Transformer) flush(ctx context.Context) {
+	d.Lock()
+	entries := make([]*entry.Entry, 0, len(d.order))
+	for _, key := range d.order {
+		p := d.pending[key]
+		if err := p.entry.Set(d.countField, p.count); err != nil {
+			d.Errorw("Failed to set count field", zap.Error(err))
+		}
+		entries = append(entries, p.entry)
+	}
+
+	d.pending = make(map[string]*pendingEntry)
+	d.order = nil
+	if d.ticker != nil {
+		d.ticker.Reset(d.interval)
+	}
+	d.Unlock()
+
+	for _, e := range entries {
+		d.Write(ctx, e)
+	}
+}
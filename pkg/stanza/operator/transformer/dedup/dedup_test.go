@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestBuild(t *testing.T) {
+	cases := []struct {
+		name      string
+		modify    func(*Config)
+		expectErr string
+	}{
+		{"default", func(*Config) {}, ""},
+		{"no-fields", func(c *Config) { c.Fields = nil }, "dedup: 'fields' is empty"},
+		{"no-count-field", func(c *Config) { c.CountField = entry.Field{} }, "missing required argument 'count_field'"},
+		{"zero-interval", func(c *Config) { c.Interval = 0 }, "'interval' must be positive"},
+		{"zero-max-entries", func(c *Config) { c.MaxEntries = 0 }, "'max_entries' must be positive"},
+		{"invalid-if", func(c *Config) { c.IfExpr = "body ==" }, "failed to compile expression"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			tc.modify(cfg)
+			op, err := cfg.Build(testutil.Logger(t))
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, &Transformer{}, op)
+		})
+	}
+}
+
+func newTestTransformer(t *testing.T, cfg *Config) (*Transformer, *testutil.FakeOutput) {
+	cfg.OutputIDs = []string{"fake"}
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	dedup := op.(*Transformer)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, dedup.SetOutputs([]operator.Operator{fake}))
+	return dedup, fake
+}
+
+func entryWith(body interface{}, attributes map[string]interface{}) *entry.Entry {
+	e := entry.New()
+	e.Body = body
+	e.Attributes = attributes
+	return e
+}
+
+func TestTransformer(t *testing.T) {
+	cases := []struct {
+		name     string
+		modify   func(*Config)
+		input    []*entry.Entry
+		expected []*entry.Entry
+	}{
+		{
+			"collapse-identical-bodies",
+			func(*Config) {},
+			[]*entry.Entry{
+				entryWith("connection refused", nil),
+				entryWith("connection refused", nil),
+				entryWith("retrying", nil),
+				entryWith("connection refused", nil),
+			},
+			[]*entry.Entry{
+				entryWith("connection refused", map[string]interface{}{"log_count": int64(3)}),
+				entryWith("retrying", map[string]interface{}{"log_count": int64(1)}),
+			},
+		},
+		{
+			"custom-fields",
+			func(c *Config) {
+				c.Fields = []entry.Field{entry.NewAttributeField("host")}
+				c.CountField = entry.NewAttributeField("repeats")
+			},
+			[]*entry.Entry{
+				entryWith("first", map[string]interface{}{"host": "a"}),
+				entryWith("second", map[string]interface{}{"host": "b"}),
+				entryWith("third", map[string]interface{}{"host": "a"}),
+			},
+			[]*entry.Entry{
+				entryWith("first", map[string]interface{}{"host": "a", "repeats": int64(2)}),
+				entryWith("second", map[string]interface{}{"host": "b", "repeats": int64(1)}),
+			},
+		},
+		{
+			"structured-body",
+			func(*Config) {},
+			[]*entry.Entry{
+				entryWith(map[string]interface{}{"msg": "x", "code": 1}, nil),
+				entryWith(map[string]interface{}{"code": 1, "msg": "x"}, nil),
+				entryWith(map[string]interface{}{"msg": "x", "code": 2}, nil),
+			},
+			[]*entry.Entry{
+				entryWith(map[string]interface{}{"msg": "x", "code": 1}, map[string]interface{}{"log_count": int64(2)}),
+				entryWith(map[string]interface{}{"msg": "x", "code": 2}, map[string]interface{}{"log_count": int64(1)}),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			cfg.Interval = time.Hour
+			tc.modify(cfg)
+			dedup, fake := newTestTransformer(t, cfg)
+			require.NoError(t, dedup.Start(testutil.NewMockPersister("test")))
+
+			for _, e := range tc.input {
+				require.NoError(t, dedup.Process(context.Background(), e))
+			}
+			fake.ExpectNoEntry(t, 50*time.Millisecond)
+
+			require.NoError(t, dedup.Stop())
+			for _, expected := range tc.expected {
+				received := <-fake.Received
+				require.Equal(t, expected.Body, received.Body)
+				require.Equal(t, expected.Attributes, received.Attributes)
+			}
+			fake.ExpectNoEntry(t, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestSkip(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.IfExpr = `body == "keep"`
+	dedup, fake := newTestTransformer(t, cfg)
+
+	e := entryWith("pass through", nil)
+	require.NoError(t, dedup.Process(context.Background(), e))
+	fake.ExpectEntry(t, e)
+}
+
+func TestFlushOnInterval(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Interval = 100 * time.Millisecond
+	dedup, fake := newTestTransformer(t, cfg)
+	require.NoError(t, dedup.Start(testutil.NewMockPersister("test")))
+	defer func() { require.NoError(t, dedup.Stop()) }()
+
+	require.NoError(t, dedup.Process(context.Background(), entryWith("body", nil)))
+	require.NoError(t, dedup.Process(context.Background(), entryWith("body", nil)))
+
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, map[string]interface{}{"log_count": int64(2)}, e.Attributes)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "The entry should be flushed by now")
+	}
+}
+
+func TestMaxEntries(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Interval = time.Hour
+	cfg.MaxEntries = 2
+	dedup, fake := newTestTransformer(t, cfg)
+
+	require.NoError(t, dedup.Process(context.Background(), entryWith("a", nil)))
+	require.NoError(t, dedup.Process(context.Background(), entryWith("a", nil)))
+	fake.ExpectNoEntry(t, 10*time.Millisecond)
+
+	require.NoError(t, dedup.Process(context.Background(), entryWith("b", nil)))
+	fake.ExpectBody(t, "a")
+	fake.ExpectBody(t, "b")
+}
+
+func TestStopTwice(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	dedup, fake := newTestTransformer(t, cfg)
+	require.NoError(t, dedup.Start(testutil.NewMockPersister("test")))
+
+	require.NoError(t, dedup.Process(context.Background(), entryWith("body", nil)))
+	require.NoError(t, dedup.Stop())
+	require.NoError(t, dedup.Stop())
+	fake.ExpectBody(t, "body")
+	fake.ExpectNoEntry(t, 10*time.Millisecond)
+}
+
+func TestProcessNotBlockedByFlush(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Interval = 10 * time.Millisecond
+	dedup, fake := newTestTransformer(t, cfg)
+	// the output blocks until the entries are read
+	fake.Received = make(chan *entry.Entry)
+	require.NoError(t, dedup.Start(testutil.NewMockPersister("test")))
+
+	require.NoError(t, dedup.Process(context.Background(), entryWith("first", nil)))
+	// wait for the flush to be blocked on the output
+	time.Sleep(50 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, dedup.Process(context.Background(), entryWith("second", nil)))
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "Process should not be blocked by the flush")
+	}
+
+	fake.ExpectBody(t, "first")
+	fake.ExpectBody(t, "second")
+	go func() {
+		for range fake.Received {
+		}
+	}()
+	require.NoError(t, dedup.Stop())
+}
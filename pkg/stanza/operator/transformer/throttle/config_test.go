@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
+)
+
+func TestUnmarshal(t *testing.T) {
+	operatortest.ConfigUnmarshalTests{
+		DefaultConfig: NewConfig(),
+		TestsFile:     filepath.Join(".", "testdata", "config.yaml"),
+		Tests: []operatortest.ConfigUnmarshalTest{
+			{
+				Name:   "default",
+				Expect: NewConfig(),
+			},
+			{
+				Name: "rate",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Rate = 100
+					return cfg
+				}(),
+			},
+			{
+				Name: "key_fields",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Rate = 10
+					cfg.KeyFields = []entry.Field{
+						entry.NewResourceField("service.name"),
+						entry.NewAttributeField("level"),
+					}
+					return cfg
+				}(),
+			},
+			{
+				Name: "dropped_count_field",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Rate = 10
+					cfg.DroppedCountField = entry.NewBodyField("dropped")
+					return cfg
+				}(),
+			},
+		},
+	}.Run(t)
+}
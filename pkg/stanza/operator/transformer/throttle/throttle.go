@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/throttle"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "throttle"
+
+// window is the period over which the rate limit applies
+const window = time.Second
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// NewConfig creates a new throttle config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new throttle config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		TransformerConfig: helper.NewTransformerConfig(operatorID, operatorType),
+		DroppedCountField: entry.NewAttributeField("log_throttled_count"),
+	}
+}
+
+// Config is the configuration of a throttle operator
+type Config struct {
+	helper.TransformerConfig `mapstructure:",squash"`
+	Rate                     int           `mapstructure:"rate"`
+	KeyFields                []entry.Field `mapstructure:"key_fields"`
+	DroppedCountField        entry.Field   `mapstructure:"dropped_count_field"`
+}
+
+// Build creates a new Transformer from a config
+func (c *Config) Build(logger *zap.SugaredLogger) (operator.Operator, error) {
+	transformer, err := c.TransformerConfig.Build(logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transformer config: %w", err)
+	}
+
+	if c.Rate <= 0 {
+		return nil, fmt.Errorf("'rate' must be positive")
+	}
+
+	if c.DroppedCountField.FieldInterface == nil {
+		return nil, fmt.Errorf("missing required argument 'dropped_count_field'")
+	}
+
+	return &Transformer{
+		TransformerOperator: transformer,
+		rate:                c.Rate,
+		keyFields:           c.KeyFields,
+		droppedCountField:   c.DroppedCountField,
+		chClose:             make(chan struct{}),
+		keys:                make(map[string]*keyState),
+	}, nil
+}
+
+// Transformer is an operator that limits the number of entries per second
+// for each key. Entries over the limit are dropped and, at the end of each
+// second, a summary entry reporting how many were dropped is emitted, in
+// addition to the entries passed within the rate
+type Transformer struct {
+	helper.TransformerOperator
+	rate              int
+	keyFields         []entry.Field
+	droppedCountField entry.Field
+	chClose           chan struct{}
+	stopOnce          sync.Once
+	wg                sync.WaitGroup
+
+	sync.Mutex
+	keys map[string]*keyState
+}
+
+// keyState tracks the entries seen for a key in the current window
+type keyState struct {
+	passed  int
+	dropped int64
+	last    *entry.Entry
+}
+
+func (t *Transformer) Start(_ operator.Persister) error {
+	t.wg.Add(1)
+	go t.flushLoop(time.NewTicker(window))
+
+	return nil
+}
+
+func (t *Transformer) flushLoop(ticker *time.Ticker) {
+	defer t.wg.Done()
+	for {
+		select {
+		case <-ticker.C:
+			t.flush(context.Background())
+		case <-t.chClose:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// Stop emits the pending summaries before stopping the operator
+func (t *Transformer) Stop() error {
+	t.stopOnce.Do(func() {
+		close(t.chClose)
+		t.wg.Wait()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		t.flush(ctx)
+	})
+
+	return nil
+}
+
+func (t *Transformer) Process(ctx context.Context, e *entry.Entry) error {
+	skip, err := t.Skip(ctx, e)
+	if err != nil {
+		return t.HandleEntryError(ctx, e, err)
+	}
+	if skip {
+		t.Write(ctx, e)
+		return nil
+	}
+
+	key, err := t.key(e)
+	if err != nil {
+		return t.HandleEntryError(ctx, e, err)
+	}
+
+	t.Lock()
+	state, ok := t.keys[key]
+	if !ok {
+		state = &keyState{}
+		t.keys[key] = state
+	}
+
+	if state.passed < t.rate {
+		state.passed++
+		t.Unlock()
+		t.Write(ctx, e)
+		return nil
+	}
+
+	state.dropped++
+	state.last = e
+	t.Unlock()
+	return nil
+}
+
+// key builds the throttling key from the values of the configured fields
+func (t *Transformer) key(e *entry.Entry) (string, error) {
+	if len(t.keyFields) == 0 {
+		return "", nil
+	}
+
+	values := make([]interface{}, len(t.keyFields))
+	for i, field := range t.keyFields {
+		values[i], _ = e.Get(field)
+	}
+
+	key, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("compute throttle key: %w", err)
+	}
+	return string(key), nil
+}
+
+// flush emits, for each key that dropped entries in the current window, the
+// last dropped entry with the number of dropped entries set on the dropped
+// count field, and starts a new window. The summaries are written after
+// releasing the lock, so that a blocked output doesn't block the entries
+// being processed.
+func (t *Transformer) flush(ctx context.Context) {
+	t.Lock()
+	var summaries []*entry.Entry
+	for _, state := range t.keys {
+		if state.dropped == 0 {
+			continue
+		}
+		if err := state.last.Set(t.droppedCountField, state.dropped); err != nil {
+			t.Errorw("Failed to set dropped count field", zap.Error(err))
+		}
+		summaries = append(summaries, state.last)
+	}
+
+	t.keys = make(map[string]*keyState)
+	t.Unlock()
+
+	for _, e := range summaries {
+		t.Write(ctx, e)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestBuild(t *testing.T) {
+	cases := []struct {
+		name      string
+		modify    func(*Config)
+		expectErr string
+	}{
+		{"valid", func(c *Config) { c.Rate = 1 }, ""},
+		{"missing-rate", func(*Config) {}, "'rate' must be positive"},
+		{"no-dropped-count-field", func(c *Config) {
+			c.Rate = 1
+			c.DroppedCountField = entry.Field{}
+		}, "missing required argument 'dropped_count_field'"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test")
+			tc.modify(cfg)
+			op, err := cfg.Build(testutil.Logger(t))
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, &Transformer{}, op)
+		})
+	}
+}
+
+func newTestTransformer(t *testing.T, cfg *Config) (*Transformer, *testutil.FakeOutput) {
+	cfg.OutputIDs = []string{"fake"}
+	op, err := cfg.Build(testutil.Logger(t))
+	require.NoError(t, err)
+	throttle := op.(*Transformer)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, throttle.SetOutputs([]operator.Operator{fake}))
+	return throttle, fake
+}
+
+func entryWith(body string, attributes map[string]interface{}) *entry.Entry {
+	e := entry.New()
+	e.Body = body
+	e.Attributes = attributes
+	return e
+}
+
+func expectSummary(t *testing.T, fake *testutil.FakeOutput, body string, attributes map[string]interface{}) {
+	select {
+	case e := <-fake.Received:
+		require.Equal(t, body, e.Body)
+		require.Equal(t, attributes, e.Attributes)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "The summary should be flushed by now")
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Rate = 2
+	throttle, fake := newTestTransformer(t, cfg)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, throttle.Process(context.Background(), entryWith(fmt.Sprintf("msg %d", i), nil)))
+	}
+
+	fake.ExpectBody(t, "msg 0")
+	fake.ExpectBody(t, "msg 1")
+	fake.ExpectNoEntry(t, 10*time.Millisecond)
+
+	// the summary of the dropped entries is emitted on shutdown
+	require.NoError(t, throttle.Stop())
+	expectSummary(t, fake, "msg 4", map[string]interface{}{"log_throttled_count": int64(3)})
+	fake.ExpectNoEntry(t, 10*time.Millisecond)
+}
+
+func TestThrottlePerKey(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Rate = 1
+	cfg.KeyFields = []entry.Field{entry.NewAttributeField("service")}
+	throttle, fake := newTestTransformer(t, cfg)
+
+	require.NoError(t, throttle.Process(context.Background(), entryWith("a1", map[string]interface{}{"service": "a"})))
+	require.NoError(t, throttle.Process(context.Background(), entryWith("a2", map[string]interface{}{"service": "a"})))
+	require.NoError(t, throttle.Process(context.Background(), entryWith("b1", map[string]interface{}{"service": "b"})))
+	require.NoError(t, throttle.Process(context.Background(), entryWith("none", nil)))
+
+	fake.ExpectBody(t, "a1")
+	fake.ExpectBody(t, "b1")
+	fake.ExpectBody(t, "none")
+	fake.ExpectNoEntry(t, 10*time.Millisecond)
+
+	require.NoError(t, throttle.Stop())
+	expectSummary(t, fake, "a2", map[string]interface{}{"service": "a", "log_throttled_count": int64(1)})
+}
+
+func TestThrottleWindowReset(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Rate = 1
+	throttle, fake := newTestTransformer(t, cfg)
+	require.NoError(t, throttle.Start(testutil.NewMockPersister("test")))
+	defer func() { require.NoError(t, throttle.Stop()) }()
+
+	require.NoError(t, throttle.Process(context.Background(), entryWith("first", nil)))
+	require.NoError(t, throttle.Process(context.Background(), entryWith("dropped", nil)))
+	fake.ExpectBody(t, "first")
+
+	expectSummary(t, fake, "dropped", map[string]interface{}{"log_throttled_count": int64(1)})
+
+	require.NoError(t, throttle.Process(context.Background(), entryWith("next window", nil)))
+	fake.ExpectBody(t, "next window")
+}
+
+func TestSkip(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Rate = 1
+	cfg.IfExpr = `body == "limited"`
+	throttle, fake := newTestTransformer(t, cfg)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, throttle.Process(context.Background(), entryWith("unlimited", nil)))
+		fake.ExpectBody(t, "unlimited")
+	}
+}
+
+func TestStopTwice(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Rate = 1
+	throttle, fake := newTestTransformer(t, cfg)
+	require.NoError(t, throttle.Start(testutil.NewMockPersister("test")))
+
+	require.NoError(t, throttle.Process(context.Background(), entryWith("first", nil)))
+	require.NoError(t, throttle.Process(context.Background(), entryWith("dropped", nil)))
+	fake.ExpectBody(t, "first")
+
+	require.NoError(t, throttle.Stop())
+	require.NoError(t, throttle.Stop())
+	expectSummary(t, fake, "dropped", map[string]interface{}{"log_throttled_count": int64(1)})
+	fake.ExpectNoEntry(t, 10*time.Millisecond)
+}
@@ -62,10 +62,26 @@ type MetricFilters struct {
 	// Supports `and`, `or`, and `()`
 	MetricConditions []string `mapstructure:"metric"`
 
+	// MetricConditionGroups is a list of groups of OTTL conditions for an ottlmetric context.
+	// A metric is dropped if it matches any of the shared conditions and any of the drop conditions of a group.
+	MetricConditionGroups []common.ConditionGroup `mapstructure:"metric_groups"`
+
 	// DataPointConditions is a list of OTTL conditions for an ottldatapoint context.
 	// If any condition resolves to true, the datapoint will be dropped.
 	// Supports `and`, `or`, and `()`
 	DataPointConditions []string `mapstructure:"datapoint"`
+
+	// DataPointConditionGroups is a list of groups of OTTL conditions for an ottldatapoint context.
+	// A datapoint is dropped if it matches any of the shared conditions and any of the drop conditions of a group.
+	DataPointConditionGroups []common.ConditionGroup `mapstructure:"datapoint_groups"`
+}
+
+func (mf MetricFilters) hasMetricConditions() bool {
+	return mf.MetricConditions != nil || mf.MetricConditionGroups != nil
+}
+
+func (mf MetricFilters) hasDataPointConditions() bool {
+	return mf.DataPointConditions != nil || mf.DataPointConditionGroups != nil
 }
 
 // TraceFilters filters by OTTL conditions
@@ -75,10 +91,26 @@ type TraceFilters struct {
 	// Supports `and`, `or`, and `()`
 	SpanConditions []string `mapstructure:"span"`
 
+	// SpanConditionGroups is a list of groups of OTTL conditions for an ottlspan context.
+	// A span is dropped if it matches any of the shared conditions and any of the drop conditions of a group.
+	SpanConditionGroups []common.ConditionGroup `mapstructure:"span_groups"`
+
 	// SpanEventConditions is a list of OTTL conditions for an ottlspanevent context.
 	// If any condition resolves to true, the span event will be dropped.
 	// Supports `and`, `or`, and `()`
 	SpanEventConditions []string `mapstructure:"spanevent"`
+
+	// SpanEventConditionGroups is a list of groups of OTTL conditions for an ottlspanevent context.
+	// A span event is dropped if it matches any of the shared conditions and any of the drop conditions of a group.
+	SpanEventConditionGroups []common.ConditionGroup `mapstructure:"spanevent_groups"`
+}
+
+func (tf TraceFilters) hasSpanConditions() bool {
+	return tf.SpanConditions != nil || tf.SpanConditionGroups != nil
+}
+
+func (tf TraceFilters) hasSpanEventConditions() bool {
+	return tf.SpanEventConditions != nil || tf.SpanEventConditionGroups != nil
 }
 
 // LogFilters filters by Log properties.
@@ -96,6 +128,14 @@ type LogFilters struct {
 	// If any condition resolves to true, the log event will be dropped.
 	// Supports `and`, `or`, and `()`
 	LogConditions []string `mapstructure:"log_record"`
+
+	// LogConditionGroups is a list of groups of OTTL conditions for an ottllog context.
+	// A log is dropped if it matches any of the shared conditions and any of the drop conditions of a group.
+	LogConditionGroups []common.ConditionGroup `mapstructure:"log_record_groups"`
+}
+
+func (lf LogFilters) hasLogConditions() bool {
+	return lf.LogConditions != nil || lf.LogConditionGroups != nil
 }
 
 // LogMatchType specifies the strategy for matching against `plog.Log`s.
@@ -106,6 +146,8 @@ type LogMatchType string
 const (
 	Strict = LogMatchType(filterset.Strict)
 	Regexp = LogMatchType(filterset.Regexp)
+	Glob   = LogMatchType(filterset.Glob)
+	Prefix = LogMatchType(filterset.Prefix)
 )
 
 var severityToNumber = map[string]plog.SeverityNumber{
@@ -270,48 +312,48 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
-	if (cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil) && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil) {
+	if (cfg.Traces.hasSpanConditions() || cfg.Traces.hasSpanEventConditions()) && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil) {
 		return fmt.Errorf("cannot use ottl conditions and include/exclude for spans at the same time")
 	}
-	if (cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil) && (cfg.Metrics.Include != nil || cfg.Metrics.Exclude != nil) {
+	if (cfg.Metrics.hasMetricConditions() || cfg.Metrics.hasDataPointConditions()) && (cfg.Metrics.Include != nil || cfg.Metrics.Exclude != nil) {
 		return fmt.Errorf("cannot use ottl conditions and include/exclude for metrics at the same time")
 	}
-	if cfg.Logs.LogConditions != nil && (cfg.Logs.Include != nil || cfg.Logs.Exclude != nil) {
+	if cfg.Logs.hasLogConditions() && (cfg.Logs.Include != nil || cfg.Logs.Exclude != nil) {
 		return fmt.Errorf("cannot use ottl conditions and include/exclude for logs at the same time")
 	}
 
 	var errors error
 
-	if cfg.Traces.SpanConditions != nil {
-		_, err := common.ParseSpan(cfg.Traces.SpanConditions, component.TelemetrySettings{Logger: zap.NewNop()})
+	if cfg.Traces.hasSpanConditions() {
+		_, err := common.ParseSpan(cfg.Traces.SpanConditions, cfg.Traces.SpanConditionGroups, component.TelemetrySettings{Logger: zap.NewNop()})
 		errors = multierr.Append(errors, err)
 	}
 
-	if cfg.Traces.SpanEventConditions != nil {
-		_, err := common.ParseSpanEvent(cfg.Traces.SpanEventConditions, component.TelemetrySettings{Logger: zap.NewNop()})
+	if cfg.Traces.hasSpanEventConditions() {
+		_, err := common.ParseSpanEvent(cfg.Traces.SpanEventConditions, cfg.Traces.SpanEventConditionGroups, component.TelemetrySettings{Logger: zap.NewNop()})
 		errors = multierr.Append(errors, err)
 	}
 
-	if cfg.Metrics.MetricConditions != nil {
-		_, err := common.ParseMetric(cfg.Metrics.MetricConditions, component.TelemetrySettings{Logger: zap.NewNop()})
+	if cfg.Metrics.hasMetricConditions() {
+		_, err := common.ParseMetric(cfg.Metrics.MetricConditions, cfg.Metrics.MetricConditionGroups, component.TelemetrySettings{Logger: zap.NewNop()})
 		errors = multierr.Append(errors, err)
 	}
 
-	if cfg.Metrics.DataPointConditions != nil {
-		_, err := common.ParseDataPoint(cfg.Metrics.DataPointConditions, component.TelemetrySettings{Logger: zap.NewNop()})
+	if cfg.Metrics.hasDataPointConditions() {
+		_, err := common.ParseDataPoint(cfg.Metrics.DataPointConditions, cfg.Metrics.DataPointConditionGroups, component.TelemetrySettings{Logger: zap.NewNop()})
 		errors = multierr.Append(errors, err)
 	}
 
-	if cfg.Logs.LogConditions != nil {
-		_, err := common.ParseLog(cfg.Logs.LogConditions, component.TelemetrySettings{Logger: zap.NewNop()})
+	if cfg.Logs.hasLogConditions() {
+		_, err := common.ParseLog(cfg.Logs.LogConditions, cfg.Logs.LogConditionGroups, component.TelemetrySettings{Logger: zap.NewNop()})
 		errors = multierr.Append(errors, err)
 	}
 
-	if cfg.Logs.LogConditions != nil && cfg.Logs.Include != nil {
+	if cfg.Logs.hasLogConditions() && cfg.Logs.Include != nil {
 		errors = multierr.Append(errors, cfg.Logs.Include.validate())
 	}
 
-	if cfg.Logs.LogConditions != nil && cfg.Logs.Exclude != nil {
+	if cfg.Logs.hasLogConditions() && cfg.Logs.Exclude != nil {
 		errors = multierr.Append(errors, cfg.Logs.Exclude.validate())
 	}
 
@@ -28,6 +28,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filtermetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 	fsregexp "github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset/regexp"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/common"
 )
 
 // TestLoadingConfigRegexp tests loading testdata/config_strict.yaml
@@ -856,6 +857,32 @@ func TestLoadingConfigOTTL(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName("filter", "groups"),
+			expected: &Config{
+				Logs: LogFilters{
+					LogConditionGroups: []common.ConditionGroup{
+						{
+							Conditions: []string{
+								`resource.attributes["service.name"] == "noisy"`,
+							},
+							Drop: []string{
+								`severity_number < SEVERITY_NUMBER_WARN`,
+								`IsMatch(body, "^GET /health") == true`,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "groups_mix_config"),
+			errorMessage: "cannot use ottl conditions and include/exclude for logs at the same time",
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "groups_without_drop"),
+			errorMessage: "condition group must have at least one drop condition",
+		},
 		{
 			id:           component.NewIDWithName(typeStr, "spans_mix_config"),
 			errorMessage: "cannot use ottl conditions and include/exclude for spans at the same time",
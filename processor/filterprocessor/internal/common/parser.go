@@ -16,6 +16,7 @@ package common // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
@@ -31,69 +32,88 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
-func ParseSpan(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottlspan.TransformContext], error) {
-	statmentsStr := conditionsToStatements(conditions)
+func ParseSpan(conditions []string, groups []ConditionGroup, set component.TelemetrySettings) (expr.BoolExpr[ottlspan.TransformContext], error) {
 	parser, err := ottlspan.NewParser(functions[ottlspan.TransformContext](), set)
 	if err != nil {
 		return nil, err
 	}
-	statements, err := parser.ParseStatements(statmentsStr)
-	if err != nil {
-		return nil, err
-	}
-	return statementsToExpr(statements), nil
+	return parseConditions(parser, conditions, groups)
 }
 
-func ParseSpanEvent(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottlspanevent.TransformContext], error) {
-	statmentsStr := conditionsToStatements(conditions)
+func ParseSpanEvent(conditions []string, groups []ConditionGroup, set component.TelemetrySettings) (expr.BoolExpr[ottlspanevent.TransformContext], error) {
 	parser, err := ottlspanevent.NewParser(functions[ottlspanevent.TransformContext](), set)
 	if err != nil {
 		return nil, err
 	}
-	statements, err := parser.ParseStatements(statmentsStr)
-	if err != nil {
-		return nil, err
-	}
-	return statementsToExpr(statements), nil
+	return parseConditions(parser, conditions, groups)
 }
 
-func ParseLog(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottllog.TransformContext], error) {
-	statmentsStr := conditionsToStatements(conditions)
+func ParseLog(conditions []string, groups []ConditionGroup, set component.TelemetrySettings) (expr.BoolExpr[ottllog.TransformContext], error) {
 	parser, err := ottllog.NewParser(functions[ottllog.TransformContext](), set)
 	if err != nil {
 		return nil, err
 	}
-	statements, err := parser.ParseStatements(statmentsStr)
-	if err != nil {
-		return nil, err
-	}
-	return statementsToExpr(statements), nil
+	return parseConditions(parser, conditions, groups)
 }
 
-func ParseMetric(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottlmetric.TransformContext], error) {
-	statmentsStr := conditionsToStatements(conditions)
+func ParseMetric(conditions []string, groups []ConditionGroup, set component.TelemetrySettings) (expr.BoolExpr[ottlmetric.TransformContext], error) {
 	parser, err := ottlmetric.NewParser(metricFunctions(), set)
 	if err != nil {
 		return nil, err
 	}
-	statements, err := parser.ParseStatements(statmentsStr)
-	if err != nil {
-		return nil, err
-	}
-	return statementsToExpr(statements), nil
+	return parseConditions(parser, conditions, groups)
 }
 
-func ParseDataPoint(conditions []string, set component.TelemetrySettings) (expr.BoolExpr[ottldatapoint.TransformContext], error) {
-	statmentsStr := conditionsToStatements(conditions)
+func ParseDataPoint(conditions []string, groups []ConditionGroup, set component.TelemetrySettings) (expr.BoolExpr[ottldatapoint.TransformContext], error) {
 	parser, err := ottldatapoint.NewParser(functions[ottldatapoint.TransformContext](), set)
 	if err != nil {
 		return nil, err
 	}
-	statements, err := parser.ParseStatements(statmentsStr)
-	if err != nil {
-		return nil, err
+	return parseConditions(parser, conditions, groups)
+}
+
+// ConditionGroup is a list of OTTL conditions sharing a list of OTTL conditions.
+// An item is dropped if it matches any of the shared Conditions and any of the Drop conditions.
+type ConditionGroup struct {
+	// Conditions are shared by all the Drop conditions of the group. The group matches
+	// all the items if empty.
+	Conditions []string `mapstructure:"conditions"`
+	// Drop is the list of conditions to drop the items matching the shared Conditions.
+	Drop []string `mapstructure:"drop"`
+}
+
+// parseConditions returns an expression matching the items that match any of the conditions,
+// or any of the groups.
+func parseConditions[K any](parser ottl.Parser[K], conditions []string, groups []ConditionGroup) (expr.BoolExpr[K], error) {
+	var exprs []expr.BoolExpr[K]
+	if len(conditions) > 0 {
+		statements, err := parser.ParseStatements(conditionsToStatements(conditions))
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, statementsToExpr(statements))
 	}
-	return statementsToExpr(statements), nil
+
+	for _, group := range groups {
+		if len(group.Drop) == 0 {
+			return nil, errors.New("condition group must have at least one drop condition")
+		}
+		drop, err := parser.ParseStatements(conditionsToStatements(group.Drop))
+		if err != nil {
+			return nil, err
+		}
+		if len(group.Conditions) == 0 {
+			exprs = append(exprs, statementsToExpr(drop))
+			continue
+		}
+		shared, err := parser.ParseStatements(conditionsToStatements(group.Conditions))
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr.And(statementsToExpr(shared), statementsToExpr(drop)))
+	}
+
+	return expr.Or(exprs...), nil
 }
 
 func conditionsToStatements(conditions []string) []string {
@@ -123,13 +143,27 @@ func statementsToExpr[K any](statements []*ottl.Statement[K]) expr.BoolExpr[K] {
 
 func functions[K any]() map[string]interface{} {
 	return map[string]interface{}{
-		"TraceID":     ottlfuncs.TraceID[K],
-		"SpanID":      ottlfuncs.SpanID[K],
-		"IsMatch":     ottlfuncs.IsMatch[K],
-		"Concat":      ottlfuncs.Concat[K],
-		"Split":       ottlfuncs.Split[K],
-		"Int":         ottlfuncs.Int[K],
-		"ConvertCase": ottlfuncs.ConvertCase[K],
+		"TraceID":         ottlfuncs.TraceID[K],
+		"SpanID":          ottlfuncs.SpanID[K],
+		"IsMatch":         ottlfuncs.IsMatch[K],
+		"Concat":          ottlfuncs.Concat[K],
+		"Split":           ottlfuncs.Split[K],
+		"Int":             ottlfuncs.Int[K],
+		"ConvertCase":     ottlfuncs.ConvertCase[K],
+		"SHA256":          ottlfuncs.SHA256[K],
+		"FNV":             ottlfuncs.FNV[K],
+		"Base64Encode":    ottlfuncs.Base64Encode[K],
+		"Base64Decode":    ottlfuncs.Base64Decode[K],
+		"Time":            ottlfuncs.Time[K],
+		"Unix":            ottlfuncs.Unix[K],
+		"FormatTime":      ottlfuncs.FormatTime[K],
+		"Duration":        ottlfuncs.Duration[K],
+		"Format":          ottlfuncs.Format[K],
+		"Len":             ottlfuncs.Len[K],
+		"Double":          ottlfuncs.Double[K],
+		"IsString":        ottlfuncs.IsString[K],
+		"IsMap":           ottlfuncs.IsMap[K],
+		"ExtractPatterns": ottlfuncs.ExtractPatterns[K],
 		"drop": func() (ottl.ExprFunc[K], error) {
 			return func(context.Context, K) (interface{}, error) {
 				return true, nil
@@ -19,9 +19,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 )
 
@@ -368,3 +372,96 @@ func Test_HasAttrOnDatapoint(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseLog_ConditionGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []string
+		groups     []ConditionGroup
+		service    string
+		severity   plog.SeverityNumber
+		expected   bool
+	}{
+		{
+			name: "shared and drop conditions match",
+			groups: []ConditionGroup{
+				{
+					Conditions: []string{`resource.attributes["service.name"] == "noisy"`},
+					Drop:       []string{`severity_number < SEVERITY_NUMBER_WARN`},
+				},
+			},
+			service:  "noisy",
+			severity: plog.SeverityNumberInfo,
+			expected: true,
+		},
+		{
+			name: "shared conditions do not match",
+			groups: []ConditionGroup{
+				{
+					Conditions: []string{`resource.attributes["service.name"] == "noisy"`},
+					Drop:       []string{`severity_number < SEVERITY_NUMBER_WARN`},
+				},
+			},
+			service:  "quiet",
+			severity: plog.SeverityNumberInfo,
+			expected: false,
+		},
+		{
+			name: "drop conditions do not match",
+			groups: []ConditionGroup{
+				{
+					Conditions: []string{`resource.attributes["service.name"] == "noisy"`},
+					Drop:       []string{`severity_number < SEVERITY_NUMBER_WARN`},
+				},
+			},
+			service:  "noisy",
+			severity: plog.SeverityNumberError,
+			expected: false,
+		},
+		{
+			name:       "conditions and groups are combined with or",
+			conditions: []string{`severity_number == SEVERITY_NUMBER_ERROR`},
+			groups: []ConditionGroup{
+				{
+					Conditions: []string{`resource.attributes["service.name"] == "noisy"`},
+					Drop:       []string{`severity_number < SEVERITY_NUMBER_WARN`},
+				},
+			},
+			service:  "quiet",
+			severity: plog.SeverityNumberError,
+			expected: true,
+		},
+		{
+			name: "group without shared conditions",
+			groups: []ConditionGroup{
+				{
+					Drop: []string{`severity_number < SEVERITY_NUMBER_WARN`},
+				},
+			},
+			service:  "quiet",
+			severity: plog.SeverityNumberDebug,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			skipExpr, err := ParseLog(tt.conditions, tt.groups, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			resource := pcommon.NewResource()
+			resource.Attributes().PutStr("service.name", tt.service)
+			log := plog.NewLogRecord()
+			log.SetSeverityNumber(tt.severity)
+
+			skip, err := skipExpr.Eval(context.Background(), ottllog.NewTransformContext(log, pcommon.NewInstrumentationScope(), resource))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, skip)
+		})
+	}
+}
+
+func Test_ParseLog_ConditionGroupWithoutDrop(t *testing.T) {
+	_, err := ParseLog(nil, []ConditionGroup{{Conditions: []string{`body == "test"`}}}, componenttest.NewNopTelemetrySettings())
+	assert.EqualError(t, err, "condition group must have at least one drop condition")
+}
@@ -40,8 +40,8 @@ func newFilterLogsProcessor(set component.TelemetrySettings, cfg *Config) (*filt
 	flp := &filterLogProcessor{
 		logger: set.Logger,
 	}
-	if cfg.Logs.LogConditions != nil {
-		skipExpr, err := common.ParseLog(cfg.Logs.LogConditions, set)
+	if cfg.Logs.hasLogConditions() {
+		skipExpr, err := common.ParseLog(cfg.Logs.LogConditions, cfg.Logs.LogConditionGroups, set)
 		if err != nil {
 			return nil, err
 		}
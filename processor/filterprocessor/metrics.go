@@ -47,16 +47,16 @@ func newFilterMetricProcessor(set component.TelemetrySettings, cfg *Config) (*fi
 	fsp := &filterMetricProcessor{
 		logger: set.Logger,
 	}
-	if cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil {
-		if cfg.Metrics.MetricConditions != nil {
-			fsp.skipMetricExpr, err = common.ParseMetric(cfg.Metrics.MetricConditions, set)
+	if cfg.Metrics.hasMetricConditions() || cfg.Metrics.hasDataPointConditions() {
+		if cfg.Metrics.hasMetricConditions() {
+			fsp.skipMetricExpr, err = common.ParseMetric(cfg.Metrics.MetricConditions, cfg.Metrics.MetricConditionGroups, set)
 			if err != nil {
 				return nil, err
 			}
 		}
 
-		if cfg.Metrics.DataPointConditions != nil {
-			fsp.skipDataPointExpr, err = common.ParseDataPoint(cfg.Metrics.DataPointConditions, set)
+		if cfg.Metrics.hasDataPointConditions() {
+			fsp.skipDataPointExpr, err = common.ParseDataPoint(cfg.Metrics.DataPointConditions, cfg.Metrics.DataPointConditionGroups, set)
 			if err != nil {
 				return nil, err
 			}
@@ -41,15 +41,15 @@ func newFilterSpansProcessor(set component.TelemetrySettings, cfg *Config) (*fil
 	fsp := &filterSpanProcessor{
 		logger: set.Logger,
 	}
-	if cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil {
-		if cfg.Traces.SpanConditions != nil {
-			fsp.skipSpanExpr, err = common.ParseSpan(cfg.Traces.SpanConditions, set)
+	if cfg.Traces.hasSpanConditions() || cfg.Traces.hasSpanEventConditions() {
+		if cfg.Traces.hasSpanConditions() {
+			fsp.skipSpanExpr, err = common.ParseSpan(cfg.Traces.SpanConditions, cfg.Traces.SpanConditionGroups, set)
 			if err != nil {
 				return nil, err
 			}
 		}
-		if cfg.Traces.SpanEventConditions != nil {
-			fsp.skipSpanEventExpr, err = common.ParseSpanEvent(cfg.Traces.SpanEventConditions, set)
+		if cfg.Traces.hasSpanEventConditions() {
+			fsp.skipSpanEventExpr, err = common.ParseSpanEvent(cfg.Traces.SpanEventConditions, cfg.Traces.SpanEventConditionGroups, set)
 			if err != nil {
 				return nil, err
 			}
@@ -34,6 +34,20 @@ var validAttributeSource = map[AttributeSource]bool{
 	recordAttributeSource:  true,
 }
 
+type SamplerMode string
+
+const (
+	hashSeedSamplerMode   = SamplerMode("hash_seed")
+	consistentSamplerMode = SamplerMode("consistent")
+
+	defaultSamplerMode = hashSeedSamplerMode
+)
+
+var validSamplerMode = map[SamplerMode]bool{
+	hashSeedSamplerMode:   true,
+	consistentSamplerMode: true,
+}
+
 // Config has the configuration guiding the sampler processor.
 type Config struct {
 
@@ -46,6 +60,12 @@ type Config struct {
 	// different sampling rates, configuring different seeds avoids that.
 	HashSeed uint32 `mapstructure:"hash_seed"`
 
+	// Mode (traces only) selects how traces are sampled. The allowed values are `hash_seed` and `consistent`.
+	// Default is `hash_seed`, which hashes the trace ID with HashSeed. `consistent` implements OpenTelemetry
+	// consistent probability sampling using the `p` and `r` values of the `ot` tracestate entry, which makes
+	// sampling decisions interoperable with SDK samplers and other collectors. HashSeed is ignored in this mode.
+	Mode SamplerMode `mapstructure:"mode"`
+
 	// AttributeSource (logs only) defines where to look for the attribute in from_attribute. The allowed values are
 	// `traceID` or `record`. Default is `traceID`.
 	AttributeSource `mapstructure:"attribute_source"`
@@ -69,5 +89,8 @@ func (cfg *Config) Validate() error {
 	if cfg.AttributeSource != "" && !validAttributeSource[cfg.AttributeSource] {
 		return fmt.Errorf("invalid attribute source: %v. Expected: %v or %v", cfg.AttributeSource, traceIDAttributeSource, recordAttributeSource)
 	}
+	if cfg.Mode != "" && !validSamplerMode[cfg.Mode] {
+		return fmt.Errorf("invalid mode: %v. Expected: %v or %v", cfg.Mode, hashSeedSamplerMode, consistentSamplerMode)
+	}
 	return nil
 }
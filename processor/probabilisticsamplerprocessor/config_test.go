@@ -36,6 +36,15 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				SamplingPercentage: 15.3,
 				HashSeed:           22,
+				Mode:               "hash_seed",
+				AttributeSource:    "traceID",
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "consistent"),
+			expected: &Config{
+				SamplingPercentage: 25,
+				Mode:               "consistent",
 				AttributeSource:    "traceID",
 			},
 		},
@@ -44,6 +53,7 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				SamplingPercentage: 15.3,
 				HashSeed:           22,
+				Mode:               "hash_seed",
 				AttributeSource:    "record",
 				FromAttribute:      "foo",
 				SamplingPriority:   "bar",
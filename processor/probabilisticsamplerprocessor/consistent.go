@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probabilisticsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor"
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// adjustedCountAttribute is the span attribute holding the number of spans in the
+// population represented by a sampled span, i.e. the inverse of its sampling probability.
+const adjustedCountAttribute = "sampling.adjusted_count"
+
+// consistentSampler implements OpenTelemetry consistent probability sampling.
+// Sampling probabilities are powers of two encoded as p-values, a span is sampled
+// when its p-value is not greater than the r-value of its trace. Other probabilities
+// are achieved by choosing, per trace, between the two nearest p-values.
+type consistentSampler struct {
+	pLow, pHigh uint8
+	// pLowProbability is the probability of choosing pLow for a trace.
+	pLowProbability float64
+}
+
+func newConsistentSampler(samplingPercentage float32) *consistentSampler {
+	probability := float64(samplingPercentage) / 100
+	switch {
+	case probability >= 1:
+		return &consistentSampler{pLow: 0, pHigh: 0, pLowProbability: 1}
+	case probability <= 0:
+		return &consistentSampler{pLow: zeroPValue, pHigh: zeroPValue, pLowProbability: 1}
+	}
+	pLow := uint8(math.Floor(-math.Log2(probability)))
+	if pLow > maxRValue {
+		pLow = maxRValue
+	}
+	if probability == pValueProbability(pLow) {
+		return &consistentSampler{pLow: pLow, pHigh: pLow, pLowProbability: 1}
+	}
+	pHigh := pLow + 1
+	return &consistentSampler{
+		pLow:            pLow,
+		pHigh:           pHigh,
+		pLowProbability: (probability - pValueProbability(pHigh)) / (pValueProbability(pLow) - pValueProbability(pHigh)),
+	}
+}
+
+// pValueProbability returns the sampling probability encoded by p.
+func pValueProbability(p uint8) float64 {
+	if p >= zeroPValue {
+		return 0
+	}
+	return math.Ldexp(1, -int(p))
+}
+
+// pValueFor returns the p-value used for the trace, derived from the
+// first half of the trace ID so that all its spans use the same one.
+func (cs *consistentSampler) pValueFor(traceID pcommon.TraceID) uint8 {
+	if cs.pLow == cs.pHigh {
+		return cs.pLow
+	}
+	u := float64(binary.BigEndian.Uint64(traceID[:8])>>11) / (1 << 53)
+	if u < cs.pLowProbability {
+		return cs.pLow
+	}
+	return cs.pHigh
+}
+
+// rValueFor derives the r-value of a trace from the random bits in the second
+// half of its trace ID: P(r >= n) = 2^-n.
+func rValueFor(traceID pcommon.TraceID) uint8 {
+	random := binary.BigEndian.Uint64(traceID[8:]) & (1<<maxRValue - 1)
+	return uint8(bits.LeadingZeros64(random) - (64 - maxRValue))
+}
+
+// sample returns whether the span is sampled. Sampled spans get their tracestate
+// p and r values and their adjusted count updated. Spans are never sampled with a
+// higher probability than the one already recorded in their tracestate.
+func (cs *consistentSampler) sample(span ptrace.Span) bool {
+	ot, members := parseTraceState(span.TraceState().AsRaw())
+	if !ot.hasR {
+		ot.r, ot.hasR = rValueFor(span.TraceID()), true
+		// A p-value without r-value cannot be trusted.
+		ot.hasP = false
+	}
+	if ot.hasP && ot.p > ot.r {
+		// Inconsistent p-value, the span would not have been sampled.
+		ot.hasP = false
+	}
+
+	p := cs.pValueFor(span.TraceID())
+	if ot.hasP && ot.p > p {
+		p = ot.p
+	}
+	if p > ot.r {
+		return false
+	}
+
+	ot.p, ot.hasP = p, true
+	span.TraceState().FromRaw(ot.serialize(members))
+	span.Attributes().PutDouble(adjustedCountAttribute, 1/pValueProbability(p))
+	return true
+}
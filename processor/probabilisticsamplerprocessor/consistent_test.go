@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probabilisticsamplerprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/idutils"
+)
+
+func Test_newConsistentSampler(t *testing.T) {
+	tests := []struct {
+		samplingPercentage float32
+		want               consistentSampler
+	}{
+		{samplingPercentage: 100, want: consistentSampler{pLow: 0, pHigh: 0, pLowProbability: 1}},
+		{samplingPercentage: 200, want: consistentSampler{pLow: 0, pHigh: 0, pLowProbability: 1}},
+		{samplingPercentage: 0, want: consistentSampler{pLow: 63, pHigh: 63, pLowProbability: 1}},
+		{samplingPercentage: 25, want: consistentSampler{pLow: 2, pHigh: 2, pLowProbability: 1}},
+		{samplingPercentage: 75, want: consistentSampler{pLow: 0, pHigh: 1, pLowProbability: 0.5}},
+		{samplingPercentage: 37.5, want: consistentSampler{pLow: 1, pHigh: 2, pLowProbability: 0.5}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, &tt.want, newConsistentSampler(tt.samplingPercentage), "sampling percentage %v", tt.samplingPercentage)
+	}
+}
+
+func Test_consistentSampler_pValueFor(t *testing.T) {
+	cs := newConsistentSampler(75)
+	assert.Equal(t, uint8(0), cs.pValueFor(idutils.UInt64ToTraceID(0, 0)))
+	assert.Equal(t, uint8(1), cs.pValueFor(idutils.UInt64ToTraceID(1<<63, 0)))
+}
+
+func Test_rValueFor(t *testing.T) {
+	assert.Equal(t, uint8(0), rValueFor(idutils.UInt64ToTraceID(0, 1<<61)))
+	assert.Equal(t, uint8(0), rValueFor(idutils.UInt64ToTraceID(0, 1<<63|1<<61)))
+	assert.Equal(t, uint8(1), rValueFor(idutils.UInt64ToTraceID(0, 1<<60)))
+	assert.Equal(t, uint8(61), rValueFor(idutils.UInt64ToTraceID(0, 1)))
+	assert.Equal(t, uint8(maxRValue), rValueFor(idutils.UInt64ToTraceID(0, 0)))
+}
@@ -16,6 +16,7 @@ package probabilisticsamplerprocessor // import "github.com/open-telemetry/opent
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"go.opencensus.io/stats"
@@ -28,6 +29,8 @@ import (
 	"go.uber.org/zap"
 )
 
+var errConsistentModeLogs = errors.New("consistent mode is not supported for logs")
+
 type logSamplerProcessor struct {
 	scaledSamplingRate uint32
 	hashSeed           uint32
@@ -40,6 +43,9 @@ type logSamplerProcessor struct {
 // newLogsProcessor returns a processor.LogsProcessor that will perform head sampling according to the given
 // configuration.
 func newLogsProcessor(ctx context.Context, set processor.CreateSettings, nextConsumer consumer.Logs, cfg *Config) (processor.Logs, error) {
+	if cfg.Mode == consistentSamplerMode {
+		return nil, errConsistentModeLogs
+	}
 
 	lsp := &logSamplerProcessor{
 		scaledSamplingRate: uint32(cfg.SamplingPercentage * percentageScaleFactor),
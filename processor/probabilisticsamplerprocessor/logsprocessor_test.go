@@ -57,6 +57,15 @@ func TestNewLogsProcessor(t *testing.T) {
 				HashSeed:           4321,
 			},
 		},
+		{
+			name:         "consistent_mode",
+			nextConsumer: consumertest.NewNop(),
+			cfg: &Config{
+				SamplingPercentage: 15.5,
+				Mode:               consistentSamplerMode,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
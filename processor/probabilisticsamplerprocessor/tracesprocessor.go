@@ -55,6 +55,7 @@ const (
 type traceSamplerProcessor struct {
 	scaledSamplingRate uint32
 	hashSeed           uint32
+	consistent         *consistentSampler
 	logger             *zap.Logger
 }
 
@@ -67,6 +68,9 @@ func newTracesProcessor(ctx context.Context, set processor.CreateSettings, cfg *
 		hashSeed:           cfg.HashSeed,
 		logger:             set.Logger,
 	}
+	if cfg.Mode == consistentSamplerMode {
+		tsp.consistent = newConsistentSampler(cfg.SamplingPercentage)
+	}
 
 	return processorhelper.NewTracesProcessor(
 		ctx,
@@ -100,16 +104,25 @@ func (tsp *traceSamplerProcessor) processTraces(ctx context.Context, td ptrace.T
 					statCountTracesSampled.M(int64(1)),
 				)
 
-				// If one assumes random trace ids hashing may seems avoidable, however, traces can be coming from sources
-				// with various different criteria to generate trace id and perhaps were already sampled without hashing.
-				// Hashing here prevents bias due to such systems.
-				tidBytes := s.TraceID()
-				sampled := sp == mustSampleSpan ||
-					computeHash(tidBytes[:], tsp.hashSeed)&bitMaskHashBuckets < tsp.scaledSamplingRate
+				policy := "trace_id_hash"
+				var sampled bool
+				switch {
+				case sp == mustSampleSpan:
+					sampled = true
+				case tsp.consistent != nil:
+					policy = "consistent_probability"
+					sampled = tsp.consistent.sample(s)
+				default:
+					// If one assumes random trace ids hashing may seems avoidable, however, traces can be coming from sources
+					// with various different criteria to generate trace id and perhaps were already sampled without hashing.
+					// Hashing here prevents bias due to such systems.
+					tidBytes := s.TraceID()
+					sampled = computeHash(tidBytes[:], tsp.hashSeed)&bitMaskHashBuckets < tsp.scaledSamplingRate
+				}
 
 				_ = stats.RecordWithTags(
 					ctx,
-					[]tag.Mutator{tag.Upsert(tagPolicyKey, "trace_id_hash"), tag.Upsert(tagSampledKey, strconv.FormatBool(sampled))},
+					[]tag.Mutator{tag.Upsert(tagPolicyKey, policy), tag.Upsert(tagSampledKey, strconv.FormatBool(sampled))},
 					statCountTracesSampled.M(int64(1)),
 				)
 				return !sampled
@@ -61,6 +61,14 @@ func TestNewTracesProcessor(t *testing.T) {
 				HashSeed:           4321,
 			},
 		},
+		{
+			name:         "happy_path_consistent",
+			nextConsumer: consumertest.NewNop(),
+			cfg: &Config{
+				SamplingPercentage: 13.33,
+				Mode:               consistentSamplerMode,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -130,6 +138,36 @@ func Test_tracesamplerprocessor_SamplingPercentageRange(t *testing.T) {
 			numTracesPerBatch: 1,
 			acceptableDelta:   0.0,
 		},
+		{
+			name: "consistent_sampling_small",
+			cfg: &Config{
+				SamplingPercentage: 5,
+				Mode:               consistentSamplerMode,
+			},
+			numBatches:        1e5,
+			numTracesPerBatch: 2,
+			acceptableDelta:   0.2,
+		},
+		{
+			name: "consistent_sampling_medium",
+			cfg: &Config{
+				SamplingPercentage: 33.0,
+				Mode:               consistentSamplerMode,
+			},
+			numBatches:        1e5,
+			numTracesPerBatch: 2,
+			acceptableDelta:   0.5,
+		},
+		{
+			name: "consistent_sampling_all",
+			cfg: &Config{
+				SamplingPercentage: 100.0,
+				Mode:               consistentSamplerMode,
+			},
+			numBatches:        1e5,
+			numTracesPerBatch: 1,
+			acceptableDelta:   0.0,
+		},
 	}
 	const testSvcName = "test-svc"
 	for _, tt := range tests {
@@ -323,6 +361,104 @@ func Test_tracesamplerprocessor_SpanSamplingPriority(t *testing.T) {
 
 // Test_parseSpanSamplingPriority ensures that the function parsing the attributes is taking "sampling.priority"
 // attribute correctly.
+func Test_tracesamplerprocessor_ConsistentTraceState(t *testing.T) {
+	// r-value 1: the second half of the trace ID has a single leading zero out of 62 bits.
+	traceID := idutils.UInt64ToTraceID(1, 1<<60)
+	tests := []struct {
+		name               string
+		samplingPercentage float32
+		traceState         string
+		sampled            bool
+		wantTraceState     string
+		wantAdjustedCount  float64
+	}{
+		{
+			name:               "derive_r_value",
+			samplingPercentage: 50,
+			sampled:            true,
+			wantTraceState:     "ot=p:1;r:1",
+			wantAdjustedCount:  2,
+		},
+		{
+			name:               "r_value_too_small",
+			samplingPercentage: 25,
+			sampled:            false,
+		},
+		{
+			name:               "use_existing_r_value",
+			samplingPercentage: 25,
+			traceState:         "vendor=value,ot=r:5;x:y",
+			sampled:            true,
+			wantTraceState:     "ot=p:2;r:5;x:y,vendor=value",
+			wantAdjustedCount:  4,
+		},
+		{
+			name:               "never_sample_up",
+			samplingPercentage: 50,
+			traceState:         "ot=p:3;r:5",
+			sampled:            true,
+			wantTraceState:     "ot=p:3;r:5",
+			wantAdjustedCount:  8,
+		},
+		{
+			name:               "sample_down",
+			samplingPercentage: 12.5,
+			traceState:         "ot=p:1;r:2",
+			sampled:            false,
+		},
+		{
+			name:               "inconsistent_p_value",
+			samplingPercentage: 50,
+			traceState:         "ot=p:4;r:2",
+			sampled:            true,
+			wantTraceState:     "ot=p:1;r:2",
+			wantAdjustedCount:  2,
+		},
+		{
+			name:               "p_value_without_r_value",
+			samplingPercentage: 100,
+			traceState:         "ot=p:3",
+			sampled:            true,
+			wantTraceState:     "ot=p:0;r:1",
+			wantAdjustedCount:  1,
+		},
+		{
+			name:               "zero_probability",
+			samplingPercentage: 0,
+			traceState:         "ot=r:62",
+			sampled:            false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := new(consumertest.TracesSink)
+			cfg := &Config{
+				SamplingPercentage: tt.samplingPercentage,
+				Mode:               consistentSamplerMode,
+			}
+			tsp, err := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+			require.NoError(t, err)
+
+			td := ptrace.NewTraces()
+			span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+			span.SetTraceID(traceID)
+			span.TraceState().FromRaw(tt.traceState)
+			require.NoError(t, tsp.ConsumeTraces(context.Background(), td))
+
+			if !tt.sampled {
+				assert.Equal(t, 0, sink.SpanCount())
+				return
+			}
+			require.Equal(t, 1, sink.SpanCount())
+			got := sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+			assert.Equal(t, tt.wantTraceState, got.TraceState().AsRaw())
+			adjustedCount, ok := got.Attributes().Get(adjustedCountAttribute)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantAdjustedCount, adjustedCount.Double())
+		})
+	}
+}
+
 func Test_parseSpanSamplingPriority(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probabilisticsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/probabilisticsamplerprocessor"
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// otTraceStateKey is the W3C tracestate vendor key reserved for OpenTelemetry.
+	otTraceStateKey = "ot"
+
+	// maxRValue is the largest valid r-value, see
+	// https://opentelemetry.io/docs/reference/specification/trace/tracestate-probability-sampling/
+	maxRValue = 62
+	// zeroPValue is the p-value of spans sampled with zero probability, i.e. with an adjusted count of zero.
+	zeroPValue = 63
+)
+
+// otTraceState is the parsed "ot" entry of a W3C tracestate.
+type otTraceState struct {
+	p, r       uint8
+	hasP, hasR bool
+	// fields holds the other "key:value" fields of the entry, in order.
+	fields []string
+}
+
+// parseTraceState splits a W3C tracestate into its "ot" entry and the remaining
+// list members. Invalid p and r values are discarded.
+func parseTraceState(ts string) (ot otTraceState, members []string) {
+	for _, member := range strings.Split(ts, ",") {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			continue
+		}
+		if !strings.HasPrefix(member, otTraceStateKey+"=") {
+			members = append(members, member)
+			continue
+		}
+		for _, field := range strings.Split(strings.TrimPrefix(member, otTraceStateKey+"="), ";") {
+			key, v, _ := strings.Cut(field, ":")
+			switch key {
+			case "p":
+				if p, err := strconv.ParseUint(v, 10, 8); err == nil && p <= zeroPValue {
+					ot.p, ot.hasP = uint8(p), true
+				}
+			case "r":
+				if r, err := strconv.ParseUint(v, 10, 8); err == nil && r <= maxRValue {
+					ot.r, ot.hasR = uint8(r), true
+				}
+			case "":
+			default:
+				ot.fields = append(ot.fields, field)
+			}
+		}
+	}
+	return ot, members
+}
+
+// serialize returns the W3C tracestate with the "ot" entry as the leftmost
+// list member, as required for modified entries.
+func (ot otTraceState) serialize(members []string) string {
+	var fields []string
+	if ot.hasP {
+		fields = append(fields, "p:"+strconv.Itoa(int(ot.p)))
+	}
+	if ot.hasR {
+		fields = append(fields, "r:"+strconv.Itoa(int(ot.r)))
+	}
+	fields = append(fields, ot.fields...)
+	if len(fields) == 0 {
+		return strings.Join(members, ",")
+	}
+	return strings.Join(append([]string{otTraceStateKey + "=" + strings.Join(fields, ";")}, members...), ",")
+}
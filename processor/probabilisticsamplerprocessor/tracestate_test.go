@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package probabilisticsamplerprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseTraceState(t *testing.T) {
+	tests := []struct {
+		name        string
+		traceState  string
+		wantOT      otTraceState
+		wantMembers []string
+		serialized  string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:        "no_ot_entry",
+			traceState:  "a=1, b=2",
+			wantMembers: []string{"a=1", "b=2"},
+			serialized:  "a=1,b=2",
+		},
+		{
+			name:        "p_and_r_values",
+			traceState:  "a=1,ot=r:10;p:3;th:x",
+			wantOT:      otTraceState{p: 3, hasP: true, r: 10, hasR: true, fields: []string{"th:x"}},
+			wantMembers: []string{"a=1"},
+			serialized:  "ot=p:3;r:10;th:x,a=1",
+		},
+		{
+			name:       "invalid_values",
+			traceState: "ot=p:64;r:63",
+		},
+		{
+			name:       "zero_p_value",
+			traceState: "ot=p:63",
+			wantOT:     otTraceState{p: 63, hasP: true},
+			serialized: "ot=p:63",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ot, members := parseTraceState(tt.traceState)
+			assert.Equal(t, tt.wantOT, ot)
+			assert.Equal(t, tt.wantMembers, members)
+			assert.Equal(t, tt.serialized, ot.serialize(members))
+		})
+	}
+}
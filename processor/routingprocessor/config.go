@@ -25,6 +25,8 @@ var (
 	errNoExporters            = errors.New("no exporters defined for the route")
 	errNoTableItems           = errors.New("the routing table is empty")
 	errNoMissingFromAttribute = errors.New("the FromAttribute property is empty")
+	errTemplateWithoutValue   = errors.New("the exporter template requires the route's value to be read from the auth data")
+	errInvalidMaxExporters    = errors.New("the maximum number of exporters created from the template can't be negative")
 )
 
 // Config defines configuration for the Routing processor.
@@ -38,6 +40,7 @@ type Config struct {
 	// The allowed values are:
 	// - "context" - the attribute must exist in the incoming context
 	// - "resource" - the attribute must exist in resource attributes
+	// - "auth" - the attribute must exist in the auth data of the authenticated client
 	// The default value is "context".
 	// Optional.
 	AttributeSource AttributeSource `mapstructure:"attribute_source"`
@@ -58,16 +61,23 @@ type Config struct {
 	// Table contains the routing table for this processor.
 	// Required.
 	Table []RoutingTableItem `mapstructure:"table"`
+
+	// ExporterTemplate configures the exporters created for the values of the
+	// attribute defined in `from_attribute` that aren't in the routing table,
+	// e.g. to export the data of each tenant with its own exporter.
+	// Optional.
+	ExporterTemplate *ExporterTemplate `mapstructure:"exporter_template"`
 }
 
 // Validate checks if the processor configuration is valid.
 func (c *Config) Validate() error {
-	// validate that there's at least one item in the table
-	if len(c.Table) == 0 {
+	// validate that there's at least one item in the table, unless the
+	// exporters are created from the template
+	if len(c.Table) == 0 && c.ExporterTemplate == nil {
 		return fmt.Errorf("invalid routing table: %w", errNoTableItems)
 	}
 
-	ottlRoutingOnly := true
+	ottlRoutingOnly := c.ExporterTemplate == nil
 	// validate that every route has a value for the routing attribute and has
 	// at least one exporter
 	for _, item := range c.Table {
@@ -103,6 +113,17 @@ func (c *Config) Validate() error {
 		return errors.New("using a different attribute source than 'attribute' and drop_resource_routing_attribute is set to true")
 	}
 
+	if c.ExporterTemplate != nil {
+		// the values read from the context are sent by the clients, which could
+		// create exporters up to the maximum with arbitrary values
+		if c.AttributeSource != authAttributeSource {
+			return errTemplateWithoutValue
+		}
+		if c.ExporterTemplate.MaxExporters < 0 {
+			return errInvalidMaxExporters
+		}
+	}
+
 	return nil
 }
 
@@ -111,6 +132,7 @@ type AttributeSource string
 const (
 	contextAttributeSource  = AttributeSource("context")
 	resourceAttributeSource = AttributeSource("resource")
+	authAttributeSource     = AttributeSource("auth")
 
 	defaultAttributeSource = contextAttributeSource
 )
@@ -132,6 +154,29 @@ type RoutingTableItem struct {
 	Exporters []string `mapstructure:"exporters"`
 }
 
+// ExporterTemplate specifies the exporters created for the route's values that
+// aren't in the routing table.
+type ExporterTemplate struct {
+	// Exporter is the type of the exporters created from the template. The
+	// exporter doesn't need to be part of the pipeline.
+	// The default value is "otlp".
+	// Optional.
+	Exporter string `mapstructure:"exporter"`
+
+	// Config is the configuration of the exporters created from the template.
+	// The "{value}" placeholder is replaced with the route's value in the
+	// string values of the configuration.
+	// Required.
+	Config map[string]interface{} `mapstructure:"config"`
+
+	// MaxExporters is the maximum number of exporters created from the template.
+	// Once reached, the data of the additional route's values are routed to the
+	// default exporters.
+	// The default value is 100.
+	// Optional.
+	MaxExporters int `mapstructure:"max_exporters"`
+}
+
 // rewriteRoutingEntriesToOTTL translates the attributes-based routing into OTTL
 func rewriteRoutingEntriesToOTTL(cfg *Config) *Config {
 	if cfg.AttributeSource != resourceAttributeSource {
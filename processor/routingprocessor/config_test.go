@@ -119,6 +119,31 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			configPath: "config.yaml",
+			id:         component.NewIDWithName(typeStr, "auth"),
+			expected: &Config{
+				DefaultExporters: []string{"otlp"},
+				AttributeSource:  authAttributeSource,
+				FromAttribute:    "subject",
+				Table: []RoutingTableItem{
+					{
+						Value:     "acme",
+						Exporters: []string{"otlp/acme"},
+					},
+				},
+				ExporterTemplate: &ExporterTemplate{
+					Exporter:     "otlp",
+					MaxExporters: 50,
+					Config: map[string]interface{}{
+						"endpoint": "{value}.tenants.example.com:4317",
+						"headers": map[string]interface{}{
+							"X-Scope-OrgID": "{value}",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range testcases {
@@ -188,6 +213,49 @@ func TestValidateConfig(t *testing.T) {
 			},
 			error: "using a different attribute source than 'attribute' and drop_resource_routing_attribute is set to true",
 		},
+		{
+			name: "exporter template with resource as routing attribute source",
+			config: &Config{
+				FromAttribute:    "attr",
+				AttributeSource:  resourceAttributeSource,
+				ExporterTemplate: &ExporterTemplate{},
+				Table: []RoutingTableItem{
+					{
+						Exporters: []string{"otlp"},
+						Value:     "test",
+					},
+				},
+			},
+			error: errTemplateWithoutValue.Error(),
+		},
+		{
+			name: "exporter template with context as routing attribute source",
+			config: &Config{
+				FromAttribute:    "X-Tenant",
+				AttributeSource:  contextAttributeSource,
+				ExporterTemplate: &ExporterTemplate{},
+			},
+			error: errTemplateWithoutValue.Error(),
+		},
+		{
+			name: "exporter template without attribute to read the route's value from",
+			config: &Config{
+				AttributeSource:  authAttributeSource,
+				ExporterTemplate: &ExporterTemplate{},
+			},
+			error: "invalid attribute to read the route's value from: the FromAttribute property is empty",
+		},
+		{
+			name: "exporter template with negative max exporters",
+			config: &Config{
+				FromAttribute:   "subject",
+				AttributeSource: authAttributeSource,
+				ExporterTemplate: &ExporterTemplate{
+					MaxExporters: -1,
+				},
+			},
+			error: errInvalidMaxExporters.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -16,30 +16,72 @@ package routingprocessor // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"go.opentelemetry.io/collector/client"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 )
 
 // extractor is responsible for extracting configured attributes from the processed data.
-// Currently, it can only extract the attributes from context.
+// Currently, it can only extract the attributes from context, either from the
+// request's metadata or from the auth data of the authenticated client.
 type extractor struct {
 	fromAttr string
+	source   AttributeSource
 	logger   *zap.Logger
 }
 
 // newExtractor creates new extractor which can extract attributes from logs,
 // metrics and traces from requested attribute source and from the provided
 // attribute name.
-func newExtractor(fromAttr string, logger *zap.Logger) extractor {
+func newExtractor(fromAttr string, source AttributeSource, logger *zap.Logger) extractor {
 	return extractor{
 		fromAttr: fromAttr,
+		source:   source,
 		logger:   logger,
 	}
 }
 
 func (e extractor) extractFromContext(ctx context.Context) string {
+	if e.source == authAttributeSource {
+		return e.extractFromAuth(ctx)
+	}
+	return e.extractFromMetadata(ctx)
+}
+
+// extractFromAuth looks up the attribute in the auth data that the
+// authenticator of the receiver added to the client info, e.g. the subject
+// for the oidc authenticator.
+func (e extractor) extractFromAuth(ctx context.Context) string {
+	info := client.FromContext(ctx)
+	if info.Auth == nil {
+		return ""
+	}
+
+	switch value := info.Auth.GetAttribute(e.fromAttr).(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case []string:
+		if len(value) == 0 {
+			return ""
+		}
+		if len(value) > 1 {
+			e.logger.Debug("more than one value found for the attribute, using only the first",
+				zap.Strings("values", value),
+				zap.String("attribute", e.fromAttr),
+			)
+		}
+		return value[0]
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func (e extractor) extractFromMetadata(ctx context.Context) string {
 	// right now, we only support looking up attributes from requests that have
 	// gone through the gRPC server in that case, it will add the HTTP headers
 	// as context metadata
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/client"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 )
@@ -70,7 +71,94 @@ func TestExtractorForTraces_FromContext(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			e := newExtractor(tc.fromAttr, zap.NewNop())
+			e := newExtractor(tc.fromAttr, contextAttributeSource, zap.NewNop())
+
+			assert.Equal(t,
+				tc.expectedValue,
+				e.extractFromContext(tc.ctxFunc()),
+			)
+		})
+	}
+}
+
+type mockAuthData map[string]interface{}
+
+func (m mockAuthData) GetAttribute(name string) interface{} {
+	return m[name]
+}
+
+func (m mockAuthData) GetAttributeNames() []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestExtractorForTraces_FromAuth(t *testing.T) {
+	authContext := func(auth client.AuthData) func() context.Context {
+		return func() context.Context {
+			return client.NewContext(context.Background(), client.Info{Auth: auth})
+		}
+	}
+
+	testcases := []struct {
+		name          string
+		ctxFunc       func() context.Context
+		fromAttr      string
+		expectedValue string
+	}{
+		{
+			name:          "value from string attribute",
+			ctxFunc:       authContext(mockAuthData{"subject": "acme"}),
+			fromAttr:      "subject",
+			expectedValue: "acme",
+		},
+		{
+			name:          "first value from multi-valued attribute",
+			ctxFunc:       authContext(mockAuthData{"membership": []string{"globex", "acme"}}),
+			fromAttr:      "membership",
+			expectedValue: "globex",
+		},
+		{
+			name:          "no value from empty multi-valued attribute",
+			ctxFunc:       authContext(mockAuthData{"membership": []string{}}),
+			fromAttr:      "membership",
+			expectedValue: "",
+		},
+		{
+			name:          "value from non-string attribute",
+			ctxFunc:       authContext(mockAuthData{"tenant_id": 42}),
+			fromAttr:      "tenant_id",
+			expectedValue: "42",
+		},
+		{
+			name:          "no value from missing attribute",
+			ctxFunc:       authContext(mockAuthData{"subject": "acme"}),
+			fromAttr:      "tenant",
+			expectedValue: "",
+		},
+		{
+			name:          "no value without auth data",
+			ctxFunc:       context.Background,
+			fromAttr:      "subject",
+			expectedValue: "",
+		},
+		{
+			name: "no value from request metadata",
+			ctxFunc: func() context.Context {
+				return metadata.NewIncomingContext(context.Background(),
+					metadata.Pairs("subject", "acme"),
+				)
+			},
+			fromAttr:      "subject",
+			expectedValue: "",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := newExtractor(tc.fromAttr, authAttributeSource, zap.NewNop())
 
 			assert.Equal(t,
 				tc.expectedValue,
@@ -49,17 +49,17 @@ func createDefaultConfig() component.Config {
 
 func createTracesProcessor(_ context.Context, params processor.CreateSettings, cfg component.Config, nextConsumer consumer.Traces) (processor.Traces, error) {
 	warnIfNotLastInPipeline(nextConsumer, params.Logger)
-	return newTracesProcessor(params.TelemetrySettings, cfg), nil
+	return newTracesProcessor(params, cfg), nil
 }
 
 func createMetricsProcessor(_ context.Context, params processor.CreateSettings, cfg component.Config, nextConsumer consumer.Metrics) (processor.Metrics, error) {
 	warnIfNotLastInPipeline(nextConsumer, params.Logger)
-	return newMetricProcessor(params.TelemetrySettings, cfg), nil
+	return newMetricProcessor(params, cfg), nil
 }
 
 func createLogsProcessor(_ context.Context, params processor.CreateSettings, cfg component.Config, nextConsumer consumer.Logs) (processor.Logs, error) {
 	warnIfNotLastInPipeline(nextConsumer, params.Logger)
-	return newLogProcessor(params.TelemetrySettings, cfg), nil
+	return newLogProcessor(params, cfg), nil
 }
 
 func warnIfNotLastInPipeline(nextConsumer interface{}, logger *zap.Logger) {
@@ -31,7 +31,6 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.opentelemetry.io/collector/processor/processortest"
-	"go.uber.org/zap"
 )
 
 func TestProcessorGetsCreatedWithValidConfiguration(t *testing.T) {
@@ -160,7 +159,10 @@ func TestProcessorDoesNotFailToBuildExportersWithMultiplePipelines(t *testing.T)
 		component.DataTypeMetrics: {
 			component.NewID("otlp/metrics"): otlpMetricsExporter,
 		},
-	})
+	}).(*mockHost)
+	host.factories = map[component.Type]component.Factory{
+		otlpExporterFactory.Type(): otlpExporterFactory,
+	}
 
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
@@ -174,7 +176,7 @@ func TestProcessorDoesNotFailToBuildExportersWithMultiplePipelines(t *testing.T)
 			require.NoError(t, err)
 			require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
-			exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, cfg)
+			exp := newMetricProcessor(processortest.NewNopCreateSettings(), cfg)
 			err = exp.Start(context.Background(), host)
 			// assert that no error is thrown due to multiple pipelines and exporters not using the routing processor
 			assert.NoError(t, err)
@@ -217,7 +219,8 @@ func (mp *mockProcessor) processTraces(context.Context, ptrace.Traces) (ptrace.T
 
 type mockHost struct {
 	component.Host
-	exps map[component.DataType]map[component.ID]component.Component
+	exps      map[component.DataType]map[component.ID]component.Component
+	factories map[component.Type]component.Factory
 }
 
 func newMockHost(exps map[component.DataType]map[component.ID]component.Component) component.Host {
@@ -231,6 +234,13 @@ func (m *mockHost) GetExporters() map[component.DataType]map[component.ID]compon
 	return m.exps
 }
 
+func (m *mockHost) GetFactory(kind component.Kind, componentType component.Type) component.Factory {
+	if kind != component.KindExporter {
+		return nil
+	}
+	return m.factories[componentType]
+}
+
 type mockComponent struct {
 	component.StartFunc
 	component.ShutdownFunc
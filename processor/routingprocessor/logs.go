@@ -40,9 +40,19 @@ type logProcessor struct {
 	router    router[exporter.Logs, ottllog.TransformContext]
 }
 
-func newLogProcessor(settings component.TelemetrySettings, config component.Config) *logProcessor {
+func newLogProcessor(params processor.CreateSettings, config component.Config) *logProcessor {
+	settings := params.TelemetrySettings
 	cfg := rewriteRoutingEntriesToOTTL(config.(*Config))
 
+	var template *exporterTemplate[exporter.Logs]
+	if cfg.ExporterTemplate != nil {
+		template = newExporterTemplate(*cfg.ExporterTemplate, settings, params.BuildInfo,
+			func(ctx context.Context, factory exporter.Factory, exporterSet exporter.CreateSettings, exporterCfg component.Config) (exporter.Logs, error) {
+				return factory.CreateLogsExporter(ctx, exporterSet, exporterCfg)
+			},
+		)
+	}
+
 	logParser, _ := ottllog.NewParser(common.Functions[ottllog.TransformContext](), settings)
 
 	return &logProcessor{
@@ -53,17 +63,14 @@ func newLogProcessor(settings component.TelemetrySettings, config component.Conf
 			cfg.DefaultExporters,
 			settings,
 			logParser,
+			template,
 		),
-		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
+		extractor: newExtractor(cfg.FromAttribute, cfg.AttributeSource, settings.Logger),
 	}
 }
 
 func (p *logProcessor) Start(_ context.Context, host component.Host) error {
-	err := p.router.registerExporters(host.GetExporters()[component.DataTypeLogs])
-	if err != nil {
-		return err
-	}
-	return nil
+	return p.router.start(host, component.DataTypeLogs)
 }
 
 func (p *logProcessor) ConsumeLogs(ctx context.Context, l plog.Logs) error {
@@ -154,8 +161,8 @@ func (p *logProcessor) routeForContext(ctx context.Context, l plog.Logs) error {
 	return errs
 }
 
-func (p *logProcessor) Shutdown(context.Context) error {
-	return nil
+func (p *logProcessor) Shutdown(ctx context.Context) error {
+	return p.router.shutdown(ctx)
 }
 
 func (p *logProcessor) Capabilities() consumer.Capabilities {
@@ -40,9 +40,19 @@ type metricsProcessor struct {
 	router    router[exporter.Metrics, ottldatapoint.TransformContext]
 }
 
-func newMetricProcessor(settings component.TelemetrySettings, config component.Config) *metricsProcessor {
+func newMetricProcessor(params processor.CreateSettings, config component.Config) *metricsProcessor {
+	settings := params.TelemetrySettings
 	cfg := rewriteRoutingEntriesToOTTL(config.(*Config))
 
+	var template *exporterTemplate[exporter.Metrics]
+	if cfg.ExporterTemplate != nil {
+		template = newExporterTemplate(*cfg.ExporterTemplate, settings, params.BuildInfo,
+			func(ctx context.Context, factory exporter.Factory, exporterSet exporter.CreateSettings, exporterCfg component.Config) (exporter.Metrics, error) {
+				return factory.CreateMetricsExporter(ctx, exporterSet, exporterCfg)
+			},
+		)
+	}
+
 	dataPointParser, _ := ottldatapoint.NewParser(common.Functions[ottldatapoint.TransformContext](), settings)
 
 	return &metricsProcessor{
@@ -53,17 +63,14 @@ func newMetricProcessor(settings component.TelemetrySettings, config component.C
 			cfg.DefaultExporters,
 			settings,
 			dataPointParser,
+			template,
 		),
-		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
+		extractor: newExtractor(cfg.FromAttribute, cfg.AttributeSource, settings.Logger),
 	}
 }
 
 func (p *metricsProcessor) Start(_ context.Context, host component.Host) error {
-	err := p.router.registerExporters(host.GetExporters()[component.DataTypeMetrics])
-	if err != nil {
-		return err
-	}
-	return nil
+	return p.router.start(host, component.DataTypeMetrics)
 }
 
 func (p *metricsProcessor) ConsumeMetrics(ctx context.Context, m pmetric.Metrics) error {
@@ -161,6 +168,6 @@ func (p *metricsProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
-func (p *metricsProcessor) Shutdown(context.Context) error {
-	return nil
+func (p *metricsProcessor) Shutdown(ctx context.Context) error {
+	return p.router.shutdown(ctx)
 }
@@ -21,10 +21,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
-	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
-	"go.uber.org/zap"
+	"go.opentelemetry.io/collector/processor/processortest"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -39,7 +38,7 @@ func TestMetricProcessorCapabilities(t *testing.T) {
 	}
 
 	// test
-	p := newMetricProcessor(component.TelemetrySettings{}, config)
+	p := newMetricProcessor(processortest.NewNopCreateSettings(), config)
 	require.NotNil(t, p)
 
 	// verify
@@ -57,7 +56,7 @@ func TestMetrics_AreCorrectlySplitPerResourceAttributeRouting(t *testing.T) {
 		},
 	})
 
-	exp := newMetricProcessor(componenttest.NewNopTelemetrySettings(), &Config{
+	exp := newMetricProcessor(processortest.NewNopCreateSettings(), &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  resourceAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -115,7 +114,7 @@ func TestMetrics_RoutingWorks_Context(t *testing.T) {
 		},
 	})
 
-	exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newMetricProcessor(processortest.NewNopCreateSettings(), &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  contextAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -174,7 +173,7 @@ func TestMetrics_RoutingWorks_ResourceAttribute(t *testing.T) {
 		},
 	})
 
-	exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newMetricProcessor(processortest.NewNopCreateSettings(), &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  resourceAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -227,7 +226,7 @@ func TestMetrics_RoutingWorks_ResourceAttribute_DropsRoutingAttribute(t *testing
 		},
 	})
 
-	exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newMetricProcessor(processortest.NewNopCreateSettings(), &Config{
 		AttributeSource:              resourceAttributeSource,
 		FromAttribute:                "X-Tenant",
 		DropRoutingResourceAttribute: true,
@@ -287,7 +286,7 @@ func Benchmark_MetricsRouting_ResourceAttribute(b *testing.B) {
 			},
 		})
 
-		exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, cfg)
+		exp := newMetricProcessor(processortest.NewNopCreateSettings(), cfg)
 		assert.NoError(b, exp.Start(context.Background(), host))
 
 		for i := 0; i < b.N; i++ {
@@ -319,7 +318,7 @@ func TestMetricsAreCorrectlySplitPerResourceAttributeRoutingWithOTTL(t *testing.
 		},
 	})
 
-	exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newMetricProcessor(processortest.NewNopCreateSettings(), &Config{
 		DefaultExporters: []string{"otlp"},
 		Table: []RoutingTableItem{
 			{
@@ -15,6 +15,7 @@
 package routingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor"
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -38,6 +39,10 @@ type router[E component.Component, K any] struct {
 
 	defaultExporters []E
 	routes           map[string]routingItem[E, K]
+
+	// template creates the exporters of the values that aren't in the
+	// routing table, nil when not configured.
+	template *exporterTemplate[E]
 }
 
 // newRouter creates a new router instance with its type parameter constrained
@@ -47,6 +52,7 @@ func newRouter[E component.Component, K any](
 	defaultExporterIDs []string,
 	settings component.TelemetrySettings,
 	parser ottl.Parser[K],
+	template *exporterTemplate[E],
 ) router[E, K] {
 	return router[E, K]{
 		logger: settings.Logger,
@@ -56,6 +62,8 @@ func newRouter[E component.Component, K any](
 		defaultExporterIDs: defaultExporterIDs,
 
 		routes: make(map[string]routingItem[E, K]),
+
+		template: template,
 	}
 }
 
@@ -64,6 +72,16 @@ type routingItem[E component.Component, K any] struct {
 	statement *ottl.Statement[K]
 }
 
+func (r *router[E, K]) start(host component.Host, dataType component.DataType) error {
+	if err := r.registerExporters(host.GetExporters()[dataType]); err != nil {
+		return err
+	}
+	if r.template != nil {
+		return r.template.start(host)
+	}
+	return nil
+}
+
 func (r *router[E, K]) registerExporters(available map[component.ID]component.Component) error {
 	// register default exporters
 	err := r.registerDefaultExporters(available)
@@ -182,8 +200,27 @@ func (r *router[E, K]) extractExporter(name string, available map[component.ID]c
 
 func (r *router[E, K]) getExporters(key string) []E {
 	e, ok := r.routes[key]
-	if !ok {
+	if ok {
+		return e.exporters
+	}
+	if r.template == nil || key == "" {
 		return r.defaultExporters
 	}
-	return e.exporters
+
+	exporter, err := r.template.getExporter(key)
+	if err != nil {
+		r.logger.Warn("Failed to get the exporter from the template, using the default exporters",
+			zap.String("value", key),
+			zap.Error(err),
+		)
+		return r.defaultExporters
+	}
+	return []E{exporter}
+}
+
+func (r *router[E, K]) shutdown(ctx context.Context) error {
+	if r.template == nil {
+		return nil
+	}
+	return r.template.shutdown(ctx)
 }
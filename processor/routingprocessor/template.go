@@ -0,0 +1,239 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/routingprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/exporter"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTemplateExporter     = "otlp"
+	defaultTemplateMaxExporters = 100
+
+	// templatePlaceholder is replaced with the route's value in the configuration of the template.
+	templatePlaceholder = "{value}"
+)
+
+var (
+	errTooManyExporters   = errors.New("the maximum number of exporters created from the template has been reached")
+	errInvalidRouteValue  = errors.New("the route's value can only contain letters, digits, '.', '_' and '-'")
+	errFactoryNotFound    = errors.New("exporter factory not found")
+	errNotExporterFactory = errors.New("not an exporter factory")
+)
+
+// routeValueRegexp restricts the route's values the exporters are created for,
+// as they are used in the configuration of the exporters, e.g. in endpoints.
+var routeValueRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// createExporterFunc creates an exporter of the pipeline type E from the factory.
+type createExporterFunc[E component.Component] func(context.Context, exporter.Factory, exporter.CreateSettings, component.Config) (E, error)
+
+// exporterTemplate creates, starts and keeps the exporters of the route's values
+// that aren't in the routing table, from the configured template.
+type exporterTemplate[E component.Component] struct {
+	settings  component.TelemetrySettings
+	buildInfo component.BuildInfo
+	config    ExporterTemplate
+	create    createExporterFunc[E]
+
+	host    component.Host
+	factory exporter.Factory
+
+	mu        sync.Mutex
+	exporters map[string]*templateExporter[E]
+}
+
+// templateExporter is an exporter created from the template. It's added to the
+// exporters before being created and started, outside the lock, so that the
+// requests for the other values aren't blocked in the meantime, and the ones
+// for the same value wait for it to be ready.
+type templateExporter[E component.Component] struct {
+	ready    chan struct{}
+	exporter E
+	err      error
+}
+
+func newExporterTemplate[E component.Component](
+	config ExporterTemplate,
+	settings component.TelemetrySettings,
+	buildInfo component.BuildInfo,
+	create createExporterFunc[E],
+) *exporterTemplate[E] {
+	if config.Exporter == "" {
+		config.Exporter = defaultTemplateExporter
+	}
+	if config.MaxExporters == 0 {
+		config.MaxExporters = defaultTemplateMaxExporters
+	}
+	return &exporterTemplate[E]{
+		settings:  settings,
+		buildInfo: buildInfo,
+		config:    config,
+		create:    create,
+		exporters: make(map[string]*templateExporter[E]),
+	}
+}
+
+// start looks up the factory of the exporters and checks that the template
+// results in a valid configuration.
+func (t *exporterTemplate[E]) start(host component.Host) error {
+	exporterType := component.Type(t.config.Exporter)
+	f := host.GetFactory(component.KindExporter, exporterType)
+	if f == nil {
+		return fmt.Errorf("invalid exporter template %q: %w", exporterType, errFactoryNotFound)
+	}
+	factory, ok := f.(exporter.Factory)
+	if !ok {
+		return fmt.Errorf("invalid exporter template %q: %w", exporterType, errNotExporterFactory)
+	}
+	t.host = host
+	t.factory = factory
+
+	if _, err := t.exporterConfig("template"); err != nil {
+		return fmt.Errorf("invalid exporter template %q: %w", exporterType, err)
+	}
+	return nil
+}
+
+// getExporter returns the exporter of the route's value, creating and starting
+// it on first use.
+func (t *exporterTemplate[E]) getExporter(value string) (E, error) {
+	t.mu.Lock()
+	if te, ok := t.exporters[value]; ok {
+		t.mu.Unlock()
+		<-te.ready
+		return te.exporter, te.err
+	}
+
+	var e E
+	if !routeValueRegexp.MatchString(value) {
+		t.mu.Unlock()
+		return e, errInvalidRouteValue
+	}
+	if len(t.exporters) >= t.config.MaxExporters {
+		t.mu.Unlock()
+		return e, errTooManyExporters
+	}
+	te := &templateExporter[E]{ready: make(chan struct{})}
+	t.exporters[value] = te
+	t.mu.Unlock()
+
+	te.exporter, te.err = t.createExporter(value)
+	if te.err != nil {
+		// the exporter is created again on the next use of the value
+		t.mu.Lock()
+		delete(t.exporters, value)
+		t.mu.Unlock()
+	}
+	close(te.ready)
+	return te.exporter, te.err
+}
+
+// createExporter creates and starts the exporter of the route's value.
+func (t *exporterTemplate[E]) createExporter(value string) (E, error) {
+	var e E
+	cfg, err := t.exporterConfig(value)
+	if err != nil {
+		return e, err
+	}
+	set := exporter.CreateSettings{
+		ID:                component.NewIDWithName(t.factory.Type(), typeStr+"_"+value),
+		TelemetrySettings: t.settings,
+		BuildInfo:         t.buildInfo,
+	}
+	set.TelemetrySettings.Logger = t.settings.Logger.With(zap.String("exporter", set.ID.String()))
+
+	// The exporters outlive the requests, they must not use their contexts.
+	e, err = t.create(context.Background(), t.factory, set, cfg)
+	if err != nil {
+		return e, fmt.Errorf("failed to create exporter %q: %w", set.ID, err)
+	}
+	if err = e.Start(context.Background(), t.host); err != nil {
+		// release what the exporter acquired before failing to start
+		err = multierr.Append(err, e.Shutdown(context.Background()))
+		var zero E
+		return zero, fmt.Errorf("failed to start exporter %q: %w", set.ID, err)
+	}
+	t.settings.Logger.Info("Created exporter from the template", zap.String("exporter", set.ID.String()))
+	return e, nil
+}
+
+// exporterConfig builds the configuration of the exporter of the route's value.
+func (t *exporterTemplate[E]) exporterConfig(value string) (component.Config, error) {
+	cfg := t.factory.CreateDefaultConfig()
+	conf := confmap.New()
+	if t.config.Config != nil {
+		conf = confmap.NewFromStringMap(expandTemplate(t.config.Config, value).(map[string]interface{}))
+	}
+	if err := component.UnmarshalConfig(conf, cfg); err != nil {
+		return nil, err
+	}
+	if err := component.ValidateConfig(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// expandTemplate returns a copy of the template with the placeholder replaced
+// with the route's value in the strings.
+func expandTemplate(template interface{}, value string) interface{} {
+	switch v := template.(type) {
+	case map[string]interface{}:
+		expanded := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			expanded[key] = expandTemplate(item, value)
+		}
+		return expanded
+	case []interface{}:
+		expanded := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			expanded = append(expanded, expandTemplate(item, value))
+		}
+		return expanded
+	case string:
+		return strings.ReplaceAll(v, templatePlaceholder, value)
+	default:
+		return v
+	}
+}
+
+// shutdown shuts down the exporters created from the template, waiting for
+// the ones being created.
+func (t *exporterTemplate[E]) shutdown(ctx context.Context) error {
+	t.mu.Lock()
+	exporters := t.exporters
+	t.exporters = make(map[string]*templateExporter[E])
+	t.mu.Unlock()
+
+	var errs error
+	for _, te := range exporters {
+		<-te.ready
+		if te.err == nil {
+			errs = multierr.Append(errs, te.exporter.Shutdown(ctx))
+		}
+	}
+	return errs
+}
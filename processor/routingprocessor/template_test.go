@@ -0,0 +1,333 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routingprocessor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+type mockTemplateConfig struct {
+	Endpoint string            `mapstructure:"endpoint"`
+	Headers  map[string]string `mapstructure:"headers"`
+}
+
+func (c *mockTemplateConfig) Validate() error {
+	if c.Endpoint == "" {
+		return errors.New("endpoint is required")
+	}
+	return nil
+}
+
+// mockTemplateFactory creates traces exporters, keeping them by ID.
+type mockTemplateFactory struct {
+	exporters map[component.ID]*mockTracesExporter
+	configs   map[component.ID]*mockTemplateConfig
+	shutdowns int
+}
+
+func (m *mockTemplateFactory) factory() exporter.Factory {
+	return exporter.NewFactory("mock",
+		func() component.Config { return &mockTemplateConfig{} },
+		exporter.WithTraces(func(_ context.Context, set exporter.CreateSettings, cfg component.Config) (exporter.Traces, error) {
+			e := &mockTracesExporter{}
+			e.ShutdownFunc = func(context.Context) error {
+				m.shutdowns++
+				return nil
+			}
+			m.exporters[set.ID] = e
+			m.configs[set.ID] = cfg.(*mockTemplateConfig)
+			return e, nil
+		}, component.StabilityLevelDevelopment),
+	)
+}
+
+func TestExpandTemplate(t *testing.T) {
+	template := map[string]interface{}{
+		"endpoint": "{value}.tenants.example.com:4317",
+		"headers": map[string]interface{}{
+			"X-Scope-OrgID": "{value}",
+		},
+		"compression": "none",
+		"endpoints":   []interface{}{"{value}-1:4317", "{value}-2:4317"},
+		"timeout":     10,
+		"tls":         nil,
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"endpoint": "acme.tenants.example.com:4317",
+		"headers": map[string]interface{}{
+			"X-Scope-OrgID": "acme",
+		},
+		"compression": "none",
+		"endpoints":   []interface{}{"acme-1:4317", "acme-2:4317"},
+		"timeout":     10,
+		"tls":         nil,
+	}, expandTemplate(template, "acme"))
+
+	// the template is left untouched
+	assert.Equal(t, "{value}.tenants.example.com:4317", template["endpoint"])
+}
+
+func TestTraces_ExporterTemplate(t *testing.T) {
+	defaultExp := &mockTracesExporter{}
+	tExp := &mockTracesExporter{}
+	factory := &mockTemplateFactory{
+		exporters: map[component.ID]*mockTracesExporter{},
+		configs:   map[component.ID]*mockTemplateConfig{},
+	}
+
+	host := newMockHost(map[component.DataType]map[component.ID]component.Component{
+		component.DataTypeTraces: {
+			component.NewID("otlp"):              defaultExp,
+			component.NewIDWithName("otlp", "2"): tExp,
+		},
+	}).(*mockHost)
+	host.factories = map[component.Type]component.Factory{"mock": factory.factory()}
+
+	exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
+		FromAttribute:    "subject",
+		AttributeSource:  authAttributeSource,
+		DefaultExporters: []string{"otlp"},
+		Table: []RoutingTableItem{
+			{
+				Value:     "acme",
+				Exporters: []string{"otlp/2"},
+			},
+		},
+		ExporterTemplate: &ExporterTemplate{
+			Exporter:     "mock",
+			MaxExporters: 2,
+			Config: map[string]interface{}{
+				"endpoint": "{value}.tenants.example.com:4317",
+				"headers": map[string]interface{}{
+					"X-Scope-OrgID": "{value}",
+				},
+			},
+		},
+	})
+	require.NoError(t, exp.Start(context.Background(), host))
+
+	consume := func(subject string) {
+		ctx := context.Background()
+		if subject != "" {
+			ctx = client.NewContext(ctx, client.Info{Auth: mockAuthData{"subject": subject}})
+		}
+		tr := ptrace.NewTraces()
+		tr.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+		require.NoError(t, exp.ConsumeTraces(ctx, tr))
+	}
+
+	t.Run("route in the table is used", func(t *testing.T) {
+		consume("acme")
+		assert.Len(t, tExp.AllTraces(), 1)
+		assert.Empty(t, factory.exporters)
+	})
+
+	globexID := component.NewIDWithName("mock", "routing_globex")
+	t.Run("exporter is created from the template", func(t *testing.T) {
+		consume("globex")
+		consume("globex")
+		require.Len(t, factory.exporters, 1)
+		require.Contains(t, factory.exporters, globexID)
+		assert.Len(t, factory.exporters[globexID].AllTraces(), 2)
+		assert.Equal(t, &mockTemplateConfig{
+			Endpoint: "globex.tenants.example.com:4317",
+			Headers:  map[string]string{"X-Scope-OrgID": "globex"},
+		}, factory.configs[globexID])
+		assert.Empty(t, defaultExp.AllTraces())
+	})
+
+	t.Run("default exporters are used when the maximum number of exporters is reached", func(t *testing.T) {
+		consume("initech")
+		consume("umbrella")
+		assert.Len(t, factory.exporters, 2)
+		assert.Contains(t, factory.exporters, component.NewIDWithName("mock", "routing_initech"))
+		assert.Len(t, defaultExp.AllTraces(), 1)
+	})
+
+	t.Run("default exporters are used for invalid values", func(t *testing.T) {
+		defaultExp.Reset()
+		consume("../globex")
+		assert.Len(t, defaultExp.AllTraces(), 1)
+	})
+
+	t.Run("default exporters are used without value", func(t *testing.T) {
+		defaultExp.Reset()
+		consume("")
+		assert.Len(t, defaultExp.AllTraces(), 1)
+	})
+
+	require.NoError(t, exp.Shutdown(context.Background()))
+	assert.Equal(t, 2, factory.shutdowns)
+}
+
+func TestExporterTemplateStartErrors(t *testing.T) {
+	factory := &mockTemplateFactory{
+		exporters: map[component.ID]*mockTracesExporter{},
+		configs:   map[component.ID]*mockTemplateConfig{},
+	}
+	host := newMockHost(map[component.DataType]map[component.ID]component.Component{}).(*mockHost)
+	host.factories = map[component.Type]component.Factory{"mock": factory.factory()}
+
+	tests := []struct {
+		name     string
+		template ExporterTemplate
+		error    string
+	}{
+		{
+			name:     "unknown exporter",
+			template: ExporterTemplate{Exporter: "unknown"},
+			error:    `invalid exporter template "unknown": exporter factory not found`,
+		},
+		{
+			name:     "invalid configuration",
+			template: ExporterTemplate{Exporter: "mock"},
+			error:    `invalid exporter template "mock": endpoint is required`,
+		},
+		{
+			name: "unknown configuration",
+			template: ExporterTemplate{
+				Exporter: "mock",
+				Config:   map[string]interface{}{"endpoint": "{value}:4317", "unknown": true},
+			},
+			error: `invalid exporter template "mock": 1 error(s) decoding:
+
+* '' has invalid keys: unknown`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
+				FromAttribute:    "subject",
+				AttributeSource:  authAttributeSource,
+				ExporterTemplate: &tt.template,
+			})
+			assert.EqualError(t, exp.Start(context.Background(), host), tt.error)
+		})
+	}
+}
+
+func TestExporterTemplateShutsDownExportersFailingToStart(t *testing.T) {
+	factory := &mockTemplateFactory{
+		exporters: map[component.ID]*mockTracesExporter{},
+		configs:   map[component.ID]*mockTemplateConfig{},
+	}
+	host := newMockHost(map[component.DataType]map[component.ID]component.Component{}).(*mockHost)
+	host.factories = map[component.Type]component.Factory{"mock": factory.factory()}
+
+	var shutdowns atomic.Int32
+	template := newExporterTemplate(
+		ExporterTemplate{
+			Exporter: "mock",
+			Config:   map[string]interface{}{"endpoint": "{value}:4317"},
+		},
+		componenttest.NewNopTelemetrySettings(),
+		component.NewDefaultBuildInfo(),
+		func(ctx context.Context, f exporter.Factory, set exporter.CreateSettings, cfg component.Config) (exporter.Traces, error) {
+			e := &mockTracesExporter{}
+			e.StartFunc = func(context.Context, component.Host) error {
+				return errors.New("connection refused")
+			}
+			e.ShutdownFunc = func(context.Context) error {
+				shutdowns.Add(1)
+				return nil
+			}
+			return e, nil
+		},
+	)
+	require.NoError(t, template.start(host))
+
+	e, err := template.getExporter("acme")
+	assert.EqualError(t, err, `failed to start exporter "mock/routing_acme": connection refused`)
+	assert.Nil(t, e)
+	assert.Equal(t, int32(1), shutdowns.Load())
+
+	// the exporter is created again on the next use of the value
+	_, err = template.getExporter("acme")
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), shutdowns.Load())
+
+	require.NoError(t, template.shutdown(context.Background()))
+	assert.Equal(t, int32(2), shutdowns.Load())
+}
+
+func TestExporterTemplateCreatesExportersOutsideTheLock(t *testing.T) {
+	factory := &mockTemplateFactory{
+		exporters: map[component.ID]*mockTracesExporter{},
+		configs:   map[component.ID]*mockTemplateConfig{},
+	}
+	host := newMockHost(map[component.DataType]map[component.ID]component.Component{}).(*mockHost)
+	host.factories = map[component.Type]component.Factory{"mock": factory.factory()}
+
+	var created atomic.Int32
+	release := make(chan struct{})
+	template := newExporterTemplate(
+		ExporterTemplate{
+			Exporter: "mock",
+			Config:   map[string]interface{}{"endpoint": "{value}:4317"},
+		},
+		componenttest.NewNopTelemetrySettings(),
+		component.NewDefaultBuildInfo(),
+		func(ctx context.Context, f exporter.Factory, set exporter.CreateSettings, cfg component.Config) (exporter.Traces, error) {
+			created.Add(1)
+			e := &mockTracesExporter{}
+			if set.ID.Name() == "routing_globex" {
+				e.StartFunc = func(context.Context, component.Host) error {
+					<-release
+					return nil
+				}
+			}
+			return e, nil
+		},
+	)
+	require.NoError(t, template.start(host))
+
+	var wg sync.WaitGroup
+	globex := make([]exporter.Traces, 3)
+	for i := range globex {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			e, err := template.getExporter("globex")
+			assert.NoError(t, err)
+			globex[i] = e
+		}(i)
+	}
+
+	// the exporters of the other values are created while globex's is starting
+	_, err := template.getExporter("initech")
+	require.NoError(t, err)
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(2), created.Load())
+	assert.Same(t, globex[0], globex[1])
+	assert.Same(t, globex[0], globex[2])
+	require.NoError(t, template.shutdown(context.Background()))
+}
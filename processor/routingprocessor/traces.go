@@ -40,9 +40,19 @@ type tracesProcessor struct {
 	router    router[exporter.Traces, ottlspan.TransformContext]
 }
 
-func newTracesProcessor(settings component.TelemetrySettings, config component.Config) *tracesProcessor {
+func newTracesProcessor(params processor.CreateSettings, config component.Config) *tracesProcessor {
+	settings := params.TelemetrySettings
 	cfg := rewriteRoutingEntriesToOTTL(config.(*Config))
 
+	var template *exporterTemplate[exporter.Traces]
+	if cfg.ExporterTemplate != nil {
+		template = newExporterTemplate(*cfg.ExporterTemplate, settings, params.BuildInfo,
+			func(ctx context.Context, factory exporter.Factory, exporterSet exporter.CreateSettings, exporterCfg component.Config) (exporter.Traces, error) {
+				return factory.CreateTracesExporter(ctx, exporterSet, exporterCfg)
+			},
+		)
+	}
+
 	spanParser, _ := ottlspan.NewParser(common.Functions[ottlspan.TransformContext](), settings)
 
 	return &tracesProcessor{
@@ -53,17 +63,14 @@ func newTracesProcessor(settings component.TelemetrySettings, config component.C
 			cfg.DefaultExporters,
 			settings,
 			spanParser,
+			template,
 		),
-		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
+		extractor: newExtractor(cfg.FromAttribute, cfg.AttributeSource, settings.Logger),
 	}
 }
 
 func (p *tracesProcessor) Start(_ context.Context, host component.Host) error {
-	err := p.router.registerExporters(host.GetExporters()[component.DataTypeTraces])
-	if err != nil {
-		return err
-	}
-	return nil
+	return p.router.start(host, component.DataTypeTraces)
 }
 
 func (p *tracesProcessor) ConsumeTraces(ctx context.Context, t ptrace.Traces) error {
@@ -154,6 +161,6 @@ func (p *tracesProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
-func (p *tracesProcessor) Shutdown(context.Context) error {
-	return nil
+func (p *tracesProcessor) Shutdown(ctx context.Context) error {
+	return p.router.shutdown(ctx)
 }
@@ -26,13 +26,13 @@ import (
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
 	"go.opentelemetry.io/collector/pdata/ptrace"
-	"go.uber.org/zap"
+	"go.opentelemetry.io/collector/processor/processortest"
 	"google.golang.org/grpc/metadata"
 )
 
 func TestTraces_RegisterExportersForValidRoute(t *testing.T) {
 	// prepare
-	exp := newTracesProcessor(component.TelemetrySettings{}, &Config{
+	exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
 		DefaultExporters: []string{"otlp"},
 		FromAttribute:    "X-Tenant",
 		Table: []RoutingTableItem{
@@ -68,7 +68,7 @@ func TestTraces_RegisterExportersForValidRoute(t *testing.T) {
 
 func TestTraces_InvalidExporter(t *testing.T) {
 	//  prepare
-	exp := newTracesProcessor(component.TelemetrySettings{}, &Config{
+	exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
 		DefaultExporters: []string{"otlp"},
 		FromAttribute:    "X-Tenant",
 		Table: []RoutingTableItem{
@@ -103,7 +103,7 @@ func TestTraces_AreCorrectlySplitPerResourceAttributeRouting(t *testing.T) {
 		},
 	})
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  resourceAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -158,7 +158,7 @@ func TestTraces_RoutingWorks_Context(t *testing.T) {
 		},
 	})
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  contextAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -217,7 +217,7 @@ func TestTraces_RoutingWorks_ResourceAttribute(t *testing.T) {
 		},
 	})
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  resourceAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -270,7 +270,7 @@ func TestTraces_RoutingWorks_ResourceAttribute_DropsRoutingAttribute(t *testing.
 		},
 	})
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
 		AttributeSource:              resourceAttributeSource,
 		FromAttribute:                "X-Tenant",
 		DropRoutingResourceAttribute: true,
@@ -316,7 +316,7 @@ func TestTracesAreCorrectlySplitPerResourceAttributeWithOTTL(t *testing.T) {
 		},
 	})
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp := newTracesProcessor(processortest.NewNopCreateSettings(), &Config{
 		DefaultExporters: []string{"otlp"},
 		Table: []RoutingTableItem{
 			{
@@ -436,7 +436,7 @@ func TestTraceProcessorCapabilities(t *testing.T) {
 	}
 
 	// test
-	p := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, config)
+	p := newTracesProcessor(processortest.NewNopCreateSettings(), config)
 	require.NotNil(t, p)
 
 	// verify
@@ -47,7 +47,7 @@ func (c *Config) Validate() error {
 		if err != nil {
 			return err
 		}
-		for _, cs := range c.TraceStatements {
+		for _, cs := range common.GroupContextStatements(c.TraceStatements) {
 			_, err = pc.ParseContextStatements(cs)
 			if err != nil {
 				return err
@@ -60,7 +60,7 @@ func (c *Config) Validate() error {
 		if err != nil {
 			return err
 		}
-		for _, cs := range c.MetricStatements {
+		for _, cs := range common.GroupContextStatements(c.MetricStatements) {
 			_, err = pc.ParseContextStatements(cs)
 			if err != nil {
 				return err
@@ -73,7 +73,7 @@ func (c *Config) Validate() error {
 		if err != nil {
 			return err
 		}
-		for _, cs := range c.LogStatements {
+		for _, cs := range common.GroupContextStatements(c.LogStatements) {
 			_, err = pc.ParseContextStatements(cs)
 			if err != nil {
 				return err
@@ -101,6 +101,30 @@ func TestLoadConfig(t *testing.T) {
 				LogStatements:    []common.ContextStatements{},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "conditions"),
+			expected: &Config{
+				ErrorMode:        ottl.PropagateError,
+				TraceStatements:  []common.ContextStatements{},
+				MetricStatements: []common.ContextStatements{},
+				LogStatements: []common.ContextStatements{
+					{
+						Context: "log",
+						Conditions: []string{
+							`resource.attributes["service.name"] == "checkout"`,
+						},
+						Statements: []string{
+							`set(attributes["team"], "payments")`,
+							`stop() where attributes["handled"] == true`,
+						},
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(typeStr, "bad_syntax_condition"),
+			errorMessage: "unable to parse OTTL condition: 1:37: invalid input text \"= \\\"checkout\\\"\"",
+		},
 		{
 			id:           component.NewIDWithName(typeStr, "bad_syntax_trace"),
 			errorMessage: "unable to parse OTTL statement: 1:18: unexpected token \"where\" (expected \")\")",
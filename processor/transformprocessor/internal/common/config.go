@@ -43,6 +43,24 @@ func (c *ContextID) UnmarshalText(text []byte) error {
 }
 
 type ContextStatements struct {
-	Context    ContextID `mapstructure:"context"`
-	Statements []string  `mapstructure:"statements"`
+	Context ContextID `mapstructure:"context"`
+	// Conditions is a list of OTTL conditions shared by all the statements. The statements
+	// are only executed for the items matching any of the conditions.
+	Conditions []string `mapstructure:"conditions"`
+	Statements []string `mapstructure:"statements"`
+}
+
+// GroupContextStatements splits the context statements entries into runs of consecutive
+// entries of the same context. The entries of a run are executed as a single list of
+// statements, so that stopping skips the remaining entries of the run for the current item.
+func GroupContextStatements(contextStatements []ContextStatements) [][]ContextStatements {
+	var groups [][]ContextStatements
+	for i, cs := range contextStatements {
+		if i > 0 && cs.Context == contextStatements[i-1].Context {
+			groups[len(groups)-1] = append(groups[len(groups)-1], cs)
+			continue
+		}
+		groups = append(groups, []ContextStatements{cs})
+	}
+	return groups
 }
@@ -31,6 +31,20 @@ func Functions[K any]() map[string]interface{} {
 		"ConvertCase":          ottlfuncs.ConvertCase[K],
 		"ParseJSON":            ottlfuncs.ParseJSON[K],
 		"Substring":            ottlfuncs.Substring[K],
+		"SHA256":               ottlfuncs.SHA256[K],
+		"FNV":                  ottlfuncs.FNV[K],
+		"Base64Encode":         ottlfuncs.Base64Encode[K],
+		"Base64Decode":         ottlfuncs.Base64Decode[K],
+		"Time":                 ottlfuncs.Time[K],
+		"Unix":                 ottlfuncs.Unix[K],
+		"FormatTime":           ottlfuncs.FormatTime[K],
+		"Duration":             ottlfuncs.Duration[K],
+		"Format":               ottlfuncs.Format[K],
+		"Len":                  ottlfuncs.Len[K],
+		"Double":               ottlfuncs.Double[K],
+		"IsString":             ottlfuncs.IsString[K],
+		"IsMap":                ottlfuncs.IsMap[K],
+		"ExtractPatterns":      ottlfuncs.ExtractPatterns[K],
 		"keep_keys":            ottlfuncs.KeepKeys[K],
 		"set":                  ottlfuncs.Set[K],
 		"truncate_all":         ottlfuncs.TruncateAll[K],
@@ -42,6 +56,7 @@ func Functions[K any]() map[string]interface{} {
 		"delete_key":           ottlfuncs.DeleteKey[K],
 		"delete_matching_keys": ottlfuncs.DeleteMatchingKeys[K],
 		"merge_maps":           ottlfuncs.MergeMaps[K],
+		"stop":                 ottlfuncs.Stop[K],
 	}
 }
 
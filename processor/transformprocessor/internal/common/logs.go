@@ -110,14 +110,16 @@ func NewLogParserCollection(settings component.TelemetrySettings, options ...Log
 	return lpc, nil
 }
 
-func (pc LogParserCollection) ParseContextStatements(contextStatements ContextStatements) (consumer.Logs, error) {
-	switch contextStatements.Context {
+// ParseContextStatements parses consecutive context statements entries of the same context
+// into a single list of statements, in which each entry is a statement group.
+func (pc LogParserCollection) ParseContextStatements(contextStatements []ContextStatements) (consumer.Logs, error) {
+	switch contextStatements[0].Context {
 	case Log:
-		parsedStatements, err := pc.logParser.ParseStatements(contextStatements.Statements)
+		groups, err := parseStatementGroups(pc.logParser, contextStatements)
 		if err != nil {
 			return nil, err
 		}
-		lStatements := ottllog.NewStatements(parsedStatements, pc.settings, ottllog.WithErrorMode(pc.errorMode))
+		lStatements := ottllog.NewStatementGroups(groups, pc.settings, ottllog.WithErrorMode(pc.errorMode))
 		return logStatements{lStatements}, nil
 	default:
 		statements, err := pc.parseCommonContextStatements(contextStatements)
@@ -209,21 +209,23 @@ func NewMetricParserCollection(settings component.TelemetrySettings, options ...
 	return mpc, nil
 }
 
-func (pc MetricParserCollection) ParseContextStatements(contextStatements ContextStatements) (consumer.Metrics, error) {
-	switch contextStatements.Context {
+// ParseContextStatements parses consecutive context statements entries of the same context
+// into a single list of statements, in which each entry is a statement group.
+func (pc MetricParserCollection) ParseContextStatements(contextStatements []ContextStatements) (consumer.Metrics, error) {
+	switch contextStatements[0].Context {
 	case Metric:
-		parseStatements, err := pc.metricParser.ParseStatements(contextStatements.Statements)
+		groups, err := parseStatementGroups(pc.metricParser, contextStatements)
 		if err != nil {
 			return nil, err
 		}
-		mStatements := ottlmetric.NewStatements(parseStatements, pc.settings, ottlmetric.WithErrorMode(pc.errorMode))
+		mStatements := ottlmetric.NewStatementGroups(groups, pc.settings, ottlmetric.WithErrorMode(pc.errorMode))
 		return metricStatements{mStatements}, nil
 	case DataPoint:
-		parsedStatements, err := pc.dataPointParser.ParseStatements(contextStatements.Statements)
+		groups, err := parseStatementGroups(pc.dataPointParser, contextStatements)
 		if err != nil {
 			return nil, err
 		}
-		dpStatements := ottldatapoint.NewStatements(parsedStatements, pc.settings, ottldatapoint.WithErrorMode(pc.errorMode))
+		dpStatements := ottldatapoint.NewStatementGroups(groups, pc.settings, ottldatapoint.WithErrorMode(pc.errorMode))
 		return dataPointStatements{dpStatements}, nil
 	default:
 		statements, err := pc.parseCommonContextStatements(contextStatements)
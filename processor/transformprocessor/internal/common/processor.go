@@ -153,23 +153,36 @@ type baseContext interface {
 	consumer.Logs
 }
 
-func (pc parserCollection) parseCommonContextStatements(contextStatement ContextStatements) (baseContext, error) {
-	switch contextStatement.Context {
+func (pc parserCollection) parseCommonContextStatements(contextStatements []ContextStatements) (baseContext, error) {
+	switch contextStatements[0].Context {
 	case Resource:
-		parsedStatements, err := pc.resourceParser.ParseStatements(contextStatement.Statements)
+		groups, err := parseStatementGroups(pc.resourceParser, contextStatements)
 		if err != nil {
 			return nil, err
 		}
-		rStatements := ottlresource.NewStatements(parsedStatements, pc.settings, ottlresource.WithErrorMode(pc.errorMode))
+		rStatements := ottlresource.NewStatementGroups(groups, pc.settings, ottlresource.WithErrorMode(pc.errorMode))
 		return resourceStatements{rStatements}, nil
 	case Scope:
-		parsedStatements, err := pc.scopeParser.ParseStatements(contextStatement.Statements)
+		groups, err := parseStatementGroups(pc.scopeParser, contextStatements)
 		if err != nil {
 			return nil, err
 		}
-		sStatements := ottlscope.NewStatements(parsedStatements, pc.settings, ottlscope.WithErrorMode(pc.errorMode))
+		sStatements := ottlscope.NewStatementGroups(groups, pc.settings, ottlscope.WithErrorMode(pc.errorMode))
 		return scopeStatements{sStatements}, nil
 	default:
-		return nil, fmt.Errorf("unknown context %v", contextStatement.Context)
+		return nil, fmt.Errorf("unknown context %v", contextStatements[0].Context)
 	}
 }
+
+// parseStatementGroups parses each context statements entry into a statement group.
+func parseStatementGroups[K any](parser ottl.Parser[K], contextStatements []ContextStatements) ([]*ottl.StatementGroup[K], error) {
+	groups := make([]*ottl.StatementGroup[K], 0, len(contextStatements))
+	for _, cs := range contextStatements {
+		group, err := parser.ParseStatementGroup(cs.Conditions, cs.Statements)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
@@ -157,21 +157,23 @@ func NewTraceParserCollection(settings component.TelemetrySettings, options ...T
 	return tpc, nil
 }
 
-func (pc TraceParserCollection) ParseContextStatements(contextStatements ContextStatements) (consumer.Traces, error) {
-	switch contextStatements.Context {
+// ParseContextStatements parses consecutive context statements entries of the same context
+// into a single list of statements, in which each entry is a statement group.
+func (pc TraceParserCollection) ParseContextStatements(contextStatements []ContextStatements) (consumer.Traces, error) {
+	switch contextStatements[0].Context {
 	case Span:
-		parsedStatements, err := pc.spanParser.ParseStatements(contextStatements.Statements)
+		groups, err := parseStatementGroups(pc.spanParser, contextStatements)
 		if err != nil {
 			return nil, err
 		}
-		sStatements := ottlspan.NewStatements(parsedStatements, pc.settings, ottlspan.WithErrorMode(pc.errorMode))
+		sStatements := ottlspan.NewStatementGroups(groups, pc.settings, ottlspan.WithErrorMode(pc.errorMode))
 		return traceStatements{sStatements}, nil
 	case SpanEvent:
-		parsedStatements, err := pc.spanEventParser.ParseStatements(contextStatements.Statements)
+		groups, err := parseStatementGroups(pc.spanEventParser, contextStatements)
 		if err != nil {
 			return nil, err
 		}
-		seStatements := ottlspanevent.NewStatements(parsedStatements, pc.settings, ottlspanevent.WithErrorMode(pc.errorMode))
+		seStatements := ottlspanevent.NewStatementGroups(groups, pc.settings, ottlspanevent.WithErrorMode(pc.errorMode))
 		return spanEventStatements{seStatements}, nil
 	default:
 		return pc.parseCommonContextStatements(contextStatements)
@@ -37,8 +37,9 @@ func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.E
 		return nil, err
 	}
 
-	contexts := make([]consumer.Logs, len(contextStatements))
-	for i, cs := range contextStatements {
+	groups := common.GroupContextStatements(contextStatements)
+	contexts := make([]consumer.Logs, len(groups))
+	for i, cs := range groups {
 		context, err := pc.ParseContextStatements(cs)
 		if err != nil {
 			return nil, err
@@ -322,6 +322,30 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "OperationA")
 			},
 		},
+		{
+			statement: `set(attributes["test"], SHA256(body)) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "0fe164ca87e2770c80b12f9778366f6c91267508bdc442dbf49cddba376933e5")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Format("%s has %d chars", [body, Len(body)])) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "operationA has 10 chars")
+			},
+		},
+		{
+			statement: `set(time_unix_nano, Time("2023-02-20 13:45:01", "%Y-%m-%d %H:%M:%S")) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2023, 2, 20, 13, 45, 1, 0, time.UTC)))
+			},
+		},
+		{
+			statement: `merge_maps(attributes, ExtractPatterns(body, "^operation(?P<operation>\\w+)$"), "insert") where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("operation", "A")
+			},
+		},
 		{
 			statement: `merge_maps(attributes, ParseJSON("{\"json_test\":\"pass\"}"), "insert") where body == "operationA"`,
 			want: func(td plog.Logs) {
@@ -464,6 +488,117 @@ func Test_ProcessLogs_MixContext(t *testing.T) {
 	}
 }
 
+func Test_ProcessLogs_StatementGroups(t *testing.T) {
+	tests := []struct {
+		name              string
+		contextStatements []common.ContextStatements
+		want              func(td plog.Logs)
+	}{
+		{
+			name: "shared conditions",
+			contextStatements: []common.ContextStatements{
+				{
+					Context:    "log",
+					Conditions: []string{`body == "operationA"`, `attributes["flags"] == "X"`},
+					Statements: []string{
+						`set(attributes["test"], "pass")`,
+						`set(attributes["test2"], "pass") where severity_number == SEVERITY_NUMBER_TRACE`,
+					},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "pass")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test2", "pass")
+			},
+		},
+		{
+			name: "shared conditions not matched",
+			contextStatements: []common.ContextStatements{
+				{
+					Context:    "log",
+					Conditions: []string{`body == "operationC"`},
+					Statements: []string{`set(attributes["test"], "pass")`},
+				},
+			},
+			want: func(td plog.Logs) {
+			},
+		},
+		{
+			name: "stop",
+			contextStatements: []common.ContextStatements{
+				{
+					Context: "log",
+					Statements: []string{
+						`set(attributes["test"], "pass")`,
+						`stop() where body == "operationA"`,
+						`set(attributes["test2"], "pass")`,
+					},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "pass")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test", "pass")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test2", "pass")
+			},
+		},
+		{
+			name: "stop skips the following entries of the same context",
+			contextStatements: []common.ContextStatements{
+				{
+					Context:    "log",
+					Statements: []string{`stop() where body == "operationA"`},
+				},
+				{
+					Context:    "log",
+					Conditions: []string{`severity_number == SEVERITY_NUMBER_TRACE`, `body == "operationB"`},
+					Statements: []string{`set(attributes["test"], "pass")`},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			name: "stop does not skip the entries of another context",
+			contextStatements: []common.ContextStatements{
+				{
+					Context:    "log",
+					Statements: []string{`stop()`},
+				},
+				{
+					Context:    "resource",
+					Statements: []string{`set(attributes["test"], "pass")`},
+				},
+				{
+					Context:    "log",
+					Statements: []string{`set(attributes["test"], "pass")`},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).Resource().Attributes().PutStr("test", "pass")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "pass")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test", "pass")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := constructLogs()
+			processor, err := NewProcessor(tt.contextStatements, ottl.PropagateError, componenttest.NewNopTelemetrySettings())
+			assert.NoError(t, err)
+
+			_, err = processor.ProcessLogs(context.Background(), td)
+			assert.NoError(t, err)
+
+			exTd := constructLogs()
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
 func constructLogs() plog.Logs {
 	td := plog.NewLogs()
 	rs0 := td.ResourceLogs().AppendEmpty()
@@ -37,8 +37,9 @@ func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.E
 		return nil, err
 	}
 
-	contexts := make([]consumer.Traces, len(contextStatements))
-	for i, cs := range contextStatements {
+	groups := common.GroupContextStatements(contextStatements)
+	contexts := make([]consumer.Traces, len(groups))
+	for i, cs := range groups {
 		context, err := pc.ParseContextStatements(cs)
 		if err != nil {
 			return nil, err
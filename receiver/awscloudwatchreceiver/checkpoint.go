@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awscloudwatchreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+const logsCheckpointKeyPrefix = "logs."
+
+// groupCheckpoint is the checkpoint kept for each log group
+type groupCheckpoint struct {
+	// EndTime is the end of the last completed poll of the log group, in milliseconds.
+	EndTime int64 `json:"end_time"`
+	// Streams are the checkpoints of each log stream of the log group.
+	Streams map[string]*streamCheckpoint `json:"streams"`
+}
+
+// streamCheckpoint records the most recent events consumed from a log stream
+type streamCheckpoint struct {
+	// Timestamp is the timestamp of the most recent event, in milliseconds.
+	Timestamp int64 `json:"timestamp"`
+	// EventIDs are the IDs of the consumed events sharing that timestamp.
+	EventIDs []string `json:"event_ids"`
+}
+
+func newGroupCheckpoint() *groupCheckpoint {
+	return &groupCheckpoint{Streams: map[string]*streamCheckpoint{}}
+}
+
+// isProcessed returns true if the event was already consumed from the log stream
+func (gc *groupCheckpoint) isProcessed(stream string, timestamp int64, eventID string) bool {
+	sc, ok := gc.Streams[stream]
+	if !ok {
+		return false
+	}
+	if timestamp != sc.Timestamp {
+		return timestamp < sc.Timestamp
+	}
+	for _, id := range sc.EventIDs {
+		if id == eventID {
+			return true
+		}
+	}
+	return false
+}
+
+// add records the event as consumed from the log stream
+func (gc *groupCheckpoint) add(stream string, timestamp int64, eventID string) {
+	sc, ok := gc.Streams[stream]
+	switch {
+	case !ok || timestamp > sc.Timestamp:
+		gc.Streams[stream] = &streamCheckpoint{Timestamp: timestamp, EventIDs: []string{eventID}}
+	case timestamp == sc.Timestamp:
+		sc.EventIDs = append(sc.EventIDs, eventID)
+	}
+}
+
+// complete marks a poll of the log group as completed up to the end time. Streams that
+// can't be returned by later polls are no longer needed to avoid duplicates.
+func (gc *groupCheckpoint) complete(endTime int64) {
+	gc.EndTime = endTime
+	for stream, sc := range gc.Streams {
+		if sc.Timestamp < endTime {
+			delete(gc.Streams, stream)
+		}
+	}
+}
+
+// checkpoint returns the checkpoint of the log group, loading it from storage if needed
+func (l *logsReceiver) checkpoint(ctx context.Context, group string) *groupCheckpoint {
+	if gc, ok := l.checkpoints[group]; ok {
+		return gc
+	}
+
+	gc := newGroupCheckpoint()
+	l.checkpoints[group] = gc
+	b, err := l.storageClient.Get(ctx, logsCheckpointKeyPrefix+group)
+	if err != nil {
+		l.logger.Info("unable to load checkpoint from storage client, continuing without a previous checkpoint", zap.String("log group", group), zap.Error(err))
+		return gc
+	}
+	if b == nil {
+		return gc
+	}
+	if err = json.Unmarshal(b, gc); err != nil {
+		l.logger.Error("unable to decode stored checkpoint, continuing without a previous checkpoint", zap.String("log group", group), zap.Error(err))
+		gc = newGroupCheckpoint()
+		l.checkpoints[group] = gc
+		return gc
+	}
+	if gc.Streams == nil {
+		gc.Streams = map[string]*streamCheckpoint{}
+	}
+	return gc
+}
+
+// writeCheckpoint persists the checkpoint of the log group to storage
+func (l *logsReceiver) writeCheckpoint(ctx context.Context, group string) error {
+	gc, ok := l.checkpoints[group]
+	if !ok {
+		return nil
+	}
+	b, err := json.Marshal(gc)
+	if err != nil {
+		return fmt.Errorf("unable to write checkpoint: %w", err)
+	}
+	return l.storageClient.Set(ctx, logsCheckpointKeyPrefix+group, b)
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awscloudwatchreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestGroupCheckpoint(t *testing.T) {
+	gc := newGroupCheckpoint()
+	require.False(t, gc.isProcessed("stream", 10, "a"))
+
+	gc.add("stream", 10, "a")
+	gc.add("stream", 10, "b")
+	require.True(t, gc.isProcessed("stream", 9, "z"))
+	require.True(t, gc.isProcessed("stream", 10, "a"))
+	require.True(t, gc.isProcessed("stream", 10, "b"))
+	require.False(t, gc.isProcessed("stream", 10, "c"))
+	require.False(t, gc.isProcessed("stream", 11, "d"))
+	require.False(t, gc.isProcessed("other-stream", 9, "z"))
+
+	gc.add("stream", 11, "d")
+	require.True(t, gc.isProcessed("stream", 10, "c"))
+	require.Equal(t, []string{"d"}, gc.Streams["stream"].EventIDs)
+
+	gc.add("other-stream", 20, "e")
+	gc.complete(15)
+	require.Equal(t, int64(15), gc.EndTime)
+	require.NotContains(t, gc.Streams, "stream")
+	require.Contains(t, gc.Streams, "other-stream")
+}
+
+func TestCheckpointResume(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+	cfg.Logs.Groups = GroupConfig{
+		NamedConfigs: map[string]StreamConfig{
+			testLogGroupName: {},
+		},
+	}
+
+	// the previous poll was interrupted after consuming the first event
+	endTime := testTimeStamp - 1000
+	stored := &groupCheckpoint{
+		EndTime: endTime,
+		Streams: map[string]*streamCheckpoint{
+			testLogStreamName: {Timestamp: testTimeStamp, EventIDs: []string{testEventID}},
+		},
+	}
+	b, err := json.Marshal(stored)
+	require.NoError(t, err)
+	sc := newMemoryStorageClient()
+	require.NoError(t, sc.Set(context.Background(), logsCheckpointKeyPrefix+testLogGroupName, b))
+
+	secondEventID := "37134448277055698880077365577645869800162629528367333380"
+	mc := &mockClient{}
+	mc.On("FilterLogEventsWithContext", mock.Anything, mock.MatchedBy(func(input *cloudwatchlogs.FilterLogEventsInput) bool {
+		return *input.StartTime == endTime
+	}), mock.Anything).Return(
+		&cloudwatchlogs.FilterLogEventsOutput{
+			Events: []*cloudwatchlogs.FilteredLogEvent{
+				{
+					EventId:       &testEventID,
+					LogStreamName: aws.String(testLogStreamName),
+					Message:       aws.String(testLogStreamMessage),
+					Timestamp:     aws.Int64(testTimeStamp),
+				},
+				{
+					EventId:       &secondEventID,
+					LogStreamName: aws.String(testLogStreamName),
+					Message:       aws.String(testLogStreamMessage),
+					Timestamp:     aws.Int64(testTimeStamp),
+				},
+			},
+		}, nil)
+
+	sink := &consumertest.LogsSink{}
+	logsRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	logsRcvr.client = mc
+	logsRcvr.storageClient = sc
+
+	require.NoError(t, logsRcvr.poll(context.Background()))
+	require.Equal(t, 1, sink.LogRecordCount())
+	id, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("id")
+	require.True(t, ok)
+	require.Equal(t, secondEventID, id.Str())
+
+	b, err = sc.Get(context.Background(), logsCheckpointKeyPrefix+testLogGroupName)
+	require.NoError(t, err)
+	var updated groupCheckpoint
+	require.NoError(t, json.Unmarshal(b, &updated))
+	require.Greater(t, updated.EndTime, endTime)
+	require.Empty(t, updated.Streams)
+}
+
+func TestCheckpointNotCompletedOnError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+	cfg.Logs.Groups = GroupConfig{
+		NamedConfigs: map[string]StreamConfig{
+			testLogGroupName: {},
+		},
+	}
+
+	mc := &mockClient{}
+	mc.On("FilterLogEventsWithContext", mock.Anything, mock.Anything, mock.Anything).Return(
+		&cloudwatchlogs.FilterLogEventsOutput{
+			Events: []*cloudwatchlogs.FilteredLogEvent{
+				{
+					EventId:       &testEventID,
+					LogStreamName: aws.String(testLogStreamName),
+					Message:       aws.String(testLogStreamMessage),
+					Timestamp:     aws.Int64(testTimeStamp),
+				},
+			},
+			NextToken: aws.String("next"),
+		}, nil).Once()
+	mc.On("FilterLogEventsWithContext", mock.Anything, mock.Anything, mock.Anything).Return(
+		(*cloudwatchlogs.FilterLogEventsOutput)(nil), errors.New("throttled")).Once()
+
+	sink := &consumertest.LogsSink{}
+	logsRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	logsRcvr.client = mc
+	sc := newMemoryStorageClient()
+	logsRcvr.storageClient = sc
+
+	require.Error(t, logsRcvr.poll(context.Background()))
+	require.Equal(t, 1, sink.LogRecordCount())
+
+	b, err := sc.Get(context.Background(), logsCheckpointKeyPrefix+testLogGroupName)
+	require.NoError(t, err)
+	var stored groupCheckpoint
+	require.NoError(t, json.Unmarshal(b, &stored))
+	require.Zero(t, stored.EndTime)
+	require.Equal(t, testTimeStamp, stored.Streams[testLogStreamName].Timestamp)
+	require.Equal(t, []string{testEventID}, stored.Streams[testLogStreamName].EventIDs)
+}
+
+type memoryStorageClient struct {
+	data map[string][]byte
+}
+
+var _ storage.Client = (*memoryStorageClient)(nil)
+
+func newMemoryStorageClient() *memoryStorageClient {
+	return &memoryStorageClient{data: map[string][]byte{}}
+}
+
+func (m *memoryStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return m.data[key], nil
+}
+
+func (m *memoryStorageClient) Set(_ context.Context, key string, value []byte) error {
+	m.data[key] = value
+	return nil
+}
+
+func (m *memoryStorageClient) Delete(_ context.Context, key string) error {
+	delete(m.data, key)
+	return nil
+}
+
+func (m *memoryStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value, _ = m.Get(ctx, op.Key)
+		case storage.Set:
+			_ = m.Set(ctx, op.Key, op.Value)
+		case storage.Delete:
+			_ = m.Delete(ctx, op.Key)
+		}
+	}
+	return nil
+}
+
+func (m *memoryStorageClient) Close(context.Context) error {
+	return nil
+}
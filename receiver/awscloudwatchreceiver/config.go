@@ -20,22 +20,30 @@ import (
 	"net/url"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.uber.org/multierr"
 )
 
 var (
-	defaultPollInterval  = time.Minute
-	defaultEventLimit    = 1000
-	defaultLogGroupLimit = 50
+	defaultPollInterval        = time.Minute
+	defaultEventLimit          = 1000
+	defaultLogGroupLimit       = 50
+	defaultMetricsPollInterval = 5 * time.Minute
+	defaultMetricsPeriod       = time.Minute
+	defaultMetricsDelay        = 5 * time.Minute
+	defaultMetricLimit         = 100
+	defaultMetricStats         = []string{"Average"}
 )
 
 // Config is the overall config structure for the awscloudwatchreceiver
 type Config struct {
-	Region       string      `mapstructure:"region"`
-	Profile      string      `mapstructure:"profile"`
-	IMDSEndpoint string      `mapstructure:"imds_endpoint"`
-	Logs         *LogsConfig `mapstructure:"logs"`
+	Region       string         `mapstructure:"region"`
+	Profile      string         `mapstructure:"profile"`
+	IMDSEndpoint string         `mapstructure:"imds_endpoint"`
+	StorageID    *component.ID  `mapstructure:"storage"`
+	Logs         *LogsConfig    `mapstructure:"logs"`
+	Metrics      *MetricsConfig `mapstructure:"metrics"`
 }
 
 // LogsConfig is the configuration for the logs portion of this receiver
@@ -64,13 +72,52 @@ type StreamConfig struct {
 	Names    []*string `mapstructure:"names"`
 }
 
+// MetricsConfig is the configuration for the metrics portion of this receiver
+type MetricsConfig struct {
+	PollInterval time.Duration                  `mapstructure:"poll_interval"`
+	Period       time.Duration                  `mapstructure:"period"`
+	Delay        time.Duration                  `mapstructure:"delay"`
+	Named        map[string][]NamedMetricConfig `mapstructure:"named"`
+	Autodiscover *MetricsAutodiscoverConfig     `mapstructure:"autodiscover,omitempty"`
+}
+
+// NamedMetricConfig is the configuration of a metric to collect within a namespace
+type NamedMetricConfig struct {
+	MetricName string                  `mapstructure:"metric_name"`
+	Stats      []string                `mapstructure:"stats"`
+	Dimensions []MetricDimensionConfig `mapstructure:"dimensions"`
+}
+
+// MetricDimensionConfig is a dimension of a metric. When used to filter the
+// autodiscovery of metrics, the value is optional.
+type MetricDimensionConfig struct {
+	Name  string `mapstructure:"name"`
+	Value string `mapstructure:"value"`
+}
+
+// MetricsAutodiscoverConfig is the configuration for the autodiscovery functionality of metrics
+type MetricsAutodiscoverConfig struct {
+	Namespaces []string                `mapstructure:"namespaces"`
+	Limit      int                     `mapstructure:"limit"`
+	Stats      []string                `mapstructure:"stats"`
+	Dimensions []MetricDimensionConfig `mapstructure:"dimensions"`
+}
+
 var (
 	errNoRegion                       = errors.New("no region was specified")
-	errNoLogsConfigured               = errors.New("no logs configured")
+	errNoLogsConfigured               = errors.New("no logs or metrics configured")
 	errInvalidEventLimit              = errors.New("event limit is improperly configured, value must be greater than 0")
 	errInvalidPollInterval            = errors.New("poll interval is incorrect, it must be a duration greater than one second")
 	errInvalidAutodiscoverLimit       = errors.New("the limit of autodiscovery of log groups is improperly configured, value must be greater than 0")
 	errAutodiscoverAndNamedConfigured = errors.New("both autodiscover and named configs are configured, Only one or the other is permitted")
+	errNoMetricsConfigured            = errors.New("no metrics configured, either autodiscover or named metrics must be set")
+	errInvalidMetricsPeriod           = errors.New("period is incorrect, it must be 1s, 5s, 10s, 30s or a multiple of 60s")
+	errInvalidMetricsPollInterval     = errors.New("metrics poll interval is incorrect, it must be a multiple of the period")
+	errInvalidMetricsDelay            = errors.New("metrics delay is incorrect, it must not be negative")
+	errInvalidMetricLimit             = errors.New("the limit of autodiscovery of metrics is improperly configured, value must be greater than 0")
+	errNoMetricName                   = errors.New("a metric name must be specified for each named metric")
+	errNoDimensionName                = errors.New("a name must be specified for each dimension")
+	errInvalidDimensionValue          = errors.New("a value must be specified for each dimension of a named metric")
 )
 
 // Validate validates all portions of the relevant config
@@ -86,13 +133,22 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.Logs == nil && c.Metrics == nil {
+		return errNoLogsConfigured
+	}
+
 	var errs error
-	errs = multierr.Append(errs, c.validateLogsConfig())
+	if c.Logs != nil {
+		errs = multierr.Append(errs, c.validateLogsConfig())
+	}
+	if c.Metrics != nil {
+		errs = multierr.Append(errs, c.Metrics.validate())
+	}
 	return errs
 }
 
 // Unmarshal is a custom unmarshaller that ensures that autodiscover is nil if
-// autodiscover is not specified
+// autodiscover is not specified, and that metrics are only collected if specified
 func (c *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
 		return errors.New("")
@@ -105,14 +161,16 @@ func (c *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser.IsSet("logs::groups::named") && !componentParser.IsSet("logs::groups::autodiscover") {
 		c.Logs.Groups.AutodiscoverConfig = nil
 	}
+
+	if !componentParser.IsSet("metrics") {
+		c.Metrics = nil
+	} else if !componentParser.IsSet("metrics::autodiscover") {
+		c.Metrics.Autodiscover = nil
+	}
 	return nil
 }
 
 func (c *Config) validateLogsConfig() error {
-	if c.Logs == nil {
-		return errNoLogsConfigured
-	}
-
 	if c.Logs.MaxEventsPerRequest <= 0 {
 		return errInvalidEventLimit
 	}
@@ -141,3 +199,66 @@ func validateAutodiscover(cfg AutodiscoverConfig) error {
 	}
 	return nil
 }
+
+func (c *MetricsConfig) validate() error {
+	if !isValidPeriod(c.Period) {
+		return errInvalidMetricsPeriod
+	}
+	if c.PollInterval < c.Period || c.PollInterval%c.Period != 0 {
+		return errInvalidMetricsPollInterval
+	}
+	if c.Delay < 0 {
+		return errInvalidMetricsDelay
+	}
+
+	if c.Autodiscover != nil && len(c.Named) > 0 {
+		return errAutodiscoverAndNamedConfigured
+	}
+
+	if c.Autodiscover != nil {
+		return c.Autodiscover.validate()
+	}
+
+	if len(c.Named) == 0 {
+		return errNoMetricsConfigured
+	}
+
+	for _, metrics := range c.Named {
+		for _, m := range metrics {
+			if m.MetricName == "" {
+				return errNoMetricName
+			}
+			for _, d := range m.Dimensions {
+				if d.Name == "" {
+					return errNoDimensionName
+				}
+				if d.Value == "" {
+					return errInvalidDimensionValue
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *MetricsAutodiscoverConfig) validate() error {
+	if c.Limit <= 0 {
+		return errInvalidMetricLimit
+	}
+	for _, d := range c.Dimensions {
+		if d.Name == "" {
+			return errNoDimensionName
+		}
+	}
+	return nil
+}
+
+// isValidPeriod checks the period against the ones supported by GetMetricData,
+// high resolution periods or multiples of a minute
+func isValidPeriod(period time.Duration) bool {
+	switch period {
+	case time.Second, 5 * time.Second, 10 * time.Second, 30 * time.Second:
+		return true
+	}
+	return period > 0 && period%time.Minute == 0
+}
@@ -136,6 +136,124 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: errAutodiscoverAndNamedConfigured,
 		},
+		{
+			name: "Valid Metrics Only",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: defaultMetricsPollInterval,
+					Period:       defaultMetricsPeriod,
+					Autodiscover: &MetricsAutodiscoverConfig{
+						Limit: defaultMetricLimit,
+					},
+				},
+			},
+		},
+		{
+			name: "Invalid Metrics Period",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: defaultMetricsPollInterval,
+					Period:       90 * time.Second,
+				},
+			},
+			expectedErr: errInvalidMetricsPeriod,
+		},
+		{
+			name: "Invalid Metrics Poll Interval",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: 90 * time.Second,
+					Period:       defaultMetricsPeriod,
+				},
+			},
+			expectedErr: errInvalidMetricsPollInterval,
+		},
+		{
+			name: "Invalid Metrics Delay",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: defaultMetricsPollInterval,
+					Period:       defaultMetricsPeriod,
+					Delay:        -time.Minute,
+				},
+			},
+			expectedErr: errInvalidMetricsDelay,
+		},
+		{
+			name: "No Metrics Configured",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: defaultMetricsPollInterval,
+					Period:       defaultMetricsPeriod,
+				},
+			},
+			expectedErr: errNoMetricsConfigured,
+		},
+		{
+			name: "Invalid Metrics Autodiscover Limit",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: defaultMetricsPollInterval,
+					Period:       defaultMetricsPeriod,
+					Autodiscover: &MetricsAutodiscoverConfig{},
+				},
+			},
+			expectedErr: errInvalidMetricLimit,
+		},
+		{
+			name: "Both Metrics Autodiscover and Named Set",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: defaultMetricsPollInterval,
+					Period:       defaultMetricsPeriod,
+					Autodiscover: &MetricsAutodiscoverConfig{
+						Limit: defaultMetricLimit,
+					},
+					Named: map[string][]NamedMetricConfig{
+						"AWS/EC2": {{MetricName: "CPUUtilization"}},
+					},
+				},
+			},
+			expectedErr: errAutodiscoverAndNamedConfigured,
+		},
+		{
+			name: "Named Metric Without Name",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: defaultMetricsPollInterval,
+					Period:       defaultMetricsPeriod,
+					Named: map[string][]NamedMetricConfig{
+						"AWS/EC2": {{}},
+					},
+				},
+			},
+			expectedErr: errNoMetricName,
+		},
+		{
+			name: "Named Metric Dimension Without Value",
+			config: Config{
+				Region: "us-west-2",
+				Metrics: &MetricsConfig{
+					PollInterval: defaultMetricsPollInterval,
+					Period:       defaultMetricsPeriod,
+					Named: map[string][]NamedMetricConfig{
+						"AWS/EC2": {{
+							MetricName: "CPUUtilization",
+							Dimensions: []MetricDimensionConfig{{Name: "InstanceId"}},
+						}},
+					},
+				},
+			},
+			expectedErr: errInvalidDimensionValue,
+		},
 	}
 
 	for _, tc := range cases {
@@ -150,6 +268,8 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+var fileStorageID = component.NewID("file_storage")
+
 func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
@@ -259,6 +379,63 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "named-metrics",
+			expectedConfig: &Config{
+				Region:    "us-west-1",
+				StorageID: &fileStorageID,
+				Logs: &LogsConfig{
+					PollInterval:        defaultPollInterval,
+					MaxEventsPerRequest: defaultEventLimit,
+					Groups: GroupConfig{
+						AutodiscoverConfig: &AutodiscoverConfig{
+							Limit: defaultLogGroupLimit,
+						},
+					},
+				},
+				Metrics: &MetricsConfig{
+					PollInterval: 5 * time.Minute,
+					Period:       time.Minute,
+					Delay:        10 * time.Minute,
+					Named: map[string][]NamedMetricConfig{
+						"AWS/EC2": {
+							{
+								MetricName: "CPUUtilization",
+								Stats:      []string{"Average", "Maximum"},
+								Dimensions: []MetricDimensionConfig{
+									{Name: "InstanceId", Value: "i-0123456789abcdef0"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "autodiscover-metrics",
+			expectedConfig: &Config{
+				Region: "us-west-1",
+				Logs: &LogsConfig{
+					PollInterval:        defaultPollInterval,
+					MaxEventsPerRequest: defaultEventLimit,
+					Groups: GroupConfig{
+						AutodiscoverConfig: &AutodiscoverConfig{
+							Limit: defaultLogGroupLimit,
+						},
+					},
+				},
+				Metrics: &MetricsConfig{
+					PollInterval: 10 * time.Minute,
+					Period:       defaultMetricsPeriod,
+					Delay:        defaultMetricsDelay,
+					Autodiscover: &MetricsAutodiscoverConfig{
+						Namespaces: []string{"AWS/EC2", "AWS/ELB"},
+						Limit:      20,
+						Dimensions: []MetricDimensionConfig{{Name: "InstanceId"}},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -16,6 +16,7 @@ package awscloudwatchreceiver // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -25,14 +26,19 @@ import (
 const (
 	typeStr        = "awscloudwatch"
 	stabilityLevel = component.StabilityLevelAlpha
+	// metricsStability is the stability level of the metrics pipeline
+	metricsStability = component.StabilityLevelDevelopment
 )
 
+var errMetricsNotConfigured = errors.New("the metrics section must be configured to use the receiver in a metrics pipeline")
+
 // NewFactory returns the component factory for the awscloudwatchreceiver
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
 		receiver.WithLogs(createLogsReceiver, stabilityLevel),
+		receiver.WithMetrics(createMetricsReceiver, metricsStability),
 	)
 }
 
@@ -43,7 +49,21 @@ func createLogsReceiver(
 	consumer consumer.Logs,
 ) (receiver.Logs, error) {
 	cfg := rConf.(*Config)
-	rcvr := newLogsReceiver(cfg, params.Logger, consumer)
+	rcvr := newLogsReceiver(cfg, params, consumer)
+	return rcvr, nil
+}
+
+func createMetricsReceiver(
+	ctx context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	cfg := rConf.(*Config)
+	if cfg.Metrics == nil {
+		return nil, errMetricsNotConfigured
+	}
+	rcvr := newMetricsReceiver(cfg, params, consumer)
 	return rcvr, nil
 }
 
@@ -58,5 +78,13 @@ func createDefaultConfig() component.Config {
 				},
 			},
 		},
+		Metrics: &MetricsConfig{
+			PollInterval: defaultMetricsPollInterval,
+			Period:       defaultMetricsPeriod,
+			Delay:        defaultMetricsDelay,
+			Autodiscover: &MetricsAutodiscoverConfig{
+				Limit: defaultMetricLimit,
+			},
+		},
 	}
 }
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -39,3 +40,24 @@ func TestCreateLogsReceiver(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+func TestCreateMetricsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-2"
+	_, err := NewFactory().CreateMetricsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		cfg,
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+
+	cfg.Metrics = nil
+	_, err = NewFactory().CreateMetricsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		cfg,
+		consumertest.NewNop(),
+	)
+	require.ErrorIs(t, err, errMetricsNotConfigured)
+}
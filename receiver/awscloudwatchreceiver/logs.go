@@ -26,13 +26,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/storageclient"
 )
 
 type logsReceiver struct {
+	id                  component.ID
 	region              string
 	profile             string
 	imdsEndpoint        string
@@ -44,6 +49,9 @@ type logsReceiver struct {
 	logger              *zap.Logger
 	client              client
 	consumer            consumer.Logs
+	storageID           *component.ID
+	storageClient       storage.Client
+	checkpoints         map[string]*groupCheckpoint
 	wg                  *sync.WaitGroup
 	doneChan            chan bool
 }
@@ -108,7 +116,7 @@ type groupRequest interface {
 	groupName() string
 }
 
-func newLogsReceiver(cfg *Config, logger *zap.Logger, consumer consumer.Logs) *logsReceiver {
+func newLogsReceiver(cfg *Config, set receiver.CreateSettings, consumer consumer.Logs) *logsReceiver {
 	groups := []groupRequest{}
 	for logGroupName, sc := range cfg.Logs.Groups.NamedConfigs {
 		for _, prefix := range sc.Prefixes {
@@ -124,6 +132,7 @@ func newLogsReceiver(cfg *Config, logger *zap.Logger, consumer consumer.Logs) *l
 	}
 
 	return &logsReceiver{
+		id:                  set.ID,
 		region:              cfg.Region,
 		profile:             cfg.Profile,
 		consumer:            consumer,
@@ -133,7 +142,9 @@ func newLogsReceiver(cfg *Config, logger *zap.Logger, consumer consumer.Logs) *l
 		pollInterval:        cfg.Logs.PollInterval,
 		nextStartTime:       time.Now().Add(-cfg.Logs.PollInterval),
 		groupRequests:       groups,
-		logger:              logger,
+		logger:              set.Logger,
+		storageID:           cfg.StorageID,
+		checkpoints:         map[string]*groupCheckpoint{},
 		wg:                  &sync.WaitGroup{},
 		doneChan:            make(chan bool),
 	}
@@ -141,6 +152,12 @@ func newLogsReceiver(cfg *Config, logger *zap.Logger, consumer consumer.Logs) *l
 
 func (l *logsReceiver) Start(ctx context.Context, host component.Host) error {
 	l.logger.Debug("starting to poll for Cloudwatch logs")
+	storageClient, err := storageclient.Get(ctx, host, l.storageID, l.id)
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+	l.storageClient = storageClient
+
 	l.wg.Add(1)
 	go l.startPolling(ctx)
 	return nil
@@ -150,6 +167,9 @@ func (l *logsReceiver) Shutdown(ctx context.Context) error {
 	l.logger.Debug("shutting down logs receiver")
 	close(l.doneChan)
 	l.wg.Wait()
+	if l.storageClient != nil {
+		return l.storageClient.Close(ctx)
+	}
 	return nil
 }
 
@@ -183,11 +203,35 @@ func (l *logsReceiver) startPolling(ctx context.Context) {
 
 func (l *logsReceiver) poll(ctx context.Context) error {
 	var errs error
-	startTime := l.nextStartTime
 	endTime := time.Now()
+	failedGroups := map[string]bool{}
 	for _, r := range l.groupRequests {
+		// resume each log group from the end of its last completed poll
+		startTime := l.nextStartTime
+		if gc := l.checkpoint(ctx, r.groupName()); gc.EndTime > 0 {
+			startTime = time.UnixMilli(gc.EndTime)
+		}
 		if err := l.pollForLogs(ctx, r, startTime, endTime); err != nil {
 			errs = multierr.Append(errs, err)
+			failedGroups[r.groupName()] = true
+		}
+	}
+
+	select {
+	case <-l.doneChan:
+		// the poll was interrupted, so the log groups will be resumed from the stream checkpoints
+		return errs
+	default:
+	}
+
+	for _, r := range l.groupRequests {
+		group := r.groupName()
+		if failedGroups[group] {
+			continue
+		}
+		l.checkpoint(ctx, group).complete(endTime.UnixMilli())
+		if err := l.writeCheckpoint(ctx, group); err != nil {
+			errs = multierr.Append(errs, err)
 		}
 	}
 	l.nextStartTime = endTime
@@ -213,14 +257,19 @@ func (l *logsReceiver) pollForLogs(ctx context.Context, pc groupRequest, startTi
 			resp, err := l.client.FilterLogEventsWithContext(ctx, input)
 			if err != nil {
 				l.logger.Error("unable to retrieve logs from cloudwatch", zap.String("log group", pc.groupName()), zap.Error(err))
-				break
+				return err
 			}
 			observedTime := pcommon.NewTimestampFromTime(time.Now())
-			logs := l.processEvents(observedTime, pc.groupName(), resp)
+			gc := l.checkpoint(ctx, pc.groupName())
+			logs := l.processEvents(observedTime, pc.groupName(), gc, resp)
 			if logs.LogRecordCount() > 0 {
 				if err = l.consumer.ConsumeLogs(ctx, logs); err != nil {
 					l.logger.Error("unable to consume logs", zap.Error(err))
-					break
+					return err
+				}
+				l.addToCheckpoint(gc, logs)
+				if err = l.writeCheckpoint(ctx, pc.groupName()); err != nil {
+					l.logger.Error("unable to write checkpoint", zap.String("log group", pc.groupName()), zap.Error(err))
 				}
 			}
 			nextToken = resp.NextToken
@@ -229,7 +278,7 @@ func (l *logsReceiver) pollForLogs(ctx context.Context, pc groupRequest, startTi
 	return nil
 }
 
-func (l *logsReceiver) processEvents(now pcommon.Timestamp, logGroupName string, output *cloudwatchlogs.FilterLogEventsOutput) plog.Logs {
+func (l *logsReceiver) processEvents(now pcommon.Timestamp, logGroupName string, gc *groupCheckpoint, output *cloudwatchlogs.FilterLogEventsOutput) plog.Logs {
 	logs := plog.NewLogs()
 	for _, e := range output.Events {
 		if e.Timestamp == nil {
@@ -247,6 +296,10 @@ func (l *logsReceiver) processEvents(now pcommon.Timestamp, logGroupName string,
 			continue
 		}
 
+		if gc.isProcessed(aws.StringValue(e.LogStreamName), *e.Timestamp, *e.EventId) {
+			continue
+		}
+
 		rl := logs.ResourceLogs().AppendEmpty()
 		resourceAttributes := rl.Resource().Attributes()
 		resourceAttributes.PutStr("aws.region", l.region)
@@ -265,6 +318,23 @@ func (l *logsReceiver) processEvents(now pcommon.Timestamp, logGroupName string,
 	return logs
 }
 
+// addToCheckpoint records the consumed log records in the stream checkpoints of the log group
+func (l *logsReceiver) addToCheckpoint(gc *groupCheckpoint, logs plog.Logs) {
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		rl := logs.ResourceLogs().At(i)
+		stream := ""
+		if v, ok := rl.Resource().Attributes().Get("cloudwatch.log.stream"); ok {
+			stream = v.Str()
+		}
+		lrs := rl.ScopeLogs().At(0).LogRecords()
+		for j := 0; j < lrs.Len(); j++ {
+			lr := lrs.At(j)
+			id, _ := lr.Attributes().Get("id")
+			gc.add(stream, lr.Timestamp().AsTime().UnixMilli(), id.Str())
+		}
+	}
+}
+
 func (l *logsReceiver) discoverGroups(ctx context.Context, auto *AutodiscoverConfig) ([]groupRequest, error) {
 	l.logger.Debug("attempting to discover log groups.", zap.Int("limit", auto.Limit))
 	groups := []groupRequest{}
@@ -326,17 +396,22 @@ func (l *logsReceiver) ensureSession() error {
 	if l.client != nil {
 		return nil
 	}
-	awsConfig := aws.NewConfig().WithRegion(l.region)
+	s, err := newSession(l.region, l.profile, l.imdsEndpoint)
+	l.client = cloudwatchlogs.New(s)
+	return err
+}
+
+// newSession creates an AWS session for the region using the optional profile and IMDS endpoint
+func newSession(region, profile, imdsEndpoint string) (*session.Session, error) {
+	awsConfig := aws.NewConfig().WithRegion(region)
 	options := session.Options{
 		Config: *awsConfig,
 	}
-	if l.imdsEndpoint != "" {
-		options.EC2IMDSEndpoint = l.imdsEndpoint
+	if imdsEndpoint != "" {
+		options.EC2IMDSEndpoint = imdsEndpoint
 	}
-	if l.profile != "" {
-		options.Profile = l.profile
+	if profile != "" {
+		options.Profile = profile
 	}
-	s, err := session.NewSessionWithOptions(options)
-	l.client = cloudwatchlogs.New(s)
-	return err
+	return session.NewSessionWithOptions(options)
 }
@@ -31,7 +31,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/plog"
-	"go.uber.org/zap"
+	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
 )
@@ -42,7 +42,7 @@ func TestStart(t *testing.T) {
 	cfg.Logs.Groups.AutodiscoverConfig = nil
 
 	sink := &consumertest.LogsSink{}
-	logsRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	logsRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 
 	err := logsRcvr.Start(context.Background(), componenttest.NewNopHost())
 	require.NoError(t, err)
@@ -64,7 +64,7 @@ func TestPrefixedConfig(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	alertRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	alertRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	alertRcvr.client = defaultMockClient()
 
 	err := alertRcvr.Start(context.Background(), componenttest.NewNopHost())
@@ -96,7 +96,7 @@ func TestPrefixedNamedStreamsConfig(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	alertRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	alertRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	alertRcvr.client = defaultMockClient()
 
 	err := alertRcvr.Start(context.Background(), componenttest.NewNopHost())
@@ -130,7 +130,7 @@ func TestDiscovery(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	logsRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	logsRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	logsRcvr.client = defaultMockClient()
 
 	require.NoError(t, logsRcvr.Start(context.Background(), componenttest.NewNopHost()))
@@ -156,7 +156,7 @@ func TestShutdownWhileCollecting(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	alertRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	alertRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	doneChan := make(chan time.Time, 1)
 	mc := &mockClient{}
 	mc.On("FilterLogEventsWithContext", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatchlogs.FilterLogEventsOutput{
@@ -211,7 +211,7 @@ func TestAutodiscoverLimit(t *testing.T) {
 	}
 
 	sink := &consumertest.LogsSink{}
-	alertRcvr := newLogsReceiver(cfg, zap.NewNop(), sink)
+	alertRcvr := newLogsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
 	alertRcvr.client = mc
 
 	grs, err := alertRcvr.discoverGroups(context.Background(), cfg.Logs.Groups.AutodiscoverConfig)
@@ -0,0 +1,448 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awscloudwatchreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchreceiver"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+type metricsReceiver struct {
+	region       string
+	profile      string
+	imdsEndpoint string
+	pollInterval time.Duration
+	period       time.Duration
+	delay        time.Duration
+	requests     []metricRequest
+	autodiscover *MetricsAutodiscoverConfig
+	logger       *zap.Logger
+	client       metricsClient
+	consumer     consumer.Metrics
+	wg           *sync.WaitGroup
+	doneChan     chan bool
+
+	// nextStartTimes is the start of the next window to retrieve, by request key.
+	// It is only advanced once the datapoints of a request have been consumed.
+	nextStartTimes map[string]time.Time
+}
+
+// maxQueriesPerRequest is the maximum number of queries allowed in a GetMetricData request
+const maxQueriesPerRequest = 500
+
+type metricsClient interface {
+	ListMetricsWithContext(ctx context.Context, input *cloudwatch.ListMetricsInput, opts ...request.Option) (*cloudwatch.ListMetricsOutput, error)
+	GetMetricDataWithContext(ctx context.Context, input *cloudwatch.GetMetricDataInput, opts ...request.Option) (*cloudwatch.GetMetricDataOutput, error)
+}
+
+// metricRequest is a single statistic of a metric to retrieve
+type metricRequest struct {
+	namespace  string
+	metricName string
+	dimensions []*cloudwatch.Dimension
+	stat       string
+}
+
+// key uniquely identifies the request across polls
+func (mr *metricRequest) key() string {
+	var b strings.Builder
+	b.WriteString(mr.namespace)
+	b.WriteByte(0)
+	b.WriteString(mr.metricName)
+	for _, d := range mr.dimensions {
+		b.WriteByte(0)
+		b.WriteString(aws.StringValue(d.Name))
+		b.WriteByte('=')
+		b.WriteString(aws.StringValue(d.Value))
+	}
+	b.WriteByte(0)
+	b.WriteString(mr.stat)
+	return b.String()
+}
+
+func (mr *metricRequest) query(id string, period time.Duration) *cloudwatch.MetricDataQuery {
+	return &cloudwatch.MetricDataQuery{
+		Id:         aws.String(id),
+		ReturnData: aws.Bool(true),
+		MetricStat: &cloudwatch.MetricStat{
+			Metric: &cloudwatch.Metric{
+				Namespace:  aws.String(mr.namespace),
+				MetricName: aws.String(mr.metricName),
+				Dimensions: mr.dimensions,
+			},
+			Period: aws.Int64(int64(period / time.Second)),
+			Stat:   aws.String(mr.stat),
+		},
+	}
+}
+
+func newMetricsReceiver(cfg *Config, set receiver.CreateSettings, consumer consumer.Metrics) *metricsReceiver {
+	requests := []metricRequest{}
+	for namespace, metrics := range cfg.Metrics.Named {
+		for _, m := range metrics {
+			dimensions := make([]*cloudwatch.Dimension, 0, len(m.Dimensions))
+			for _, d := range m.Dimensions {
+				dimensions = append(dimensions, &cloudwatch.Dimension{Name: aws.String(d.Name), Value: aws.String(d.Value)})
+			}
+			for _, stat := range statsOrDefault(m.Stats) {
+				requests = append(requests, metricRequest{namespace: namespace, metricName: m.MetricName, dimensions: dimensions, stat: stat})
+			}
+		}
+	}
+
+	// safeguard from using both
+	autodiscover := cfg.Metrics.Autodiscover
+	if len(cfg.Metrics.Named) > 0 {
+		autodiscover = nil
+	}
+
+	return &metricsReceiver{
+		region:         cfg.Region,
+		profile:        cfg.Profile,
+		imdsEndpoint:   cfg.IMDSEndpoint,
+		pollInterval:   cfg.Metrics.PollInterval,
+		period:         cfg.Metrics.Period,
+		delay:          cfg.Metrics.Delay,
+		requests:       requests,
+		autodiscover:   autodiscover,
+		logger:         set.Logger,
+		consumer:       consumer,
+		wg:             &sync.WaitGroup{},
+		doneChan:       make(chan bool),
+		nextStartTimes: map[string]time.Time{},
+	}
+}
+
+func (m *metricsReceiver) Start(ctx context.Context, host component.Host) error {
+	m.logger.Debug("starting to poll for Cloudwatch metrics")
+	m.wg.Add(1)
+	go m.startPolling(ctx)
+	return nil
+}
+
+func (m *metricsReceiver) Shutdown(ctx context.Context) error {
+	m.logger.Debug("shutting down metrics receiver")
+	close(m.doneChan)
+	m.wg.Wait()
+	return nil
+}
+
+func (m *metricsReceiver) startPolling(ctx context.Context) {
+	defer m.wg.Done()
+
+	t := time.NewTicker(m.pollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.doneChan:
+			return
+		case <-t.C:
+			if m.autodiscover != nil {
+				requests, err := m.discoverMetrics(ctx, m.autodiscover)
+				if err != nil {
+					m.logger.Error("unable to perform discovery of metrics", zap.Error(err))
+					continue
+				}
+				m.requests = requests
+			}
+
+			err := m.poll(ctx)
+			if err != nil {
+				m.logger.Error("there was an error during the poll", zap.Error(err))
+			}
+		}
+	}
+}
+
+// poll retrieves the datapoints of all the complete periods since the last successful poll of each request.
+// The most recent periods are only retrieved once the delay has elapsed, as CloudWatch publishes datapoints late.
+func (m *metricsReceiver) poll(ctx context.Context) error {
+	now := time.Now()
+	endTime := alignToPeriod(now.Add(-m.delay), m.period)
+	initialStartTime := alignToPeriod(now.Add(-m.delay-m.pollInterval), m.period)
+
+	// Group the requests by the start of their window, so that requests which
+	// failed previously are retried from where they were left off.
+	byStartTime := map[time.Time][]metricRequest{}
+	nextStartTimes := make(map[string]time.Time, len(m.requests))
+	for _, r := range m.requests {
+		key := r.key()
+		startTime, ok := m.nextStartTimes[key]
+		if !ok {
+			startTime = initialStartTime
+		}
+		nextStartTimes[key] = startTime
+		if startTime.Before(endTime) {
+			byStartTime[startTime] = append(byStartTime[startTime], r)
+		}
+	}
+	// requests that are no longer polled, e.g. after a discovery, are forgotten
+	m.nextStartTimes = nextStartTimes
+
+	startTimes := make([]time.Time, 0, len(byStartTime))
+	for startTime := range byStartTime {
+		startTimes = append(startTimes, startTime)
+	}
+	sort.Slice(startTimes, func(i, j int) bool { return startTimes[i].Before(startTimes[j]) })
+
+	var errs error
+	for _, startTime := range startTimes {
+		requests := byStartTime[startTime]
+		for i := 0; i < len(requests); i += maxQueriesPerRequest {
+			end := i + maxQueriesPerRequest
+			if end > len(requests) {
+				end = len(requests)
+			}
+			batch := requests[i:end]
+			if err := m.pollForMetrics(ctx, batch, startTime, endTime); err != nil {
+				errs = multierr.Append(errs, err)
+				continue
+			}
+			for _, r := range batch {
+				m.nextStartTimes[r.key()] = endTime
+			}
+		}
+	}
+	return errs
+}
+
+func (m *metricsReceiver) pollForMetrics(ctx context.Context, requests []metricRequest, startTime, endTime time.Time) error {
+	err := m.ensureSession()
+	if err != nil {
+		return err
+	}
+
+	queries := make([]*cloudwatch.MetricDataQuery, 0, len(requests))
+	requestsByID := make(map[string]*metricRequest, len(requests))
+	for i := range requests {
+		id := fmt.Sprintf("m%d", i)
+		queries = append(queries, requests[i].query(id, m.period))
+		requestsByID[id] = &requests[i]
+	}
+
+	mb := newMetricsBuilder(m.region)
+	nextToken := aws.String("")
+	for nextToken != nil {
+		select {
+		// if done, we want to stop processing paginated stream of results
+		case _, ok := <-m.doneChan:
+			if !ok {
+				return nil
+			}
+		default:
+			input := &cloudwatch.GetMetricDataInput{
+				StartTime:         aws.Time(startTime),
+				EndTime:           aws.Time(endTime),
+				MetricDataQueries: queries,
+				ScanBy:            aws.String(cloudwatch.ScanByTimestampAscending),
+			}
+			if *nextToken != "" {
+				input.NextToken = nextToken
+			}
+			resp, err := m.client.GetMetricDataWithContext(ctx, input)
+			if err != nil {
+				m.logger.Error("unable to retrieve metrics from cloudwatch", zap.Error(err))
+				return err
+			}
+			for _, result := range resp.MetricDataResults {
+				mr, ok := requestsByID[aws.StringValue(result.Id)]
+				if !ok {
+					m.logger.Error("unexpected metric data result", zap.String("id", aws.StringValue(result.Id)))
+					continue
+				}
+				mb.addResult(mr, result)
+			}
+			nextToken = resp.NextToken
+		}
+	}
+
+	metrics := mb.metrics
+	if metrics.DataPointCount() == 0 {
+		return nil
+	}
+	if err = m.consumer.ConsumeMetrics(ctx, metrics); err != nil {
+		m.logger.Error("unable to consume metrics", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (m *metricsReceiver) discoverMetrics(ctx context.Context, auto *MetricsAutodiscoverConfig) ([]metricRequest, error) {
+	m.logger.Debug("attempting to discover metrics.", zap.Int("limit", auto.Limit))
+	requests := []metricRequest{}
+	err := m.ensureSession()
+	if err != nil {
+		return requests, fmt.Errorf("unable to establish a session to auto discover metrics: %w", err)
+	}
+
+	filters := make([]*cloudwatch.DimensionFilter, 0, len(auto.Dimensions))
+	for _, d := range auto.Dimensions {
+		filter := &cloudwatch.DimensionFilter{Name: aws.String(d.Name)}
+		if d.Value != "" {
+			filter.Value = aws.String(d.Value)
+		}
+		filters = append(filters, filter)
+	}
+
+	// an empty namespace discovers metrics of all namespaces
+	namespaces := auto.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+
+	numMetrics := 0
+	for _, namespace := range namespaces {
+		var nextToken = aws.String("")
+		for nextToken != nil {
+			if numMetrics >= auto.Limit {
+				m.logger.Debug("reached limit of the number of metrics to discover."+
+					"To increase the number of metrics able to be discovered, please increase the autodiscover limit field.",
+					zap.Int("metrics_discovered", numMetrics), zap.Int("limit", auto.Limit))
+				return requests, nil
+			}
+
+			req := &cloudwatch.ListMetricsInput{
+				// only metrics with datapoints in the past three hours can have new datapoints
+				RecentlyActive: aws.String(cloudwatch.RecentlyActivePt3h),
+			}
+			if namespace != "" {
+				req.Namespace = aws.String(namespace)
+			}
+			if len(filters) > 0 {
+				req.Dimensions = filters
+			}
+			if *nextToken != "" {
+				req.NextToken = nextToken
+			}
+
+			resp, err := m.client.ListMetricsWithContext(ctx, req)
+			if err != nil {
+				return requests, fmt.Errorf("unable to list metrics: %w", err)
+			}
+
+			for _, metric := range resp.Metrics {
+				if numMetrics == auto.Limit {
+					break
+				}
+				numMetrics++
+				m.logger.Debug("discovered metric", zap.String("metric", metric.GoString()))
+				for _, stat := range statsOrDefault(auto.Stats) {
+					requests = append(requests, metricRequest{
+						namespace:  aws.StringValue(metric.Namespace),
+						metricName: aws.StringValue(metric.MetricName),
+						dimensions: metric.Dimensions,
+						stat:       stat,
+					})
+				}
+			}
+			nextToken = resp.NextToken
+		}
+	}
+	return requests, nil
+}
+
+func (m *metricsReceiver) ensureSession() error {
+	if m.client != nil {
+		return nil
+	}
+	s, err := newSession(m.region, m.profile, m.imdsEndpoint)
+	m.client = cloudwatch.New(s)
+	return err
+}
+
+// metricsBuilder groups the metric data results into a gauge for each metric, and a resource for each namespace
+type metricsBuilder struct {
+	region    string
+	metrics   pmetric.Metrics
+	resources map[string]pmetric.MetricSlice
+	gauges    map[string]pmetric.Metric
+}
+
+func newMetricsBuilder(region string) *metricsBuilder {
+	return &metricsBuilder{
+		region:    region,
+		metrics:   pmetric.NewMetrics(),
+		resources: map[string]pmetric.MetricSlice{},
+		gauges:    map[string]pmetric.Metric{},
+	}
+}
+
+func (mb *metricsBuilder) addResult(mr *metricRequest, result *cloudwatch.MetricDataResult) {
+	if len(result.Values) == 0 {
+		return
+	}
+
+	ms, ok := mb.resources[mr.namespace]
+	if !ok {
+		rm := mb.metrics.ResourceMetrics().AppendEmpty()
+		resourceAttributes := rm.Resource().Attributes()
+		resourceAttributes.PutStr("aws.region", mb.region)
+		resourceAttributes.PutStr("cloudwatch.namespace", mr.namespace)
+		ms = rm.ScopeMetrics().AppendEmpty().Metrics()
+		mb.resources[mr.namespace] = ms
+	}
+
+	key := mr.namespace + "/" + mr.metricName
+	gauge, ok := mb.gauges[key]
+	if !ok {
+		gauge = ms.AppendEmpty()
+		gauge.SetName(mr.metricName)
+		gauge.SetEmptyGauge()
+		mb.gauges[key] = gauge
+	}
+
+	for i, v := range result.Values {
+		if v == nil || i >= len(result.Timestamps) || result.Timestamps[i] == nil {
+			continue
+		}
+		dp := gauge.Gauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(*result.Timestamps[i]))
+		dp.SetDoubleValue(*v)
+		for _, d := range mr.dimensions {
+			dp.Attributes().PutStr(aws.StringValue(d.Name), aws.StringValue(d.Value))
+		}
+		dp.Attributes().PutStr("cloudwatch.statistic", mr.stat)
+	}
+}
+
+// alignToPeriod returns the start of the period containing t, periods being aligned to the Unix epoch
+func alignToPeriod(t time.Time, period time.Duration) time.Time {
+	seconds := int64(period / time.Second)
+	unix := t.Unix()
+	return time.Unix(unix-unix%seconds, 0)
+}
+
+func statsOrDefault(stats []string) []string {
+	if len(stats) == 0 {
+		return defaultMetricStats
+	}
+	return stats
+}
@@ -0,0 +1,318 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awscloudwatchreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestMetricsStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+
+	sink := &consumertest.MetricsSink{}
+	metricsRcvr := newMetricsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+
+	err := metricsRcvr.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	err = metricsRcvr.Shutdown(context.Background())
+	require.NoError(t, err)
+}
+
+func TestNamedMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+	cfg.Metrics.Period = time.Minute
+	cfg.Metrics.Autodiscover = nil
+	cfg.Metrics.Named = map[string][]NamedMetricConfig{
+		"AWS/EC2": {
+			{
+				MetricName: "CPUUtilization",
+				Stats:      []string{"Average", "Maximum"},
+				Dimensions: []MetricDimensionConfig{{Name: "InstanceId", Value: testInstanceID}},
+			},
+		},
+	}
+
+	sink := &consumertest.MetricsSink{}
+	metricsRcvr := newMetricsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	require.Len(t, metricsRcvr.requests, 2)
+
+	ts := time.Date(2023, 2, 21, 10, 0, 0, 0, time.UTC)
+	mc := &mockMetricsClient{}
+	mc.On("GetMetricDataWithContext", mock.Anything, mock.MatchedBy(func(input *cloudwatch.GetMetricDataInput) bool {
+		return len(input.MetricDataQueries) == 2 &&
+			*input.MetricDataQueries[0].MetricStat.Period == 60 &&
+			input.StartTime.Unix()%60 == 0 &&
+			input.EndTime.Unix()%60 == 0
+	}), mock.Anything).Return(&cloudwatch.GetMetricDataOutput{
+		MetricDataResults: []*cloudwatch.MetricDataResult{
+			{
+				Id:         aws.String("m0"),
+				Timestamps: []*time.Time{aws.Time(ts), aws.Time(ts.Add(time.Minute))},
+				Values:     []*float64{aws.Float64(12.5), aws.Float64(15.25)},
+			},
+			{
+				Id:         aws.String("m1"),
+				Timestamps: []*time.Time{aws.Time(ts)},
+				Values:     []*float64{aws.Float64(40)},
+			},
+		},
+	}, nil)
+	metricsRcvr.client = mc
+
+	require.NoError(t, metricsRcvr.poll(context.Background()))
+	require.Len(t, sink.AllMetrics(), 1)
+
+	metrics := sink.AllMetrics()[0]
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+	rm := metrics.ResourceMetrics().At(0)
+	namespace, ok := rm.Resource().Attributes().Get("cloudwatch.namespace")
+	require.True(t, ok)
+	require.Equal(t, "AWS/EC2", namespace.Str())
+
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, ms.Len())
+	require.Equal(t, "CPUUtilization", ms.At(0).Name())
+	require.Equal(t, pmetric.MetricTypeGauge, ms.At(0).Type())
+
+	dps := ms.At(0).Gauge().DataPoints()
+	require.Equal(t, 3, dps.Len())
+	require.Equal(t, ts, dps.At(0).Timestamp().AsTime())
+	require.Equal(t, 12.5, dps.At(0).DoubleValue())
+	require.Equal(t, map[string]interface{}{
+		"InstanceId":           testInstanceID,
+		"cloudwatch.statistic": "Average",
+	}, dps.At(0).Attributes().AsRaw())
+	stat, ok := dps.At(2).Attributes().Get("cloudwatch.statistic")
+	require.True(t, ok)
+	require.Equal(t, "Maximum", stat.Str())
+
+	// the next poll starts where the previous one ended, so periods already
+	// retrieved are not retrieved again
+	require.Len(t, metricsRcvr.nextStartTimes, 2)
+	for key := range metricsRcvr.nextStartTimes {
+		metricsRcvr.nextStartTimes[key] = alignToPeriod(time.Now(), time.Minute).Add(time.Minute)
+	}
+	require.NoError(t, metricsRcvr.poll(context.Background()))
+	mc.AssertNumberOfCalls(t, "GetMetricDataWithContext", 1)
+}
+
+func TestMetricsPollWindow(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+	cfg.Metrics.Autodiscover = nil
+	cfg.Metrics.Delay = 10 * time.Minute
+	cfg.Metrics.Named = map[string][]NamedMetricConfig{
+		"AWS/EC2": {{MetricName: "CPUUtilization"}},
+	}
+
+	var inputs []*cloudwatch.GetMetricDataInput
+	mc := &mockMetricsClient{}
+	mc.On("GetMetricDataWithContext", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		inputs = append(inputs, args.Get(1).(*cloudwatch.GetMetricDataInput))
+	}).Return(&cloudwatch.GetMetricDataOutput{}, errors.New("throttled")).Once()
+	mc.On("GetMetricDataWithContext", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		inputs = append(inputs, args.Get(1).(*cloudwatch.GetMetricDataInput))
+	}).Return(&cloudwatch.GetMetricDataOutput{}, nil).Once()
+
+	sink := &consumertest.MetricsSink{}
+	metricsRcvr := newMetricsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	metricsRcvr.client = mc
+
+	// the window ends before the delay, so that late datapoints are retrieved
+	before := time.Now()
+	require.Error(t, metricsRcvr.poll(context.Background()))
+	require.Len(t, inputs, 1)
+	require.False(t, inputs[0].EndTime.After(before.Add(-cfg.Metrics.Delay)))
+	failedStartTime := *inputs[0].StartTime
+
+	// the window of a failed poll is retrieved again by the next poll
+	require.NoError(t, metricsRcvr.poll(context.Background()))
+	require.Len(t, inputs, 2)
+	require.Equal(t, failedStartTime, *inputs[1].StartTime)
+	mc.AssertExpectations(t)
+}
+
+func TestMetricsBatching(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+	cfg.Metrics.Autodiscover = nil
+	named := []NamedMetricConfig{}
+	for i := 0; i < maxQueriesPerRequest+1; i++ {
+		named = append(named, NamedMetricConfig{MetricName: fmt.Sprintf("metric-%d", i)})
+	}
+	cfg.Metrics.Named = map[string][]NamedMetricConfig{"Custom": named}
+
+	mc := &mockMetricsClient{}
+	mc.On("GetMetricDataWithContext", mock.Anything, mock.MatchedBy(func(input *cloudwatch.GetMetricDataInput) bool {
+		return len(input.MetricDataQueries) == maxQueriesPerRequest
+	}), mock.Anything).Return(&cloudwatch.GetMetricDataOutput{}, nil).Once()
+	mc.On("GetMetricDataWithContext", mock.Anything, mock.MatchedBy(func(input *cloudwatch.GetMetricDataInput) bool {
+		return len(input.MetricDataQueries) == 1
+	}), mock.Anything).Return(&cloudwatch.GetMetricDataOutput{}, nil).Once()
+
+	sink := &consumertest.MetricsSink{}
+	metricsRcvr := newMetricsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	metricsRcvr.client = mc
+
+	require.NoError(t, metricsRcvr.poll(context.Background()))
+	mc.AssertExpectations(t)
+	require.Empty(t, sink.AllMetrics())
+}
+
+func TestMetricsDiscovery(t *testing.T) {
+	mc := &mockMetricsClient{}
+	metrics := []*cloudwatch.Metric{}
+	for i := 0; i < 10; i++ {
+		metrics = append(metrics, &cloudwatch.Metric{
+			Namespace:  aws.String("AWS/EC2"),
+			MetricName: aws.String(fmt.Sprintf("metric-%d", i)),
+			Dimensions: []*cloudwatch.Dimension{{Name: aws.String("InstanceId"), Value: aws.String(testInstanceID)}},
+		})
+	}
+	mc.On("ListMetricsWithContext", mock.Anything, mock.MatchedBy(func(input *cloudwatch.ListMetricsInput) bool {
+		return *input.Namespace == "AWS/EC2" && *input.Dimensions[0].Name == "InstanceId" && input.Dimensions[0].Value == nil
+	}), mock.Anything).Return(&cloudwatch.ListMetricsOutput{
+		Metrics:   metrics[:5],
+		NextToken: aws.String("next"),
+	}, nil).Once()
+	mc.On("ListMetricsWithContext", mock.Anything, mock.Anything, mock.Anything).Return(&cloudwatch.ListMetricsOutput{
+		Metrics: metrics[5:],
+	}, nil).Once()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+	cfg.Metrics.Autodiscover = &MetricsAutodiscoverConfig{
+		Namespaces: []string{"AWS/EC2"},
+		Limit:      7,
+		Stats:      []string{"Sum", "SampleCount"},
+		Dimensions: []MetricDimensionConfig{{Name: "InstanceId"}},
+	}
+
+	metricsRcvr := newMetricsReceiver(cfg, receivertest.NewNopCreateSettings(), consumertest.NewNop())
+	metricsRcvr.client = mc
+
+	requests, err := metricsRcvr.discoverMetrics(context.Background(), cfg.Metrics.Autodiscover)
+	require.NoError(t, err)
+	require.Len(t, requests, 14)
+	require.Equal(t, "metric-6", requests[13].metricName)
+	require.Equal(t, "SampleCount", requests[13].stat)
+	mc.AssertExpectations(t)
+}
+
+// TestMetricsWithMockEndpoint uses the CloudWatch client against a mock endpoint
+// serving the responses of the CloudWatch API.
+func TestMetricsWithMockEndpoint(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		action := r.Form.Get("Action")
+		actions = append(actions, action)
+		var file string
+		switch action {
+		case "ListMetrics":
+			file = "list-metrics.xml"
+		case "GetMetricData":
+			require.Equal(t, "60", r.Form.Get("MetricDataQueries.member.1.MetricStat.Period"))
+			require.Equal(t, "CPUUtilization", r.Form.Get("MetricDataQueries.member.1.MetricStat.Metric.MetricName"))
+			require.Equal(t, "NetworkIn", r.Form.Get("MetricDataQueries.member.2.MetricStat.Metric.MetricName"))
+			file = "get-metric-data.xml"
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		b, err := os.ReadFile(filepath.Join("testdata", "metrics", file))
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "text/xml")
+		_, err = w.Write(b)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Region = "us-west-1"
+	cfg.Metrics.Autodiscover.Namespaces = []string{"AWS/EC2"}
+
+	sink := &consumertest.MetricsSink{}
+	metricsRcvr := newMetricsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	s, err := session.NewSession(aws.NewConfig().
+		WithRegion(cfg.Region).
+		WithEndpoint(server.URL).
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")))
+	require.NoError(t, err)
+	metricsRcvr.client = cloudwatch.New(s)
+
+	requests, err := metricsRcvr.discoverMetrics(context.Background(), metricsRcvr.autodiscover)
+	require.NoError(t, err)
+	metricsRcvr.requests = requests
+	require.NoError(t, metricsRcvr.poll(context.Background()))
+	require.Equal(t, []string{"ListMetrics", "GetMetricData"}, actions)
+
+	require.Len(t, sink.AllMetrics(), 1)
+	metrics := sink.AllMetrics()[0]
+	require.Equal(t, 2, metrics.MetricCount())
+	require.Equal(t, 3, metrics.DataPointCount())
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, "CPUUtilization", ms.At(0).Name())
+	require.Equal(t, 15.25, ms.At(0).Gauge().DataPoints().At(1).DoubleValue())
+	require.Equal(t, "NetworkIn", ms.At(1).Name())
+	require.Equal(t, float64(2048), ms.At(1).Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestAlignToPeriod(t *testing.T) {
+	ts := time.Date(2023, 2, 21, 10, 7, 42, 500, time.UTC)
+	require.Equal(t, time.Date(2023, 2, 21, 10, 7, 40, 0, time.UTC), alignToPeriod(ts, 10*time.Second).UTC())
+	require.Equal(t, time.Date(2023, 2, 21, 10, 7, 0, 0, time.UTC), alignToPeriod(ts, time.Minute).UTC())
+	require.Equal(t, time.Date(2023, 2, 21, 10, 5, 0, 0, time.UTC), alignToPeriod(ts, 5*time.Minute).UTC())
+	require.Equal(t, time.Date(2023, 2, 21, 10, 0, 0, 0, time.UTC), alignToPeriod(ts, time.Hour).UTC())
+}
+
+var testInstanceID = "i-0123456789abcdef0"
+
+type mockMetricsClient struct {
+	mock.Mock
+}
+
+func (mc *mockMetricsClient) ListMetricsWithContext(ctx context.Context, input *cloudwatch.ListMetricsInput, opts ...request.Option) (*cloudwatch.ListMetricsOutput, error) {
+	args := mc.Called(ctx, input, opts)
+	return args.Get(0).(*cloudwatch.ListMetricsOutput), args.Error(1)
+}
+
+func (mc *mockMetricsClient) GetMetricDataWithContext(ctx context.Context, input *cloudwatch.GetMetricDataInput, opts ...request.Option) (*cloudwatch.GetMetricDataOutput, error) {
+	args := mc.Called(ctx, input, opts)
+	return args.Get(0).(*cloudwatch.GetMetricDataOutput), args.Error(1)
+}
@@ -25,12 +25,15 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlogs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwmetricstream"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/otlpmetricstream"
 )
 
 const (
 	typeStr           = "awsfirehose"
 	stability         = component.StabilityLevelAlpha
+	logsStability     = component.StabilityLevelDevelopment
 	defaultRecordType = cwmetricstream.TypeStr
 	defaultEndpoint   = "0.0.0.0:4433"
 )
@@ -38,17 +41,20 @@ const (
 var (
 	errUnrecognizedRecordType = errors.New("unrecognized record type")
 	availableRecordTypes      = map[string]bool{
-		cwmetricstream.TypeStr: true,
+		cwmetricstream.TypeStr:   true,
+		otlpmetricstream.TypeStr: true,
+		cwlogs.TypeStr:           true,
 	}
 )
 
-// NewFactory creates a receiver factory for awsfirehose. Currently, only
-// available in metrics pipelines.
+// NewFactory creates a receiver factory for awsfirehose. Available in
+// metrics and logs pipelines, depending on the configured record type.
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, stability))
+		receiver.WithMetrics(createMetricsReceiver, stability),
+		receiver.WithLogs(createLogsReceiver, logsStability))
 }
 
 // validateRecordType checks the available record types for the
@@ -64,8 +70,19 @@ func validateRecordType(recordType string) error {
 // unmarshalers.
 func defaultMetricsUnmarshalers(logger *zap.Logger) map[string]unmarshaler.MetricsUnmarshaler {
 	cwmsu := cwmetricstream.NewUnmarshaler(logger)
+	otlpv1msu := otlpmetricstream.NewUnmarshaler(logger)
 	return map[string]unmarshaler.MetricsUnmarshaler{
-		cwmsu.Type(): cwmsu,
+		cwmsu.Type():     cwmsu,
+		otlpv1msu.Type(): otlpv1msu,
+	}
+}
+
+// defaultLogsUnmarshalers creates a map of the available logs
+// unmarshalers.
+func defaultLogsUnmarshalers(logger *zap.Logger) map[string]unmarshaler.LogsUnmarshaler {
+	cwlu := cwlogs.NewUnmarshaler(logger)
+	return map[string]unmarshaler.LogsUnmarshaler{
+		cwlu.Type(): cwlu,
 	}
 }
 
@@ -89,3 +106,13 @@ func createMetricsReceiver(
 ) (receiver.Metrics, error) {
 	return newMetricsReceiver(cfg.(*Config), set, defaultMetricsUnmarshalers(set.Logger), nextConsumer)
 }
+
+// createLogsReceiver implements the CreateLogsReceiver function type.
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	return newLogsReceiver(cfg.(*Config), set, defaultLogsUnmarshalers(set.Logger), nextConsumer)
+}
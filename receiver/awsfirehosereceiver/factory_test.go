@@ -22,6 +22,9 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlogs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/otlpmetricstream"
 )
 
 func TestValidConfig(t *testing.T) {
@@ -40,7 +43,22 @@ func TestCreateMetricsReceiver(t *testing.T) {
 	require.NotNil(t, r)
 }
 
+func TestCreateLogsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RecordType = cwlogs.TypeStr
+	r, err := createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		cfg,
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+}
+
 func TestValidateRecordType(t *testing.T) {
 	require.NoError(t, validateRecordType(defaultRecordType))
+	require.NoError(t, validateRecordType(otlpmetricstream.TypeStr))
+	require.NoError(t, validateRecordType(cwlogs.TypeStr))
 	require.Error(t, validateRecordType("nop"))
 }
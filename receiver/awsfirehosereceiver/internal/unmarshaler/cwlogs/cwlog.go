@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlogs // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlogs"
+
+// cWLog is the format of a CloudWatch Logs subscription filter payload.
+//
+// More details can be found at:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/SubscriptionFilters.html
+type cWLog struct {
+	// MessageType is either DATA_MESSAGE or CONTROL_MESSAGE. The latter
+	// is only sent to check that the destination is reachable.
+	MessageType string `json:"messageType"`
+	// Owner is the AWS account ID of the originating log data.
+	Owner string `json:"owner"`
+	// LogGroup is the log group name of the originating log data.
+	LogGroup string `json:"logGroup"`
+	// LogStream is the log stream name of the originating log data.
+	LogStream string `json:"logStream"`
+	// SubscriptionFilters is the list of subscription filter names
+	// that matched with the originating log data.
+	SubscriptionFilters []string `json:"subscriptionFilters"`
+	// LogEvents is the array of log events.
+	LogEvents []cWLogEvent `json:"logEvents"`
+}
+
+// cWLogEvent is a single log event within a cWLog.
+type cWLogEvent struct {
+	// ID is the unique identifier for the log event.
+	ID string `json:"id"`
+	// Timestamp is the milliseconds since epoch for when the
+	// event was ingested.
+	Timestamp int64 `json:"timestamp"`
+	// Message is the raw log message.
+	Message string `json:"message"`
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlogs // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlogs"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// resourceAttributes are the CloudWatch Logs attributes that define a
+// unique resource.
+type resourceAttributes struct {
+	// owner is the AWS account ID.
+	owner string
+	// logGroup is the CloudWatch log group name.
+	logGroup string
+	// logStream is the CloudWatch log stream name.
+	logStream string
+}
+
+// The resourceLogsBuilder is used to aggregate log records for the
+// same resourceAttributes.
+type resourceLogsBuilder struct {
+	rls plog.LogRecordSlice
+}
+
+// newResourceLogsBuilder creates a resourceLogsBuilder with the
+// resourceAttributes.
+func newResourceLogsBuilder(ld plog.Logs, attrs resourceAttributes) *resourceLogsBuilder {
+	rls := ld.ResourceLogs().AppendEmpty()
+	attrs.setAttributes(rls.Resource())
+	return &resourceLogsBuilder{rls: rls.ScopeLogs().AppendEmpty().LogRecords()}
+}
+
+// AddLog adds a log record for each of the events in the cWLog.
+func (rlb *resourceLogsBuilder) AddLog(log cWLog) {
+	for _, event := range log.LogEvents {
+		lr := rlb.rls.AppendEmpty()
+		lr.SetTimestamp(pcommon.Timestamp(event.Timestamp * 1e6))
+		lr.Body().SetStr(event.Message)
+	}
+}
+
+// setAttributes creates a pcommon.Resource from the fields in the resourceAttributes.
+func (ra *resourceAttributes) setAttributes(resource pcommon.Resource) {
+	attributes := resource.Attributes()
+	attributes.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAWS)
+	attributes.PutStr(conventions.AttributeCloudAccountID, ra.owner)
+	attributes.PutEmptySlice(conventions.AttributeAWSLogGroupNames).AppendEmpty().SetStr(ra.logGroup)
+	attributes.PutEmptySlice(conventions.AttributeAWSLogStreamNames).AppendEmpty().SetStr(ra.logStream)
+}
@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlogs // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlogs"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
+)
+
+const (
+	TypeStr = "cwlogs"
+
+	messageTypeData    = "DATA_MESSAGE"
+	messageTypeControl = "CONTROL_MESSAGE"
+)
+
+var (
+	errInvalidRecords = errors.New("record format invalid")
+)
+
+// Unmarshaler for the CloudWatch Logs subscription filter record format.
+//
+// More details can be found at:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/SubscriptionFilters.html#FirehoseExample
+type Unmarshaler struct {
+	logger *zap.Logger
+}
+
+var _ unmarshaler.LogsUnmarshaler = (*Unmarshaler)(nil)
+
+// NewUnmarshaler creates a new instance of the Unmarshaler.
+func NewUnmarshaler(logger *zap.Logger) *Unmarshaler {
+	return &Unmarshaler{logger}
+}
+
+// Unmarshal decompresses the records, deserializes them into cWLogs and
+// uses the resourceLogsBuilder to group them into a single plog.Logs.
+// Skips invalid records and control messages.
+func (u Unmarshaler) Unmarshal(records [][]byte) (plog.Logs, error) {
+	ld := plog.NewLogs()
+	builders := make(map[resourceAttributes]*resourceLogsBuilder)
+	validCount := 0
+	for recordIndex, record := range records {
+		logs, err := u.decode(record)
+		if err != nil {
+			u.logger.Error(
+				"Unable to unmarshal input",
+				zap.Error(err),
+				zap.Int("record_index", recordIndex),
+			)
+			continue
+		}
+		for logIndex, log := range logs {
+			switch log.MessageType {
+			case messageTypeControl:
+				validCount++
+				continue
+			case messageTypeData:
+			default:
+				u.logger.Error(
+					"Invalid log",
+					zap.String("message_type", log.MessageType),
+					zap.Int("log_index", logIndex),
+					zap.Int("record_index", recordIndex),
+				)
+				continue
+			}
+			if !u.isValid(log) {
+				u.logger.Error(
+					"Invalid log",
+					zap.Int("log_index", logIndex),
+					zap.Int("record_index", recordIndex),
+				)
+				continue
+			}
+			validCount++
+			attrs := resourceAttributes{
+				owner:     log.Owner,
+				logGroup:  log.LogGroup,
+				logStream: log.LogStream,
+			}
+			lb, ok := builders[attrs]
+			if !ok {
+				lb = newResourceLogsBuilder(ld, attrs)
+				builders[attrs] = lb
+			}
+			lb.AddLog(log)
+		}
+	}
+
+	if validCount == 0 {
+		return plog.NewLogs(), errInvalidRecords
+	}
+
+	return ld, nil
+}
+
+// decode decompresses the gzip record and deserializes each of the
+// cWLogs that it contains.
+func (u Unmarshaler) decode(record []byte) ([]cWLog, error) {
+	r, err := gzip.NewReader(bytes.NewReader(record))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var logs []cWLog
+	decoder := json.NewDecoder(r)
+	for {
+		var log cWLog
+		if err = decoder.Decode(&log); err != nil {
+			if errors.Is(err, io.EOF) {
+				return logs, nil
+			}
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+}
+
+// isValid validates that the cWLog has been unmarshalled correctly.
+func (u Unmarshaler) isValid(log cWLog) bool {
+	return log.Owner != "" && log.LogGroup != "" && log.LogStream != ""
+}
+
+// Type of the serialized messages.
+func (u Unmarshaler) Type() string {
+	return TypeStr
+}
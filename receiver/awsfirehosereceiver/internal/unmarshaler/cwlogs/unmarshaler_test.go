@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwlogs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func TestType(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	require.Equal(t, TypeStr, unmarshaler.Type())
+}
+
+func TestUnmarshal(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	testCases := map[string]struct {
+		filename          string
+		wantResourceCount int
+		wantLogCount      int
+		wantErr           error
+	}{
+		"WithMultipleRecords": {
+			filename:          "multiple_records",
+			wantResourceCount: 2,
+			wantLogCount:      5,
+		},
+		"WithSingleRecord": {
+			filename:          "single_record",
+			wantResourceCount: 1,
+			wantLogCount:      1,
+		},
+		"WithControlMessage": {
+			filename: "control_message",
+		},
+		"WithInvalidRecords": {
+			filename: "invalid_records",
+			wantErr:  errInvalidRecords,
+		},
+		"WithSomeInvalidRecords": {
+			filename:          "some_invalid_records",
+			wantResourceCount: 1,
+			wantLogCount:      1,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			record, err := os.ReadFile(filepath.Join(".", "testdata", testCase.filename))
+			require.NoError(t, err)
+
+			records := [][]byte{compress(t, record)}
+
+			got, err := unmarshaler.Unmarshal(records)
+			if testCase.wantErr != nil {
+				require.Error(t, err)
+				require.Equal(t, testCase.wantErr, err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, got)
+				require.Equal(t, testCase.wantResourceCount, got.ResourceLogs().Len())
+				require.Equal(t, testCase.wantLogCount, got.LogRecordCount())
+			}
+		})
+	}
+}
+
+func TestUnmarshalAttributes(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	record, err := os.ReadFile(filepath.Join(".", "testdata", "single_record"))
+	require.NoError(t, err)
+
+	got, err := unmarshaler.Unmarshal([][]byte{compress(t, record)})
+	require.NoError(t, err)
+	require.Equal(t, 1, got.ResourceLogs().Len())
+
+	rl := got.ResourceLogs().At(0)
+	attrs := rl.Resource().Attributes().AsRaw()
+	require.Equal(t, "aws", attrs["cloud.provider"])
+	require.Equal(t, "123456789012", attrs["cloud.account.id"])
+	require.Equal(t, []interface{}{"/aws/lambda/test"}, attrs["aws.log.group.names"])
+	require.Equal(t, []interface{}{"2023/02/21/[$LATEST]a1b2c3d4"}, attrs["aws.log.stream.names"])
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	require.Equal(t, "START RequestId: 7f1f2b6e Version: $LATEST", lr.Body().Str())
+	require.Equal(t, int64(1676997600000), lr.Timestamp().AsTime().UnixMilli())
+}
+
+func TestUnmarshalUncompressed(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	record, err := os.ReadFile(filepath.Join(".", "testdata", "single_record"))
+	require.NoError(t, err)
+
+	got, err := unmarshaler.Unmarshal([][]byte{record})
+	require.Equal(t, errInvalidRecords, err)
+	require.Equal(t, plog.NewLogs(), got)
+}
+
+func compress(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
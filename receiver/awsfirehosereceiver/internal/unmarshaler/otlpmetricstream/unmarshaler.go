@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpmetricstream // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/otlpmetricstream"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
+)
+
+const (
+	TypeStr = "otlp_v1"
+)
+
+var (
+	errInvalidRecords = errors.New("record format invalid")
+	errInvalidLength  = errors.New("invalid message length")
+)
+
+// Unmarshaler for the CloudWatch Metric Stream OpenTelemetry record format.
+// Each record contains one or more ExportMetricsServiceRequest messages,
+// each of which is prefixed with its length encoded as a varint.
+//
+// More details can be found at:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch-metric-streams-formats-opentelemetry-100.html
+type Unmarshaler struct {
+	logger *zap.Logger
+}
+
+var _ unmarshaler.MetricsUnmarshaler = (*Unmarshaler)(nil)
+
+// NewUnmarshaler creates a new instance of the Unmarshaler.
+func NewUnmarshaler(logger *zap.Logger) *Unmarshaler {
+	return &Unmarshaler{logger}
+}
+
+// Unmarshal deserializes the length-delimited ExportMetricsServiceRequests
+// in each of the records and merges them into a single pmetric.Metrics.
+// Skips the remainder of a record once an invalid message is found.
+func (u Unmarshaler) Unmarshal(records [][]byte) (pmetric.Metrics, error) {
+	md := pmetric.NewMetrics()
+	for recordIndex, record := range records {
+		dataLen, pos := len(record), 0
+		for pos < dataLen {
+			req, n, err := u.decode(record[pos:])
+			if err != nil {
+				u.logger.Error(
+					"Unable to unmarshal input",
+					zap.Error(err),
+					zap.Int("record_index", recordIndex),
+				)
+				break
+			}
+			pos += n
+			req.Metrics().ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+		}
+	}
+
+	if md.ResourceMetrics().Len() == 0 {
+		return pmetric.NewMetrics(), errInvalidRecords
+	}
+
+	return md, nil
+}
+
+// decode reads a single length-delimited ExportMetricsServiceRequest from
+// the start of the data and returns it along with the number of bytes read.
+func (u Unmarshaler) decode(data []byte) (pmetricotlp.ExportRequest, int, error) {
+	req := pmetricotlp.NewExportRequest()
+	length, n := binary.Uvarint(data)
+	if n <= 0 {
+		return req, 0, errInvalidLength
+	}
+	// Compare against the remaining bytes rather than computing the end
+	// offset first, as a crafted length near 2^64 would overflow it.
+	if length > uint64(len(data)-n) {
+		return req, 0, fmt.Errorf("%w: %d exceeds remaining %d bytes", errInvalidLength, length, len(data)-n)
+	}
+	end := n + int(length)
+	if err := req.UnmarshalProto(data[n:end]); err != nil {
+		return req, 0, err
+	}
+	return req, end, nil
+}
+
+// Type of the serialized messages.
+func (u Unmarshaler) Type() string {
+	return TypeStr
+}
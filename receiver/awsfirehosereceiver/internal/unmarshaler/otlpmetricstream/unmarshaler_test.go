@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpmetricstream
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.uber.org/zap"
+)
+
+func TestType(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	require.Equal(t, TypeStr, unmarshaler.Type())
+}
+
+func TestUnmarshal(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	testCases := map[string]struct {
+		records           [][]byte
+		wantResourceCount int
+		wantMetricCount   int
+		wantErr           error
+	}{
+		"WithSingleRecord": {
+			records: [][]byte{
+				appendRequest(t, nil, createMetrics("AWS/EC2", 2)),
+			},
+			wantResourceCount: 1,
+			wantMetricCount:   2,
+		},
+		"WithMultipleRequestsPerRecord": {
+			records: [][]byte{
+				appendRequest(t, appendRequest(t, nil, createMetrics("AWS/EC2", 2)), createMetrics("AWS/ELB", 3)),
+			},
+			wantResourceCount: 2,
+			wantMetricCount:   5,
+		},
+		"WithMultipleRecords": {
+			records: [][]byte{
+				appendRequest(t, nil, createMetrics("AWS/EC2", 1)),
+				appendRequest(t, nil, createMetrics("AWS/ELB", 1)),
+			},
+			wantResourceCount: 2,
+			wantMetricCount:   2,
+		},
+		"WithInvalidRecords": {
+			records: [][]byte{{0xff}, []byte("invalid")},
+			wantErr: errInvalidRecords,
+		},
+		"WithSomeInvalidRecords": {
+			records: [][]byte{
+				appendRequest(t, nil, createMetrics("AWS/EC2", 2)),
+				{0x05, 0x01},
+			},
+			wantResourceCount: 1,
+			wantMetricCount:   2,
+		},
+		"WithTruncatedRecord": {
+			records: [][]byte{
+				append(appendRequest(t, nil, createMetrics("AWS/EC2", 2)), 0x7f),
+			},
+			wantResourceCount: 1,
+			wantMetricCount:   2,
+		},
+		"WithOverflowingLength": {
+			records: [][]byte{
+				append(binary.AppendUvarint(nil, math.MaxUint64-1), []byte("invalid")...),
+			},
+			wantErr: errInvalidRecords,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := unmarshaler.Unmarshal(testCase.records)
+			if testCase.wantErr != nil {
+				require.Error(t, err)
+				require.Equal(t, testCase.wantErr, err)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, got)
+				require.Equal(t, testCase.wantResourceCount, got.ResourceMetrics().Len())
+				require.Equal(t, testCase.wantMetricCount, got.MetricCount())
+			}
+		})
+	}
+}
+
+func TestDecodeOverflowingLength(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	for _, length := range []uint64{math.MaxUint64, math.MaxUint64 - 1, math.MaxUint64 - 9} {
+		data := append(binary.AppendUvarint(nil, length), []byte("invalid")...)
+		_, _, err := unmarshaler.decode(data)
+		require.ErrorIs(t, err, errInvalidLength)
+	}
+}
+
+func createMetrics(namespace string, count int) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("cloud.provider", "aws")
+	rm.Resource().Attributes().PutStr("aws.exporter.arn", "arn:aws:cloudwatch:us-east-1:123456789012:metric-stream/test")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	for i := 0; i < count; i++ {
+		m := sm.Metrics().AppendEmpty()
+		m.SetName(namespace + ".metric")
+		m.SetUnit("1")
+		dp := m.SetEmptySummary().DataPoints().AppendEmpty()
+		dp.SetCount(1)
+		dp.SetSum(float64(i))
+	}
+	return md
+}
+
+func appendRequest(t *testing.T, buf []byte, md pmetric.Metrics) []byte {
+	data, err := pmetricotlp.NewExportRequestFromMetrics(md).MarshalProto()
+	require.NoError(t, err)
+	buf = binary.AppendUvarint(buf, uint64(len(data)))
+	return append(buf, data...)
+}
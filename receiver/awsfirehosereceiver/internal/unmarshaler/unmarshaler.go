@@ -15,6 +15,7 @@
 package unmarshaler // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
 
 import (
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -26,3 +27,12 @@ type MetricsUnmarshaler interface {
 	// Type of the serialized messages.
 	Type() string
 }
+
+// LogsUnmarshaler deserializes the message body
+type LogsUnmarshaler interface {
+	// Unmarshal deserializes the records into logs.
+	Unmarshal(records [][]byte) (plog.Logs, error)
+
+	// Type of the serialized messages.
+	Type() string
+}
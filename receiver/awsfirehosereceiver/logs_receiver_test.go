@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsfirehosereceiver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwlogs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/unmarshalertest"
+)
+
+type logsRecordConsumer struct {
+	result plog.Logs
+}
+
+var _ consumer.Logs = (*logsRecordConsumer)(nil)
+
+func (rc *logsRecordConsumer) ConsumeLogs(_ context.Context, logs plog.Logs) error {
+	rc.result = logs
+	return nil
+}
+
+func (rc *logsRecordConsumer) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func TestNewLogsReceiver(t *testing.T) {
+	testCases := map[string]struct {
+		consumer   consumer.Logs
+		recordType string
+		wantErr    error
+	}{
+		"WithNilConsumer": {
+			wantErr: component.ErrNilNextConsumer,
+		},
+		"WithInvalidRecordType": {
+			consumer:   consumertest.NewNop(),
+			recordType: "test",
+			wantErr:    errUnrecognizedRecordType,
+		},
+		"WithMetricsRecordType": {
+			consumer:   consumertest.NewNop(),
+			recordType: defaultRecordType,
+			wantErr:    errUnrecognizedRecordType,
+		},
+		"WithLogsRecordType": {
+			consumer:   consumertest.NewNop(),
+			recordType: cwlogs.TypeStr,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.RecordType = testCase.recordType
+			got, err := newLogsReceiver(
+				cfg,
+				receivertest.NewNopCreateSettings(),
+				defaultLogsUnmarshalers(zap.NewNop()),
+				testCase.consumer,
+			)
+			require.Equal(t, testCase.wantErr, err)
+			if testCase.wantErr == nil {
+				require.NotNil(t, got)
+			} else {
+				require.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestLogsConsumer(t *testing.T) {
+	testErr := errors.New("test error")
+	testCases := map[string]struct {
+		unmarshalerErr error
+		consumerErr    error
+		wantStatus     int
+		wantErr        error
+	}{
+		"WithUnmarshalerError": {
+			unmarshalerErr: testErr,
+			wantStatus:     http.StatusBadRequest,
+			wantErr:        testErr,
+		},
+		"WithConsumerError": {
+			consumerErr: testErr,
+			wantStatus:  http.StatusInternalServerError,
+			wantErr:     testErr,
+		},
+		"WithNoError": {
+			wantStatus: http.StatusOK,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lc := &logsConsumer{
+				unmarshaler: unmarshalertest.NewErrLogs(testCase.unmarshalerErr),
+				consumer:    consumertest.NewErr(testCase.consumerErr),
+			}
+			gotStatus, gotErr := lc.Consume(context.TODO(), nil, nil)
+			require.Equal(t, testCase.wantStatus, gotStatus)
+			require.Equal(t, testCase.wantErr, gotErr)
+		})
+	}
+
+	t.Run("WithCommonAttributes", func(t *testing.T) {
+		base := plog.NewLogs()
+		base.ResourceLogs().AppendEmpty()
+		rc := logsRecordConsumer{}
+		lc := &logsConsumer{
+			unmarshaler: unmarshalertest.NewWithLogs(base),
+			consumer:    &rc,
+		}
+		gotStatus, gotErr := lc.Consume(context.TODO(), nil, map[string]string{
+			"CommonAttributes": "Test",
+		})
+		require.Equal(t, http.StatusOK, gotStatus)
+		require.NoError(t, gotErr)
+		gotRls := rc.result.ResourceLogs()
+		require.Equal(t, 1, gotRls.Len())
+		gotRl := gotRls.At(0)
+		require.Equal(t, 1, gotRl.Resource().Attributes().Len())
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	// startAtBeginning reads the whole retained log of a container the first time it is seen.
+	startAtBeginning = "beginning"
+	// startAtEnd only reads log lines written after the container is first seen.
+	startAtEnd = "end"
+)
+
+var _ component.Config = (*Config)(nil)
+
+type Config struct {
+	// The URL of the docker server.  Default is "unix:///var/run/docker.sock"
+	Endpoint string `mapstructure:"endpoint"`
+
+	// The maximum amount of time to wait for docker API responses.  Default is 5s
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// A list of filters whose matching images are to be excluded.  Supports literals, globs, and regex.
+	ExcludedImages []string `mapstructure:"excluded_images"`
+
+	// Docker client API version. Default is 1.22
+	DockerAPIVersion float64 `mapstructure:"api_version"`
+
+	// A mapping of container label names to resource attribute keys.
+	// The corresponding container label value will become the resource attribute value
+	// for the mapped key.  E.g. `io.kubernetes.container.name: container_spec_name`
+	// would result in a resource attribute called `container_spec_name` whose value is
+	// the value of the `io.kubernetes.container.name` container label.
+	ContainerLabelsToResourceAttributes map[string]string `mapstructure:"container_labels_to_resource_attributes"`
+
+	// Where to start reading the logs of a container without a stored checkpoint.
+	// One of "beginning" or "end".  Default is "end"
+	StartAt string `mapstructure:"start_at"`
+
+	// The ID of a storage extension used to persist the timestamp of the last
+	// log line read for each container.
+	StorageID *component.ID `mapstructure:"storage"`
+}
+
+func (config Config) Validate() error {
+	if config.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	if config.DockerAPIVersion < minimalRequiredDockerAPIVersion {
+		return fmt.Errorf("api_version must be at least %v", minimalRequiredDockerAPIVersion)
+	}
+	if config.StartAt != startAtBeginning && config.StartAt != startAtEnd {
+		return fmt.Errorf("start_at must be one of %q or %q", startAtBeginning, startAtEnd)
+	}
+	return nil
+}
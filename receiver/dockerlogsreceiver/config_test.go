@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	storageID := component.NewID("file_storage")
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewIDWithName(typeStr, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(typeStr, "allsettings"),
+			expected: &Config{
+				Endpoint:         "http://example.com/",
+				Timeout:          20 * time.Second,
+				DockerAPIVersion: 1.24,
+				StartAt:          startAtBeginning,
+				StorageID:        &storageID,
+
+				ExcludedImages: []string{
+					"undesired-container",
+					"another-*-container",
+				},
+
+				ContainerLabelsToResourceAttributes: map[string]string{
+					"my.container.label":       "my-resource-attribute",
+					"my.other.container.label": "my-other-resource-attribute",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	cfg := &Config{}
+	assert.Equal(t, "endpoint must be specified", component.ValidateConfig(cfg).Error())
+
+	cfg = &Config{Endpoint: "someEndpoint", DockerAPIVersion: 1.21}
+	assert.Equal(t, "api_version must be at least 1.22", component.ValidateConfig(cfg).Error())
+
+	cfg = &Config{Endpoint: "someEndpoint", DockerAPIVersion: 1.22, StartAt: "middle"}
+	assert.Equal(t, `start_at must be one of "beginning" or "end"`, component.ValidateConfig(cfg).Error())
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	rcvr "go.opentelemetry.io/collector/receiver"
+)
+
+const (
+	typeStr   = "docker_logs"
+	stability = component.StabilityLevelDevelopment
+)
+
+func NewFactory() rcvr.Factory {
+	return rcvr.NewFactory(
+		typeStr,
+		createDefaultConfig,
+		rcvr.WithLogs(createLogsReceiver, stability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Endpoint:         "unix:///var/run/docker.sock",
+		Timeout:          5 * time.Second,
+		DockerAPIVersion: defaultDockerAPIVersion,
+		StartAt:          startAtEnd,
+	}
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params rcvr.CreateSettings,
+	config component.Config,
+	consumer consumer.Logs,
+) (rcvr.Logs, error) {
+	return newReceiver(params, config.(*Config), consumer), nil
+}
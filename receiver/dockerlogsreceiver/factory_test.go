@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	assert.Equal(t, component.Type(typeStr), factory.Type())
+
+	cfg := factory.CreateDefaultConfig()
+	assert.NotNil(t, cfg, "failed to create default config")
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+}
+
+func TestCreateReceiver(t *testing.T) {
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig()
+
+	params := receivertest.NewNopCreateSettings()
+	logsReceiver, err := factory.CreateLogsReceiver(context.Background(), params, config, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.NotNil(t, logsReceiver)
+
+	metricsReceiver, err := factory.CreateMetricsReceiver(context.Background(), params, config, consumertest.NewNop())
+	assert.ErrorIs(t, err, component.ErrDataTypeIsNotSupported)
+	assert.Nil(t, metricsReceiver)
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	streamStdout = "stdout"
+	streamStderr = "stderr"
+
+	// frameHeaderLen is the size of the header preceding every frame of a
+	// multiplexed log stream: one byte for the stream type, three bytes of
+	// padding and the big-endian uint32 size of the payload.
+	frameHeaderLen = 8
+)
+
+// logEntry is a single log line read from a container.
+type logEntry struct {
+	stream    string
+	timestamp time.Time
+	line      string
+}
+
+// pendingEntry holds a line that was split across several frames by the daemon.
+type pendingEntry struct {
+	timestamp time.Time
+	buf       []byte
+}
+
+// logReader reads log entries from a stream returned by the container logs endpoint
+// with timestamps enabled. Containers started without a TTY multiplex stdout and stderr
+// into frames, containers with a TTY produce a raw stream that is reported as stdout.
+type logReader struct {
+	reader  *bufio.Reader
+	tty     bool
+	header  [frameHeaderLen]byte
+	pending map[string]*pendingEntry
+}
+
+func newLogReader(r io.Reader, tty bool) *logReader {
+	return &logReader{
+		reader:  bufio.NewReader(r),
+		tty:     tty,
+		pending: map[string]*pendingEntry{},
+	}
+}
+
+// next returns the entries completed by the next frame, or the next line of a raw stream.
+// The returned slice may be empty when a frame only carries part of a line.
+func (lr *logReader) next() ([]logEntry, error) {
+	if lr.tty {
+		line, err := lr.reader.ReadBytes('\n')
+		if len(line) == 0 {
+			return nil, err
+		}
+		return lr.parse(streamStdout, line), nil
+	}
+
+	if _, err := io.ReadFull(lr.reader, lr.header[:]); err != nil {
+		return nil, err
+	}
+
+	var stream string
+	switch lr.header[0] {
+	case 1:
+		stream = streamStdout
+	case 2:
+		stream = streamStderr
+	default:
+		return nil, fmt.Errorf("unexpected stream type %d in log frame", lr.header[0])
+	}
+
+	payload := make([]byte, binary.BigEndian.Uint32(lr.header[4:]))
+	if _, err := io.ReadFull(lr.reader, payload); err != nil {
+		return nil, err
+	}
+	return lr.parse(stream, payload), nil
+}
+
+// parse splits data into lines. A trailing segment without a newline is kept until the
+// rest of the line arrives on the same stream.
+func (lr *logReader) parse(stream string, data []byte) []logEntry {
+	var entries []logEntry
+	for len(data) > 0 {
+		var segment []byte
+		complete := false
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			segment, data = data[:i], data[i+1:]
+			complete = true
+		} else {
+			segment, data = data, nil
+		}
+
+		timestamp, content := splitTimestamp(segment)
+		p, ok := lr.pending[stream]
+		if !ok {
+			p = &pendingEntry{timestamp: timestamp}
+		}
+		p.buf = append(p.buf, content...)
+		if !complete {
+			lr.pending[stream] = p
+			continue
+		}
+
+		delete(lr.pending, stream)
+		entries = append(entries, logEntry{
+			stream:    stream,
+			timestamp: p.timestamp,
+			line:      string(bytes.TrimSuffix(p.buf, []byte{'\r'})),
+		})
+	}
+	return entries
+}
+
+// splitTimestamp separates the RFC3339Nano timestamp the daemon prepends to every
+// line from the line content. Lines without a valid timestamp are attributed to now.
+func splitTimestamp(segment []byte) (time.Time, []byte) {
+	if i := bytes.IndexByte(segment, ' '); i > 0 {
+		if ts, err := time.Parse(time.RFC3339Nano, string(segment[:i])); err == nil {
+			return ts, segment[i+1:]
+		}
+	}
+	return time.Now(), segment
+}
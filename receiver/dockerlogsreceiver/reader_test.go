@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func frame(stream byte, payload string) []byte {
+	header := make([]byte, frameHeaderLen)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+	return append(header, payload...)
+}
+
+func readAll(t *testing.T, reader *logReader) []logEntry {
+	var entries []logEntry
+	for {
+		next, err := reader.next()
+		if err == io.EOF {
+			return entries
+		}
+		require.NoError(t, err)
+		entries = append(entries, next...)
+	}
+}
+
+func TestLogReaderMultiplexed(t *testing.T) {
+	var stream bytes.Buffer
+	stream.Write(frame(1, "2023-02-01T10:00:00.000000001Z first line\n"))
+	stream.Write(frame(2, "2023-02-01T10:00:00.000000002Z an error\n"))
+	stream.Write(frame(1, "2023-02-01T10:00:00.000000003Z split "))
+	stream.Write(frame(2, "2023-02-01T10:00:00.000000004Z another error\r\n"))
+	stream.Write(frame(1, "2023-02-01T10:00:00.000000005Z line\n"))
+	stream.Write(frame(1, "no timestamp\n"))
+
+	entries := readAll(t, newLogReader(&stream, false))
+	require.Len(t, entries, 5)
+
+	base := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, logEntry{stream: streamStdout, timestamp: base.Add(1), line: "first line"}, entries[0])
+	assert.Equal(t, logEntry{stream: streamStderr, timestamp: base.Add(2), line: "an error"}, entries[1])
+	assert.Equal(t, logEntry{stream: streamStderr, timestamp: base.Add(4), line: "another error"}, entries[2])
+	assert.Equal(t, logEntry{stream: streamStdout, timestamp: base.Add(3), line: "split line"}, entries[3])
+	assert.Equal(t, streamStdout, entries[4].stream)
+	assert.Equal(t, "no timestamp", entries[4].line)
+	assert.False(t, entries[4].timestamp.IsZero())
+}
+
+func TestLogReaderTTY(t *testing.T) {
+	stream := bytes.NewBufferString("2023-02-01T10:00:00Z one\r\n2023-02-01T10:00:01Z two\r\n2023-02-01T10:00:02Z three")
+
+	entries := readAll(t, newLogReader(stream, true))
+	require.Len(t, entries, 2)
+	assert.Equal(t, logEntry{stream: streamStdout, timestamp: time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC), line: "one"}, entries[0])
+	assert.Equal(t, logEntry{stream: streamStdout, timestamp: time.Date(2023, 2, 1, 10, 0, 1, 0, time.UTC), line: "two"}, entries[1])
+}
+
+func TestLogReaderInvalidStream(t *testing.T) {
+	_, err := newLogReader(bytes.NewReader(frame(3, "x\n")), false).next()
+	assert.EqualError(t, err, "unexpected stream type 3 in log frame")
+}
+
+func TestLogReaderTruncatedFrame(t *testing.T) {
+	data := frame(1, "2023-02-01T10:00:00Z truncated\n")
+	_, err := newLogReader(bytes.NewReader(data[:len(data)-4]), false).next()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
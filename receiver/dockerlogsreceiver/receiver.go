@@ -0,0 +1,342 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerlogsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerlogsreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	rcvr "go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/storageclient"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+)
+
+const (
+	defaultDockerAPIVersion         = 1.22
+	minimalRequiredDockerAPIVersion = 1.22
+
+	// attributeContainerHostname is not part of the semantic conventions, it matches
+	// the resource attribute reported by the docker_stats receiver.
+	attributeContainerHostname = "container.hostname"
+
+	// attributeLogIOStream records whether a line was written to stdout or stderr.
+	attributeLogIOStream = "log.iostream"
+
+	// containerSyncInterval is how often the set of tailed containers is reconciled
+	// with the containers tracked by the docker client.
+	containerSyncInterval = time.Second
+)
+
+type receiver struct {
+	config        *Config
+	settings      rcvr.CreateSettings
+	consumer      consumer.Logs
+	client        *docker.Client
+	storageClient storage.Client
+
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	tailersLock sync.Mutex
+	tailers     map[string]*tailer
+
+	// checkpoints caches the last consumed timestamp per container so that restarted
+	// tailers resume where they stopped even without a storage extension.
+	checkpointsLock sync.Mutex
+	checkpoints     map[string]time.Time
+}
+
+// tailer follows the logs of a single container.
+type tailer struct {
+	container docker.Container
+	cancel    context.CancelFunc
+}
+
+func newReceiver(set rcvr.CreateSettings, config *Config, consumer consumer.Logs) *receiver {
+	return &receiver{
+		config:      config,
+		settings:    set,
+		consumer:    consumer,
+		tailers:     map[string]*tailer{},
+		checkpoints: map[string]time.Time{},
+	}
+}
+
+func (r *receiver) Start(ctx context.Context, host component.Host) error {
+	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	r.client, err = docker.NewDockerClient(dConfig, r.settings.Logger)
+	if err != nil {
+		return err
+	}
+
+	r.storageClient, err = storageclient.Get(ctx, host, r.config.StorageID, r.settings.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = r.client.LoadContainerList(ctx); err != nil {
+		return err
+	}
+
+	cctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(2)
+	go func() {
+		defer r.wg.Done()
+		r.client.ContainerEventLoop(cctx)
+	}()
+	go func() {
+		defer r.wg.Done()
+		r.syncLoop(cctx)
+	}()
+	return nil
+}
+
+func (r *receiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.storageClient != nil {
+		return r.storageClient.Close(ctx)
+	}
+	return nil
+}
+
+func (r *receiver) syncLoop(ctx context.Context) {
+	ticker := time.NewTicker(containerSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		r.syncTailers(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncTailers starts following the logs of newly discovered containers and stops
+// following containers that are no longer tracked by the docker client.
+func (r *receiver) syncTailers(ctx context.Context) {
+	containers := r.client.Containers()
+	current := make(map[string]struct{}, len(containers))
+
+	r.tailersLock.Lock()
+	defer r.tailersLock.Unlock()
+
+	for _, container := range containers {
+		current[container.ID] = struct{}{}
+		if _, ok := r.tailers[container.ID]; ok {
+			continue
+		}
+
+		tctx, cancel := context.WithCancel(ctx)
+		t := &tailer{container: container, cancel: cancel}
+		r.tailers[container.ID] = t
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.tail(tctx, t)
+		}()
+	}
+
+	for id, t := range r.tailers {
+		if _, ok := current[id]; !ok {
+			t.cancel()
+			delete(r.tailers, id)
+		}
+	}
+
+	r.pruneCheckpoints(ctx, current)
+}
+
+// pruneCheckpoints forgets the checkpoints of containers that are neither tracked by
+// the docker client nor followed anymore. It must be called with tailersLock held.
+func (r *receiver) pruneCheckpoints(ctx context.Context, current map[string]struct{}) {
+	var pruned []string
+	r.checkpointsLock.Lock()
+	for cid := range r.checkpoints {
+		_, tracked := current[cid]
+		_, followed := r.tailers[cid]
+		if !tracked && !followed {
+			delete(r.checkpoints, cid)
+			pruned = append(pruned, cid)
+		}
+	}
+	r.checkpointsLock.Unlock()
+
+	for _, cid := range pruned {
+		if err := r.storageClient.Delete(ctx, cid); err != nil {
+			r.settings.Logger.Warn("Could not delete container log checkpoint", zap.String("id", cid), zap.Error(err))
+		}
+	}
+}
+
+func (r *receiver) tail(ctx context.Context, t *tailer) {
+	defer func() {
+		t.cancel()
+		r.tailersLock.Lock()
+		if r.tailers[t.container.ID] == t {
+			delete(r.tailers, t.container.ID)
+		}
+		r.tailersLock.Unlock()
+	}()
+
+	cid := t.container.ID
+	checkpoint := r.loadCheckpoint(ctx, cid)
+
+	options := dtypes.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Timestamps: true,
+	}
+	switch {
+	case !checkpoint.IsZero():
+		options.Since = fmt.Sprintf("%d.%09d", checkpoint.Unix(), checkpoint.Nanosecond())
+	case r.config.StartAt == startAtEnd:
+		options.Tail = "0"
+	}
+
+	logs, err := r.client.ContainerLogs(ctx, cid, options)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.settings.Logger.Error("Could not follow container logs", zap.String("id", cid), zap.Error(err))
+		}
+		return
+	}
+	defer logs.Close()
+
+	reader := newLogReader(logs, t.container.Config.Tty)
+	for {
+		entries, err := reader.next()
+		if err != nil {
+			if ctx.Err() == nil && !errors.Is(err, io.EOF) {
+				r.settings.Logger.Warn("Error reading container logs", zap.String("id", cid), zap.Error(err))
+			}
+			return
+		}
+
+		// The since filter of the daemon is inclusive, drop what was already consumed.
+		var kept []logEntry
+		for _, entry := range entries {
+			if entry.timestamp.After(checkpoint) {
+				kept = append(kept, entry)
+			}
+		}
+		if len(kept) == 0 {
+			continue
+		}
+
+		// On failure the tailer stops without advancing the checkpoint, the next sync
+		// follows the container again and reads the failed lines once more.
+		if err = r.consumer.ConsumeLogs(ctx, r.buildLogs(t.container, kept)); err != nil {
+			r.settings.Logger.Error("Failed to consume container logs", zap.String("id", cid), zap.Error(err))
+			if checkpoint.IsZero() {
+				r.storeCheckpoint(ctx, cid, kept[0].timestamp.Add(-time.Nanosecond))
+			}
+			return
+		}
+
+		checkpoint = kept[len(kept)-1].timestamp
+		r.storeCheckpoint(ctx, cid, checkpoint)
+	}
+}
+
+func (r *receiver) buildLogs(container docker.Container, entries []logEntry) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+
+	attrs := rl.Resource().Attributes()
+	attrs.PutStr(conventions.AttributeContainerRuntime, "docker")
+	attrs.PutStr(attributeContainerHostname, container.Config.Hostname)
+	attrs.PutStr(conventions.AttributeContainerID, container.ID)
+	attrs.PutStr(conventions.AttributeContainerImageName, container.Config.Image)
+	attrs.PutStr(conventions.AttributeContainerName, strings.TrimPrefix(container.Name, "/"))
+	for k, label := range r.config.ContainerLabelsToResourceAttributes {
+		if v, ok := container.Config.Labels[k]; ok {
+			attrs.PutStr(label, v)
+		}
+	}
+
+	lrs := rl.ScopeLogs().AppendEmpty().LogRecords()
+	lrs.EnsureCapacity(len(entries))
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	for _, entry := range entries {
+		lr := lrs.AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(entry.timestamp))
+		lr.SetObservedTimestamp(observed)
+		lr.Body().SetStr(entry.line)
+		lr.Attributes().PutStr(attributeLogIOStream, entry.stream)
+	}
+	return ld
+}
+
+// loadCheckpoint returns the timestamp of the last log line consumed for the container,
+// or the zero time if none was stored.
+func (r *receiver) loadCheckpoint(ctx context.Context, cid string) time.Time {
+	r.checkpointsLock.Lock()
+	checkpoint, ok := r.checkpoints[cid]
+	r.checkpointsLock.Unlock()
+	if ok {
+		return checkpoint
+	}
+
+	data, err := r.storageClient.Get(ctx, cid)
+	if err != nil {
+		r.settings.Logger.Warn("Could not load container log checkpoint", zap.String("id", cid), zap.Error(err))
+		return time.Time{}
+	}
+	if len(data) == 0 {
+		return time.Time{}
+	}
+
+	checkpoint, err = time.Parse(time.RFC3339Nano, string(data))
+	if err != nil {
+		r.settings.Logger.Warn("Ignoring invalid container log checkpoint", zap.String("id", cid), zap.Error(err))
+		return time.Time{}
+	}
+	return checkpoint
+}
+
+func (r *receiver) storeCheckpoint(ctx context.Context, cid string, checkpoint time.Time) {
+	r.checkpointsLock.Lock()
+	r.checkpoints[cid] = checkpoint
+	r.checkpointsLock.Unlock()
+
+	if err := r.storageClient.Set(ctx, cid, []byte(checkpoint.Format(time.RFC3339Nano))); err != nil {
+		r.settings.Logger.Warn("Could not store container log checkpoint", zap.String("id", cid), zap.Error(err))
+	}
+}
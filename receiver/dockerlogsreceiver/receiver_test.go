@@ -0,0 +1,273 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package dockerlogsreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+const containerID = "10b703fb312b25e8368ab5a3bce3a1610d1cee5d71a94920f1a7adbc5b0cb326"
+
+// mockEngine serves the subset of the Docker Engine API used by the receiver
+// and records the query of every logs request.
+type mockEngine struct {
+	*httptest.Server
+	logs []byte
+
+	mu      sync.Mutex
+	queries []url.Values
+}
+
+func newMockEngine(t *testing.T, logs []byte) *mockEngine {
+	engine := &mockEngine{logs: logs}
+	containers := []map[string]interface{}{{"Id": containerID, "Image": "ubuntu"}}
+	container := map[string]interface{}{
+		"Id":   containerID,
+		"Name": "/bold_sinoussi",
+		"Config": map[string]interface{}{
+			"Hostname": "10b703fb312b",
+			"Image":    "ubuntu",
+			"Labels":   map[string]string{"container.label": "container-label"},
+		},
+		"State": map[string]interface{}{"Running": true},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1.22/containers/json", func(rw http.ResponseWriter, _ *http.Request) {
+		assert.NoError(t, json.NewEncoder(rw).Encode(containers))
+	})
+	mux.HandleFunc("/v1.22/containers/"+containerID+"/json", func(rw http.ResponseWriter, _ *http.Request) {
+		assert.NoError(t, json.NewEncoder(rw).Encode(container))
+	})
+	mux.HandleFunc("/v1.22/containers/"+containerID+"/logs", func(rw http.ResponseWriter, req *http.Request) {
+		engine.mu.Lock()
+		engine.queries = append(engine.queries, req.URL.Query())
+		engine.mu.Unlock()
+		_, _ = rw.Write(engine.logs)
+	})
+	mux.HandleFunc("/v1.22/events", func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+		rw.(http.Flusher).Flush()
+		<-req.Context().Done()
+	})
+	engine.Server = httptest.NewServer(mux)
+	t.Cleanup(engine.Close)
+	return engine
+}
+
+func (e *mockEngine) logQueries() []url.Values {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]url.Values(nil), e.queries...)
+}
+
+func testLogs() []byte {
+	var logs []byte
+	logs = append(logs, frame(1, "2023-02-01T10:00:00.000000001Z starting\n")...)
+	logs = append(logs, frame(2, "2023-02-01T10:00:00.000000002Z something failed\n")...)
+	return logs
+}
+
+func TestReceiverFollowsContainerLogs(t *testing.T) {
+	engine := newMockEngine(t, testLogs())
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = engine.URL
+	cfg.StartAt = startAtBeginning
+	cfg.ContainerLabelsToResourceAttributes = map[string]string{"container.label": "my.label"}
+
+	sink := new(consumertest.LogsSink)
+	r := newReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The mock stream ends after every request, so the receiver follows the container
+	// again and must not emit the lines it already consumed.
+	assert.Eventually(t, func() bool {
+		return len(engine.logQueries()) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	queries := engine.logQueries()
+	assert.Equal(t, "1", queries[0].Get("follow"))
+	assert.Equal(t, "1", queries[0].Get("timestamps"))
+	assert.Equal(t, "", queries[0].Get("since"))
+	assert.Equal(t, "", queries[0].Get("tail"))
+	assert.Equal(t, "1675245600.000000002", queries[1].Get("since"))
+
+	require.Equal(t, 2, sink.LogRecordCount())
+	allLogs := sink.AllLogs()
+	for _, logs := range allLogs {
+		assert.Equal(t, map[string]interface{}{
+			"container.runtime":    "docker",
+			"container.hostname":   "10b703fb312b",
+			"container.id":         containerID,
+			"container.image.name": "ubuntu",
+			"container.name":       "bold_sinoussi",
+			"my.label":             "container-label",
+		}, logs.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	}
+
+	stdout := allLogs[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "starting", stdout.Body().Str())
+	assert.Equal(t, map[string]interface{}{"log.iostream": "stdout"}, stdout.Attributes().AsRaw())
+	assert.Equal(t, time.Date(2023, 2, 1, 10, 0, 0, 1, time.UTC), stdout.Timestamp().AsTime())
+
+	stderr := allLogs[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "something failed", stderr.Body().Str())
+	assert.Equal(t, map[string]interface{}{"log.iostream": "stderr"}, stderr.Attributes().AsRaw())
+}
+
+func TestReceiverStartAtEnd(t *testing.T) {
+	engine := newMockEngine(t, nil)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = engine.URL
+
+	r := newReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		return len(engine.logQueries()) >= 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	assert.Equal(t, "0", engine.logQueries()[0].Get("tail"))
+}
+
+func TestReceiverResumesFromCheckpoint(t *testing.T) {
+	engine := newMockEngine(t, testLogs())
+
+	storageID := storagetest.NewStorageID("docker_logs")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = engine.URL
+	cfg.StorageID = &storageID
+
+	settings := receivertest.NewNopCreateSettings()
+	ext := storagetest.NewFileBackedStorageExtension("docker_logs", t.TempDir())
+	client, err := ext.GetClient(context.Background(), component.KindReceiver, settings.ID, "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(context.Background(), containerID, []byte("2023-02-01T10:00:00.000000001Z")))
+	require.NoError(t, client.Close(context.Background()))
+
+	sink := new(consumertest.LogsSink)
+	r := newReceiver(settings, cfg, sink)
+	host := storagetest.NewStorageHost().WithExtension(storageID, ext)
+	require.NoError(t, r.Start(context.Background(), host))
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	assert.Equal(t, "1675245600.000000001", engine.logQueries()[0].Get("since"))
+	record := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "something failed", record.Body().Str())
+
+	client, err = ext.GetClient(context.Background(), component.KindReceiver, settings.ID, "")
+	require.NoError(t, err)
+	stored, err := client.Get(context.Background(), containerID)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-02-01T10:00:00.000000002Z", string(stored))
+	require.NoError(t, client.Close(context.Background()))
+}
+
+func TestReceiverRetriesFailedBatch(t *testing.T) {
+	engine := newMockEngine(t, testLogs())
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = engine.URL
+
+	sink := new(consumertest.LogsSink)
+	var calls atomic.Int32
+	logsConsumer, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		if calls.Add(1) == 1 {
+			return errors.New("consumer failed")
+		}
+		return sink.ConsumeLogs(ctx, ld)
+	})
+	require.NoError(t, err)
+
+	r := newReceiver(receivertest.NewNopCreateSettings(), cfg, logsConsumer)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	// The first batch failed, the container is followed again from right before it
+	// instead of from the end of the logs.
+	queries := engine.logQueries()
+	assert.Equal(t, "0", queries[0].Get("tail"))
+	assert.Equal(t, "1675245600.000000000", queries[1].Get("since"))
+
+	record := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "starting", record.Body().Str())
+}
+
+func TestReceiverPrunesCheckpointsOfRemovedContainers(t *testing.T) {
+	engine := newMockEngine(t, testLogs())
+
+	storageID := storagetest.NewStorageID("docker_logs")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = engine.URL
+	cfg.StartAt = startAtBeginning
+	cfg.StorageID = &storageID
+
+	settings := receivertest.NewNopCreateSettings()
+	ext := storagetest.NewFileBackedStorageExtension("docker_logs", t.TempDir())
+	sink := new(consumertest.LogsSink)
+	r := newReceiver(settings, cfg, sink)
+	host := storagetest.NewStorageHost().WithExtension(storageID, ext)
+	require.NoError(t, r.Start(context.Background(), host))
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	r.client.RemoveContainer(containerID)
+	assert.Eventually(t, func() bool {
+		r.checkpointsLock.Lock()
+		defer r.checkpointsLock.Unlock()
+		return len(r.checkpoints) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	client, err := ext.GetClient(context.Background(), component.KindReceiver, settings.ID, "")
+	require.NoError(t, err)
+	stored, err := client.Get(context.Background(), containerID)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+	require.NoError(t, client.Close(context.Background()))
+}
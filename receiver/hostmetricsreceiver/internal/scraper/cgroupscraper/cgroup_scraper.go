@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+const (
+	cpuMetricsLen    = 2
+	memoryMetricsLen = 2
+	ioMetricsLen     = 2
+	pidsMetricsLen   = 1
+	metricsLen       = cpuMetricsLen + memoryMetricsLen + ioMetricsLen + pidsMetricsLen
+)
+
+// scraper for cgroup v2 Metrics
+type scraper struct {
+	settings  receiver.CreateSettings
+	config    *Config
+	mb        *metadata.MetricsBuilder
+	includeFS filterset.FilterSet
+	excludeFS filterset.FilterSet
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// newCgroupScraper creates a set of cgroup v2 related metrics
+func newCgroupScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) (*scraper, error) {
+	scraper := &scraper{settings: settings, config: cfg, bootTime: host.BootTime}
+
+	var err error
+
+	if len(cfg.Include.Paths) > 0 {
+		scraper.includeFS, err = filterset.CreateFilterSet(cfg.Include.Paths, &cfg.Include.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cgroup include filters: %w", err)
+		}
+	}
+
+	if len(cfg.Exclude.Paths) > 0 {
+		scraper.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.Paths, &cfg.Exclude.Config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cgroup exclude filters: %w", err)
+		}
+	}
+
+	return scraper, nil
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errs scrapererror.ScrapeErrors
+
+	mountPath := s.mountPath()
+	slices, err := findSlices(mountPath)
+	if err != nil {
+		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+	}
+
+	for _, slice := range slices {
+		cgroupPath := "/" + filepath.ToSlash(slice)
+		if !s.includeSlice(cgroupPath) {
+			continue
+		}
+
+		dir := filepath.Join(mountPath, slice)
+		if err = s.recordCPUMetrics(now, dir); err != nil {
+			errs.AddPartial(cpuMetricsLen, err)
+		}
+		if err = s.recordMemoryMetrics(now, dir); err != nil {
+			errs.AddPartial(memoryMetricsLen, err)
+		}
+		if err = s.recordIOMetrics(now, dir); err != nil {
+			errs.AddPartial(ioMetricsLen, err)
+		}
+		if err = s.recordPidsMetrics(now, dir); err != nil {
+			errs.AddPartial(pidsMetricsLen, err)
+		}
+
+		s.mb.EmitForResource(metadata.WithCgroupPath(cgroupPath))
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *scraper) mountPath() string {
+	rootPath := s.config.RootPath
+	if rootPath == "" {
+		rootPath = "/"
+	}
+	return filepath.Join(rootPath, "sys", "fs", "cgroup")
+}
+
+func (s *scraper) includeSlice(cgroupPath string) bool {
+	return (s.includeFS == nil || s.includeFS.Matches(cgroupPath)) &&
+		(s.excludeFS == nil || !s.excludeFS.Matches(cgroupPath))
+}
+
+// findSlices returns the paths, relative to the cgroup mount point, of every
+// systemd slice in the hierarchy. Slices can only be nested in other slices,
+// so services and scopes are not descended into.
+func findSlices(mountPath string) ([]string, error) {
+	var slices []string
+	err := filepath.WalkDir(mountPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() || path == mountPath {
+			return nil
+		}
+		if !strings.HasSuffix(d.Name(), ".slice") {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(mountPath, path)
+		if err != nil {
+			return err
+		}
+		slices = append(slices, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cgroup slices: %w", err)
+	}
+	return slices, nil
+}
+
+func (s *scraper) recordCPUMetrics(now pcommon.Timestamp, dir string) error {
+	stats, err := readFlatKeyed(filepath.Join(dir, "cpu.stat"))
+	if err != nil || stats == nil {
+		return err
+	}
+
+	s.mb.RecordSystemCgroupCPUTimeDataPoint(now, float64(stats["user_usec"])/1e6, metadata.AttributeStateUser)
+	s.mb.RecordSystemCgroupCPUTimeDataPoint(now, float64(stats["system_usec"])/1e6, metadata.AttributeStateSystem)
+	if throttled, ok := stats["throttled_usec"]; ok {
+		s.mb.RecordSystemCgroupCPUThrottledTimeDataPoint(now, float64(throttled)/1e6)
+	}
+	return nil
+}
+
+func (s *scraper) recordMemoryMetrics(now pcommon.Timestamp, dir string) error {
+	current, ok, err := readSingleValue(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		s.mb.RecordSystemCgroupMemoryUsageDataPoint(now, current)
+	}
+
+	limit, ok, err := readSingleValue(filepath.Join(dir, "memory.max"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		s.mb.RecordSystemCgroupMemoryLimitDataPoint(now, limit)
+	}
+	return nil
+}
+
+func (s *scraper) recordIOMetrics(now pcommon.Timestamp, dir string) error {
+	stats, err := readNestedKeyed(filepath.Join(dir, "io.stat"))
+	if err != nil || stats == nil {
+		return err
+	}
+
+	var rbytes, wbytes, rios, wios int64
+	for _, device := range stats {
+		rbytes += device["rbytes"]
+		wbytes += device["wbytes"]
+		rios += device["rios"]
+		wios += device["wios"]
+	}
+
+	s.mb.RecordSystemCgroupIoDataPoint(now, rbytes, metadata.AttributeDirectionRead)
+	s.mb.RecordSystemCgroupIoDataPoint(now, wbytes, metadata.AttributeDirectionWrite)
+	s.mb.RecordSystemCgroupIoOperationsDataPoint(now, rios, metadata.AttributeDirectionRead)
+	s.mb.RecordSystemCgroupIoOperationsDataPoint(now, wios, metadata.AttributeDirectionWrite)
+	return nil
+}
+
+func (s *scraper) recordPidsMetrics(now pcommon.Timestamp, dir string) error {
+	current, ok, err := readSingleValue(filepath.Join(dir, "pids.current"))
+	if err != nil {
+		return err
+	}
+	if ok {
+		s.mb.RecordSystemCgroupPidsCountDataPoint(now, current)
+	}
+	return nil
+}
+
+// readSingleValue reads an interface file holding a single integer, such as
+// memory.current. ok is false when the controller is not enabled for the
+// cgroup or the value is "max".
+func readSingleValue(path string) (value int64, ok bool, err error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	raw := strings.TrimSpace(string(content))
+	if raw == "max" {
+		return 0, false, nil
+	}
+
+	value, err = strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return value, true, nil
+}
+
+// readFlatKeyed reads an interface file of "<key> <value>" lines, such as
+// cpu.stat. A nil map is returned when the file does not exist.
+func readFlatKeyed(path string) (map[string]int64, error) {
+	values := map[string]int64{}
+	err := scanLines(path, func(fields []string) error {
+		if len(fields) != 2 {
+			return fmt.Errorf("unexpected line in %s: %q", path, strings.Join(fields, " "))
+		}
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		values[fields[0]] = value
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return values, err
+}
+
+// readNestedKeyed reads an interface file of "<key> <sub-key>=<value> ..."
+// lines, such as io.stat. A nil map is returned when the file does not exist.
+func readNestedKeyed(path string) (map[string]map[string]int64, error) {
+	values := map[string]map[string]int64{}
+	err := scanLines(path, func(fields []string) error {
+		entry := map[string]int64{}
+		for _, field := range fields[1:] {
+			key, raw, found := strings.Cut(field, "=")
+			if !found {
+				return fmt.Errorf("unexpected field in %s: %q", path, field)
+			}
+			value, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse %s: %w", path, err)
+			}
+			entry[key] = value
+		}
+		values[fields[0]] = entry
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return values, err
+}
+
+func scanLines(path string, fn func(fields []string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if err = fn(fields); err != nil {
+			return err
+		}
+	}
+	return sc.Err()
+}
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroupscraper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	type testCase struct {
+		name           string
+		include        MatchConfig
+		exclude        MatchConfig
+		expectedSlices []string
+	}
+
+	testCases := []testCase{
+		{
+			name:           "All slices",
+			expectedSlices: []string{"/system.slice", "/user.slice", "/user.slice/user-1000.slice"},
+		},
+		{
+			name: "Include filter",
+			include: MatchConfig{
+				Config: filterset.Config{MatchType: filterset.Regexp},
+				Paths:  []string{"^/user\\.slice"},
+			},
+			expectedSlices: []string{"/user.slice", "/user.slice/user-1000.slice"},
+		},
+		{
+			name: "Exclude filter",
+			exclude: MatchConfig{
+				Config: filterset.Config{MatchType: filterset.Strict},
+				Paths:  []string{"/user.slice/user-1000.slice"},
+			},
+			expectedSlices: []string{"/system.slice", "/user.slice"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &Config{
+				Metrics: metadata.DefaultMetricsSettings(),
+				Include: test.include,
+				Exclude: test.exclude,
+			}
+			cfg.SetRootPath("testdata")
+
+			scraper, err := newCgroupScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+			scraper.bootTime = func() (uint64, error) { return 100, nil }
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			var slices []string
+			rms := md.ResourceMetrics()
+			for i := 0; i < rms.Len(); i++ {
+				path, ok := rms.At(i).Resource().Attributes().Get("cgroup.path")
+				require.True(t, ok)
+				slices = append(slices, path.Str())
+				internal.AssertSameTimeStampForAllMetrics(t, rms.At(i).ScopeMetrics().At(0).Metrics())
+			}
+			assert.ElementsMatch(t, test.expectedSlices, slices)
+		})
+	}
+}
+
+func TestScrapeSystemSlice(t *testing.T) {
+	cfg := &Config{
+		Metrics: metadata.DefaultMetricsSettings(),
+		Include: MatchConfig{
+			Config: filterset.Config{MatchType: filterset.Strict},
+			Paths:  []string{"/system.slice"},
+		},
+	}
+	cfg.SetRootPath("testdata")
+
+	scraper, err := newCgroupScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	scraper.bootTime = func() (uint64, error) { return 100, nil }
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	// memory.max is "max", so no limit is reported.
+	assert.Equal(t, metricsLen-1, metrics.Len())
+
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		dps := metric.Sum().DataPoints()
+		switch metric.Name() {
+		case "system.cgroup.cpu.time":
+			assertDataPointsByAttribute(t, dps, "state", map[string]float64{"user": 3, "system": 2})
+		case "system.cgroup.cpu.throttled_time":
+			assert.Equal(t, 0.25, dps.At(0).DoubleValue())
+		case "system.cgroup.memory.usage":
+			assert.Equal(t, int64(104857600), dps.At(0).IntValue())
+		case "system.cgroup.io":
+			assertDataPointsByAttribute(t, dps, "direction", map[string]float64{"read": 5120, "write": 8192})
+		case "system.cgroup.io.operations":
+			assertDataPointsByAttribute(t, dps, "direction", map[string]float64{"read": 4, "write": 2})
+		case "system.cgroup.pids.count":
+			assert.Equal(t, int64(42), dps.At(0).IntValue())
+		default:
+			assert.Failf(t, "unexpected-metric", "metric %q is not expected", metric.Name())
+		}
+	}
+}
+
+func TestScrapeErrors(t *testing.T) {
+	t.Run("missing cgroup mount", func(t *testing.T) {
+		cfg := &Config{Metrics: metadata.DefaultMetricsSettings()}
+		cfg.SetRootPath(t.TempDir())
+
+		scraper, err := newCgroupScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+		require.NoError(t, err)
+		scraper.bootTime = func() (uint64, error) { return 100, nil }
+		require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+		_, err = scraper.scrape(context.Background())
+		assert.ErrorContains(t, err, "failed to list cgroup slices")
+		assert.True(t, scrapererror.IsPartialScrapeError(err))
+	})
+
+	t.Run("malformed file", func(t *testing.T) {
+		root := t.TempDir()
+		slice := filepath.Join(root, "sys", "fs", "cgroup", "system.slice")
+		require.NoError(t, os.MkdirAll(slice, 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(slice, "pids.current"), []byte("many\n"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(slice, "memory.current"), []byte("1024\n"), 0600))
+
+		cfg := &Config{Metrics: metadata.DefaultMetricsSettings()}
+		cfg.SetRootPath(root)
+
+		scraper, err := newCgroupScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+		require.NoError(t, err)
+		scraper.bootTime = func() (uint64, error) { return 100, nil }
+		require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+		md, err := scraper.scrape(context.Background())
+		var partialErr scrapererror.PartialScrapeError
+		require.ErrorAs(t, err, &partialErr)
+		assert.Equal(t, pidsMetricsLen, partialErr.Failed)
+		assert.Equal(t, 1, md.MetricCount())
+	})
+
+	t.Run("boot time", func(t *testing.T) {
+		scraper, err := newCgroupScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{})
+		require.NoError(t, err)
+		scraper.bootTime = func() (uint64, error) { return 0, errors.New("err1") }
+		assert.EqualError(t, scraper.start(context.Background(), componenttest.NewNopHost()), "err1")
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		_, err := newCgroupScraper(context.Background(), receivertest.NewNopCreateSettings(), &Config{
+			Include: MatchConfig{Paths: []string{"/system.slice"}},
+		})
+		assert.ErrorContains(t, err, "error creating cgroup include filters")
+	})
+}
+
+func assertDataPointsByAttribute(t *testing.T, dps pmetric.NumberDataPointSlice, attr string, expected map[string]float64) {
+	actual := map[string]float64{}
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		v, _ := dp.Attributes().Get(attr)
+		if dp.ValueType() == pmetric.NumberDataPointValueTypeDouble {
+			actual[v.Str()] = dp.DoubleValue()
+		} else {
+			actual[v.Str()] = float64(dp.IntValue())
+		}
+	}
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroupscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper"
+
+import (
+	"context"
+	"errors"
+	"runtime"
+
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cgroupscraper/internal/metadata"
+)
+
+// This file implements Factory for cgroup scraper.
+
+const (
+	// TypeStr the value of "type" key in configuration.
+	TypeStr = "cgroup"
+)
+
+// Factory is the Factory for scraper.
+type Factory struct {
+}
+
+// CreateDefaultConfig creates the default configuration for the Scraper.
+func (f *Factory) CreateDefaultConfig() internal.Config {
+	return &Config{
+		Metrics: metadata.DefaultMetricsSettings(),
+	}
+}
+
+// CreateMetricsScraper creates a resource scraper based on provided config.
+func (f *Factory) CreateMetricsScraper(
+	ctx context.Context,
+	settings receiver.CreateSettings,
+	config internal.Config,
+) (scraperhelper.Scraper, error) {
+	if runtime.GOOS != "linux" {
+		return nil, errors.New("cgroup scraper only available on Linux")
+	}
+
+	cfg := config.(*Config)
+	s, err := newCgroupScraper(ctx, settings, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraper(
+		TypeStr,
+		s.scrape,
+		scraperhelper.WithStart(s.start),
+	)
+}
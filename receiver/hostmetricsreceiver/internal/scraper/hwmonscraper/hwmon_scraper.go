@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwmonscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper/internal/metadata"
+)
+
+const metricsLen = 2
+
+// scraper for Hardware Monitoring Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+}
+
+// newHwmonScraper creates a set of hardware sensor related metrics
+func newHwmonScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{settings: settings, config: cfg}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, s.settings)
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errs scrapererror.ScrapeErrors
+
+	devices, err := filepath.Glob(filepath.Join(s.hwmonPath(), "hwmon*"))
+	if err != nil {
+		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+	}
+
+	for _, devicePath := range devices {
+		device := filepath.Base(devicePath)
+		chip := readTrimmed(filepath.Join(devicePath, "name"))
+
+		if s.config.Metrics.SystemHwmonTemperature.Enabled {
+			err = forEachSensor(devicePath, "temp", func(sensor string, value int64) {
+				// temperatures are exposed in millidegree Celsius
+				s.mb.RecordSystemHwmonTemperatureDataPoint(now, float64(value)/1000, device, chip, sensor)
+			})
+			if err != nil {
+				errs.AddPartial(1, err)
+			}
+		}
+
+		if s.config.Metrics.SystemHwmonFanSpeed.Enabled {
+			err = forEachSensor(devicePath, "fan", func(sensor string, value int64) {
+				s.mb.RecordSystemHwmonFanSpeedDataPoint(now, value, device, chip, sensor)
+			})
+			if err != nil {
+				errs.AddPartial(1, err)
+			}
+		}
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *scraper) hwmonPath() string {
+	rootPath := s.config.RootPath
+	if rootPath == "" {
+		rootPath = "/"
+	}
+	return filepath.Join(rootPath, "sys", "class", "hwmon")
+}
+
+// forEachSensor calls fn for every `<kind><N>_input` file of the device, passing
+// the sensor label (or channel name when no `<kind><N>_label` file exists) and
+// the raw integer reading.
+func forEachSensor(devicePath string, kind string, fn func(sensor string, value int64)) error {
+	inputs, err := filepath.Glob(filepath.Join(devicePath, kind+"*_input"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(inputs)
+
+	for _, input := range inputs {
+		channel := strings.TrimSuffix(filepath.Base(input), "_input")
+		raw, err := os.ReadFile(input)
+		if err != nil {
+			// sensors of absent or powered down hardware fail to read; skip them.
+			continue
+		}
+
+		value, err := strconv.ParseInt(strings.TrimSpace(string(raw)), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", input, err)
+		}
+
+		sensor := readTrimmed(filepath.Join(devicePath, channel+"_label"))
+		if sensor == "" {
+			sensor = channel
+		}
+		fn(sensor, value)
+	}
+	return nil
+}
+
+// readTrimmed returns the content of a single-value sysfs file, or the
+// empty string if it cannot be read.
+func readTrimmed(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwmonscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/hwmonscraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	cfg := &Config{Metrics: metadata.DefaultMetricsSettings()}
+	cfg.SetRootPath("testdata")
+
+	scraper := newHwmonScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, metricsLen, md.MetricCount())
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	internal.AssertSameTimeStampForAllMetrics(t, metrics)
+
+	temperature := findMetric(t, metrics, "system.hwmon.temperature")
+	assert.Equal(t, map[string]float64{
+		"hwmon0/coretemp/Package id 0": 45,
+		"hwmon0/coretemp/Core 0":       42.5,
+		"hwmon1/nct6775/temp1":         30,
+	}, collectDataPoints(temperature, pmetric.NumberDataPoint.DoubleValue))
+
+	fan := findMetric(t, metrics, "system.hwmon.fan.speed")
+	assert.Equal(t, map[string]float64{
+		"hwmon1/nct6775/fan1": 1200,
+	}, collectDataPoints(fan, func(dp pmetric.NumberDataPoint) float64 { return float64(dp.IntValue()) }))
+}
+
+func TestScrapeNoDevices(t *testing.T) {
+	cfg := &Config{Metrics: metadata.DefaultMetricsSettings()}
+	cfg.SetRootPath(t.TempDir())
+
+	scraper := newHwmonScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.MetricCount())
+}
+
+func TestScrapeInvalidReading(t *testing.T) {
+	root := t.TempDir()
+	device := filepath.Join(root, "sys", "class", "hwmon", "hwmon0")
+	require.NoError(t, os.MkdirAll(device, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(device, "temp1_input"), []byte("hot\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(device, "fan1_input"), []byte("800\n"), 0600))
+
+	cfg := &Config{Metrics: metadata.DefaultMetricsSettings()}
+	cfg.SetRootPath(root)
+
+	scraper := newHwmonScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, 1, md.MetricCount())
+}
+
+func findMetric(t *testing.T, metrics pmetric.MetricSlice, name string) pmetric.Metric {
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i)
+		}
+	}
+	require.Failf(t, "missing-metric", "metric %q not found", name)
+	return pmetric.Metric{}
+}
+
+func collectDataPoints(metric pmetric.Metric, value func(pmetric.NumberDataPoint) float64) map[string]float64 {
+	res := map[string]float64{}
+	dps := metric.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		device, _ := dp.Attributes().Get("device")
+		chip, _ := dp.Attributes().Get("chip")
+		sensor, _ := dp.Attributes().Get("sensor")
+		res[device.Str()+"/"+chip.Str()+"/"+sensor.Str()] = value(dp)
+	}
+	return res
+}
@@ -0,0 +1,402 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+)
+
+// MetricSettings provides common settings for a particular metric.
+type MetricSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	enabledSetByUser bool
+}
+
+func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+	err := parser.Unmarshal(ms, confmap.WithErrorUnused())
+	if err != nil {
+		return err
+	}
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// MetricsSettings provides settings for hostmetricsreceiver/pressure metrics.
+type MetricsSettings struct {
+	SystemPressureStallRatio MetricSettings `mapstructure:"system.pressure.stall.ratio"`
+	SystemPressureStallTime  MetricSettings `mapstructure:"system.pressure.stall.time"`
+}
+
+func DefaultMetricsSettings() MetricsSettings {
+	return MetricsSettings{
+		SystemPressureStallRatio: MetricSettings{
+			Enabled: false,
+		},
+		SystemPressureStallTime: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+// ResourceAttributeSettings provides common settings for a particular metric.
+type ResourceAttributeSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	enabledProvidedByUser bool
+}
+
+func (ras *ResourceAttributeSettings) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+	err := parser.Unmarshal(ras, confmap.WithErrorUnused())
+	if err != nil {
+		return err
+	}
+	ras.enabledProvidedByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// ResourceAttributesSettings provides settings for hostmetricsreceiver/pressure metrics.
+type ResourceAttributesSettings struct {
+}
+
+func DefaultResourceAttributesSettings() ResourceAttributesSettings {
+	return ResourceAttributesSettings{}
+}
+
+// AttributeResource specifies the a value resource attribute.
+type AttributeResource int
+
+const (
+	_ AttributeResource = iota
+	AttributeResourceCpu
+	AttributeResourceMemory
+	AttributeResourceIo
+)
+
+// String returns the string representation of the AttributeResource.
+func (av AttributeResource) String() string {
+	switch av {
+	case AttributeResourceCpu:
+		return "cpu"
+	case AttributeResourceMemory:
+		return "memory"
+	case AttributeResourceIo:
+		return "io"
+	}
+	return ""
+}
+
+// MapAttributeResource is a helper map of string to AttributeResource attribute value.
+var MapAttributeResource = map[string]AttributeResource{
+	"cpu":    AttributeResourceCpu,
+	"memory": AttributeResourceMemory,
+	"io":     AttributeResourceIo,
+}
+
+// AttributeScope specifies the a value scope attribute.
+type AttributeScope int
+
+const (
+	_ AttributeScope = iota
+	AttributeScopeSome
+	AttributeScopeFull
+)
+
+// String returns the string representation of the AttributeScope.
+func (av AttributeScope) String() string {
+	switch av {
+	case AttributeScopeSome:
+		return "some"
+	case AttributeScopeFull:
+		return "full"
+	}
+	return ""
+}
+
+// MapAttributeScope is a helper map of string to AttributeScope attribute value.
+var MapAttributeScope = map[string]AttributeScope{
+	"some": AttributeScopeSome,
+	"full": AttributeScopeFull,
+}
+
+// AttributeWindow specifies the a value window attribute.
+type AttributeWindow int
+
+const (
+	_ AttributeWindow = iota
+	AttributeWindow10s
+	AttributeWindow60s
+	AttributeWindow300s
+)
+
+// String returns the string representation of the AttributeWindow.
+func (av AttributeWindow) String() string {
+	switch av {
+	case AttributeWindow10s:
+		return "10s"
+	case AttributeWindow60s:
+		return "60s"
+	case AttributeWindow300s:
+		return "300s"
+	}
+	return ""
+}
+
+// MapAttributeWindow is a helper map of string to AttributeWindow attribute value.
+var MapAttributeWindow = map[string]AttributeWindow{
+	"10s":  AttributeWindow10s,
+	"60s":  AttributeWindow60s,
+	"300s": AttributeWindow300s,
+}
+
+type metricSystemPressureStallRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.pressure.stall.ratio metric with initial data.
+func (m *metricSystemPressureStallRatio) init() {
+	m.data.SetName("system.pressure.stall.ratio")
+	m.data.SetDescription("Share of wall time tasks were stalled waiting for a resource, averaged over a window.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemPressureStallRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, resourceAttributeValue string, scopeAttributeValue string, windowAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
+	dp.Attributes().PutStr("scope", scopeAttributeValue)
+	dp.Attributes().PutStr("window", windowAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemPressureStallRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemPressureStallRatio) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemPressureStallRatio(settings MetricSettings) metricSystemPressureStallRatio {
+	m := metricSystemPressureStallRatio{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSystemPressureStallTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills system.pressure.stall.time metric with initial data.
+func (m *metricSystemPressureStallTime) init() {
+	m.data.SetName("system.pressure.stall.time")
+	m.data.SetDescription("Total time tasks were stalled waiting for a resource.")
+	m.data.SetUnit("us")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSystemPressureStallTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, resourceAttributeValue string, scopeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("resource", resourceAttributeValue)
+	dp.Attributes().PutStr("scope", scopeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSystemPressureStallTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSystemPressureStallTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSystemPressureStallTime(settings MetricSettings) metricSystemPressureStallTime {
+	m := metricSystemPressureStallTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+// MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
+// required to produce metric representation defined in metadata and user settings.
+type MetricsBuilder struct {
+	startTime                      pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                int                 // maximum observed number of metrics per resource.
+	resourceCapacity               int                 // maximum observed number of resource attributes.
+	metricsBuffer                  pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                      component.BuildInfo // contains version information
+	resourceAttributesSettings     ResourceAttributesSettings
+	metricSystemPressureStallRatio metricSystemPressureStallRatio
+	metricSystemPressureStallTime  metricSystemPressureStallTime
+}
+
+// metricBuilderOption applies changes to default metrics builder.
+type metricBuilderOption func(*MetricsBuilder)
+
+// WithStartTime sets startTime on the metrics builder.
+func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.startTime = startTime
+	}
+}
+
+// WithResourceAttributesSettings sets ResourceAttributeSettings on the metrics builder.
+func WithResourceAttributesSettings(ras ResourceAttributesSettings) metricBuilderOption {
+	return func(mb *MetricsBuilder) {
+		mb.resourceAttributesSettings = ras
+	}
+}
+
+func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
+	mb := &MetricsBuilder{
+		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                  pmetric.NewMetrics(),
+		buildInfo:                      settings.BuildInfo,
+		resourceAttributesSettings:     DefaultResourceAttributesSettings(),
+		metricSystemPressureStallRatio: newMetricSystemPressureStallRatio(ms.SystemPressureStallRatio),
+		metricSystemPressureStallTime:  newMetricSystemPressureStallTime(ms.SystemPressureStallTime),
+	}
+	for _, op := range options {
+		op(mb)
+	}
+	return mb
+}
+
+// updateCapacity updates max length of metrics and resource attributes that will be used for the slice capacity.
+func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
+	if mb.metricsCapacity < rm.ScopeMetrics().At(0).Metrics().Len() {
+		mb.metricsCapacity = rm.ScopeMetrics().At(0).Metrics().Len()
+	}
+	if mb.resourceCapacity < rm.Resource().Attributes().Len() {
+		mb.resourceCapacity = rm.Resource().Attributes().Len()
+	}
+}
+
+// ResourceMetricsOption applies changes to provided resource metrics.
+type ResourceMetricsOption func(ResourceAttributesSettings, pmetric.ResourceMetrics)
+
+// WithStartTimeOverride overrides start time for all the resource metrics data points.
+// This option should be only used if different start time has to be set on metrics coming from different resources.
+func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		var dps pmetric.NumberDataPointSlice
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			switch metrics.At(i).Type() {
+			case pmetric.MetricTypeGauge:
+				dps = metrics.At(i).Gauge().DataPoints()
+			case pmetric.MetricTypeSum:
+				dps = metrics.At(i).Sum().DataPoints()
+			}
+			for j := 0; j < dps.Len(); j++ {
+				dps.At(j).SetStartTimestamp(start)
+			}
+		}
+	}
+}
+
+// EmitForResource saves all the generated metrics under a new resource and updates the internal state to be ready for
+// recording another set of data points as part of another resource. This function can be helpful when one scraper
+// needs to emit metrics from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceMetricsOption arguments.
+func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
+	rm := pmetric.NewResourceMetrics()
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	rm.Resource().Attributes().EnsureCapacity(mb.resourceCapacity)
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName("otelcol/hostmetricsreceiver/pressure")
+	ils.Scope().SetVersion(mb.buildInfo.Version)
+	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSystemPressureStallRatio.emit(ils.Metrics())
+	mb.metricSystemPressureStallTime.emit(ils.Metrics())
+
+	for _, op := range rmo {
+		op(mb.resourceAttributesSettings, rm)
+	}
+	if ils.Metrics().Len() > 0 {
+		mb.updateCapacity(rm)
+		rm.MoveTo(mb.metricsBuffer.ResourceMetrics().AppendEmpty())
+	}
+}
+
+// Emit returns all the metrics accumulated by the metrics builder and updates the internal state to be ready for
+// recording another set of metrics. This function will be responsible for applying all the transformations required to
+// produce metric representation defined in metadata and user settings, e.g. delta or cumulative.
+func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
+	mb.EmitForResource(rmo...)
+	metrics := mb.metricsBuffer
+	mb.metricsBuffer = pmetric.NewMetrics()
+	return metrics
+}
+
+// RecordSystemPressureStallRatioDataPoint adds a data point to system.pressure.stall.ratio metric.
+func (mb *MetricsBuilder) RecordSystemPressureStallRatioDataPoint(ts pcommon.Timestamp, val float64, resourceAttributeValue AttributeResource, scopeAttributeValue AttributeScope, windowAttributeValue AttributeWindow) {
+	mb.metricSystemPressureStallRatio.recordDataPoint(mb.startTime, ts, val, resourceAttributeValue.String(), scopeAttributeValue.String(), windowAttributeValue.String())
+}
+
+// RecordSystemPressureStallTimeDataPoint adds a data point to system.pressure.stall.time metric.
+func (mb *MetricsBuilder) RecordSystemPressureStallTimeDataPoint(ts pcommon.Timestamp, val int64, resourceAttributeValue AttributeResource, scopeAttributeValue AttributeScope) {
+	mb.metricSystemPressureStallTime.recordDataPoint(mb.startTime, ts, val, resourceAttributeValue.String(), scopeAttributeValue.String())
+}
+
+// Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
+// and metrics builder should update its startTime and reset it's internal state accordingly.
+func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
+	mb.startTime = pcommon.NewTimestampFromTime(time.Now())
+	for _, op := range options {
+		op(mb)
+	}
+}
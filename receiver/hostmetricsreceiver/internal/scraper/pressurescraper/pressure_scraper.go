@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pressurescraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+const metricsLen = 2
+
+// resources are the files found under /proc/pressure.
+var resources = []metadata.AttributeResource{
+	metadata.AttributeResourceCpu,
+	metadata.AttributeResourceMemory,
+	metadata.AttributeResourceIo,
+}
+
+// scraper for Pressure Stall Information (PSI) Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking
+	bootTime func() (uint64, error)
+}
+
+// pressureLine holds the values of a single `some` or `full` line of a PSI file.
+type pressureLine struct {
+	scope  metadata.AttributeScope
+	avg10  float64
+	avg60  float64
+	avg300 float64
+	total  int64
+}
+
+// newPressureScraper creates a set of Pressure Stall Information related metrics
+func newPressureScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{settings: settings, config: cfg, bootTime: host.BootTime}
+}
+
+func (s *scraper) start(context.Context, component.Host) error {
+	bootTime, err := s.bootTime()
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.Metrics, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errs scrapererror.ScrapeErrors
+
+	for _, resource := range resources {
+		lines, err := readPressureFile(s.pressurePath(resource))
+		if err != nil {
+			errs.AddPartial(metricsLen, err)
+			continue
+		}
+
+		for _, line := range lines {
+			s.mb.RecordSystemPressureStallTimeDataPoint(now, line.total, resource, line.scope)
+			s.mb.RecordSystemPressureStallRatioDataPoint(now, line.avg10/100, resource, line.scope, metadata.AttributeWindow10s)
+			s.mb.RecordSystemPressureStallRatioDataPoint(now, line.avg60/100, resource, line.scope, metadata.AttributeWindow60s)
+			s.mb.RecordSystemPressureStallRatioDataPoint(now, line.avg300/100, resource, line.scope, metadata.AttributeWindow300s)
+		}
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *scraper) pressurePath(resource metadata.AttributeResource) string {
+	rootPath := s.config.RootPath
+	if rootPath == "" {
+		rootPath = "/"
+	}
+	return filepath.Join(rootPath, "proc", "pressure", resource.String())
+}
+
+// readPressureFile parses a PSI file of the form:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//
+// The `full` line is absent for cpu on kernels older than 5.13.
+func readPressureFile(path string) ([]pressureLine, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pressure stall information: %w", err)
+	}
+	defer f.Close()
+
+	var lines []pressureLine
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		scope, ok := metadata.MapAttributeScope[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("unexpected line in %s: %q", path, sc.Text())
+		}
+
+		line := pressureLine{scope: scope}
+		for _, field := range fields[1:] {
+			key, value, found := strings.Cut(field, "=")
+			if !found {
+				return nil, fmt.Errorf("unexpected field in %s: %q", path, field)
+			}
+
+			switch key {
+			case "avg10":
+				line.avg10, err = strconv.ParseFloat(value, 64)
+			case "avg60":
+				line.avg60, err = strconv.ParseFloat(value, 64)
+			case "avg300":
+				line.avg300, err = strconv.ParseFloat(value, 64)
+			case "total":
+				line.total, err = strconv.ParseInt(value, 10, 64)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %q in %s: %w", field, path, err)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	if err = sc.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read pressure stall information: %w", err)
+	}
+	return lines, nil
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pressurescraper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+func TestScrape(t *testing.T) {
+	type testCase struct {
+		name           string
+		rootPath       string
+		bootTimeFunc   func() (uint64, error)
+		expectedStall  map[string]int64
+		expectedErr    string
+		initializeErr  string
+		expectedFailed int
+	}
+
+	testCases := []testCase{
+		{
+			name:     "Standard",
+			rootPath: "testdata",
+			expectedStall: map[string]int64{
+				"cpu/some":    123456,
+				"memory/some": 4000,
+				"memory/full": 1000,
+				"io/some":     987654,
+				"io/full":     876543,
+			},
+		},
+		{
+			name:          "Error from boot time",
+			rootPath:      "testdata",
+			bootTimeFunc:  func() (uint64, error) { return 100, errors.New("err1") },
+			initializeErr: "err1",
+		},
+		{
+			name:           "Missing pressure files",
+			rootPath:       t.TempDir(),
+			expectedStall:  map[string]int64{},
+			expectedErr:    "failed to read pressure stall information",
+			expectedFailed: 3 * metricsLen,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &Config{Metrics: metadata.DefaultMetricsSettings()}
+			cfg.Metrics.SystemPressureStallRatio.Enabled = true
+			cfg.SetRootPath(test.rootPath)
+
+			scraper := newPressureScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+			if test.bootTimeFunc != nil {
+				scraper.bootTime = test.bootTimeFunc
+			} else {
+				scraper.bootTime = func() (uint64, error) { return 100, nil }
+			}
+
+			err := scraper.start(context.Background(), componenttest.NewNopHost())
+			if test.initializeErr != "" {
+				assert.EqualError(t, err, test.initializeErr)
+				return
+			}
+			require.NoError(t, err, "Failed to initialize pressure scraper: %v", err)
+
+			md, err := scraper.scrape(context.Background())
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+
+				isPartial := scrapererror.IsPartialScrapeError(err)
+				assert.True(t, isPartial)
+				if isPartial {
+					var scraperErr scrapererror.PartialScrapeError
+					require.ErrorAs(t, err, &scraperErr)
+					assert.Equal(t, test.expectedFailed, scraperErr.Failed)
+				}
+				assert.Equal(t, 0, md.MetricCount())
+				return
+			}
+			require.NoError(t, err, "Failed to scrape metrics: %v", err)
+
+			assert.Equal(t, metricsLen, md.MetricCount())
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			internal.AssertSameTimeStampForAllMetrics(t, metrics)
+
+			for i := 0; i < metrics.Len(); i++ {
+				metric := metrics.At(i)
+				switch metric.Name() {
+				case "system.pressure.stall.time":
+					assertStallTime(t, metric, test.expectedStall)
+				case "system.pressure.stall.ratio":
+					assert.Equal(t, 3*len(test.expectedStall), metric.Gauge().DataPoints().Len())
+				default:
+					assert.Failf(t, "unexpected-metric", "metric %q is not expected", metric.Name())
+				}
+			}
+		})
+	}
+}
+
+func assertStallTime(t *testing.T, metric pmetric.Metric, expected map[string]int64) {
+	dps := metric.Sum().DataPoints()
+	assert.Equal(t, len(expected), dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		resource, _ := dp.Attributes().Get("resource")
+		scope, _ := dp.Attributes().Get("scope")
+		assert.Equal(t, expected[resource.Str()+"/"+scope.Str()], dp.IntValue())
+		assert.Equal(t, pcommon.Timestamp(100*1e9), dp.StartTimestamp())
+	}
+}
+
+func TestReadPressureFile(t *testing.T) {
+	lines, err := readPressureFile("testdata/proc/pressure/io")
+	require.NoError(t, err)
+	assert.Equal(t, []pressureLine{
+		{scope: metadata.AttributeScopeSome, avg10: 10, avg60: 5, avg300: 2.5, total: 987654},
+		{scope: metadata.AttributeScopeFull, avg10: 8, avg60: 4, avg300: 2, total: 876543},
+	}, lines)
+}
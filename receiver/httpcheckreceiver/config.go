@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -28,7 +29,9 @@ import (
 
 // Predefined error responses for configuration validation failures
 var (
-	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errInvalidEndpoint       = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+	errInvalidExpectedStatus = errors.New(`"expected_status" must only contain status codes between 100 and 599`)
+	errInvalidExpectedBody   = errors.New(`"expected_body" must be a valid regular expression`)
 )
 
 const defaultEndpoint = "http://localhost:80"
@@ -39,17 +42,83 @@ type Config struct {
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
 	Method                                  string                   `mapstructure:"method"`
+
+	// Targets is the list of endpoints to check. When empty, the endpoint and method
+	// configured at the top level are checked instead.
+	Targets []*TargetConfig `mapstructure:"targets"`
+}
+
+// TargetConfig configures a single endpoint to check.
+type TargetConfig struct {
+	confighttp.HTTPClientSettings `mapstructure:",squash"`
+	Method                        string `mapstructure:"method"`
+	Body                          string `mapstructure:"body"`
+	// ExpectedStatus is the list of status codes considered successful. Any status code is accepted if empty.
+	ExpectedStatus []int `mapstructure:"expected_status"`
+	// ExpectedBody is a regular expression the response body must match.
+	ExpectedBody string `mapstructure:"expected_body"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
 func (cfg *Config) Validate() error {
 	var err error
 
-	_, parseErr := url.Parse(cfg.Endpoint)
-	if parseErr != nil {
-		wrappedErr := fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr)
-		err = multierr.Append(err, wrappedErr)
+	if len(cfg.Targets) == 0 {
+		err = multierr.Append(err, validateEndpoint(cfg.Endpoint))
+	}
+
+	for _, target := range cfg.Targets {
+		err = multierr.Append(err, target.validate())
 	}
 
 	return err
 }
+
+func (t *TargetConfig) validate() error {
+	err := validateEndpoint(t.Endpoint)
+
+	for _, status := range t.ExpectedStatus {
+		if status < 100 || status > 599 {
+			err = multierr.Append(err, errInvalidExpectedStatus)
+			break
+		}
+	}
+
+	if _, regexErr := regexp.Compile(t.ExpectedBody); regexErr != nil {
+		err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidExpectedBody.Error(), regexErr))
+	}
+
+	return err
+}
+
+func validateEndpoint(endpoint string) error {
+	_, parseErr := url.Parse(endpoint)
+	if parseErr != nil {
+		return fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr)
+	}
+	return nil
+}
+
+// targets returns the targets to check. The top level endpoint and method are used when no targets are configured.
+// Targets without a method or timeout inherit the top level ones.
+func (cfg *Config) targets() []*TargetConfig {
+	if len(cfg.Targets) == 0 {
+		return []*TargetConfig{{
+			HTTPClientSettings: cfg.HTTPClientSettings,
+			Method:             cfg.Method,
+		}}
+	}
+
+	targets := make([]*TargetConfig, 0, len(cfg.Targets))
+	for _, target := range cfg.Targets {
+		t := *target
+		if t.Method == "" {
+			t.Method = cfg.Method
+		}
+		if t.Timeout == 0 {
+			t.Timeout = cfg.Timeout
+		}
+		targets = append(targets, &t)
+	}
+	return targets
+}
@@ -41,6 +41,42 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%s: %w", errInvalidEndpoint, errors.New(`parse "invalid://endpoint:  12efg": invalid port ":  12efg" after host`)),
 			),
 		},
+		{
+			desc: "invalid target",
+			cfg: &Config{
+				HTTPClientSettings: confighttp.HTTPClientSettings{
+					Endpoint: "invalid://endpoint:  12efg",
+				},
+				Targets: []*TargetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint: defaultEndpoint,
+						},
+						ExpectedStatus: []int{200, 700},
+						ExpectedBody:   "(",
+					},
+				},
+			},
+			expectedErr: multierr.Combine(
+				errInvalidExpectedStatus,
+				fmt.Errorf("%s: %w", errInvalidExpectedBody, errors.New("error parsing regexp: missing closing ): `(`")),
+			),
+		},
+		{
+			desc: "valid targets",
+			cfg: &Config{
+				Targets: []*TargetConfig{
+					{
+						HTTPClientSettings: confighttp.HTTPClientSettings{
+							Endpoint: "https://opentelemetry.io",
+						},
+						ExpectedStatus: []int{200},
+						ExpectedBody:   "OpenTelemetry",
+					},
+				},
+			},
+			expectedErr: nil,
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
@@ -33,9 +33,12 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for httpcheckreceiver metrics.
 type MetricsSettings struct {
-	HttpcheckDuration MetricSettings `mapstructure:"httpcheck.duration"`
-	HttpcheckError    MetricSettings `mapstructure:"httpcheck.error"`
-	HttpcheckStatus   MetricSettings `mapstructure:"httpcheck.status"`
+	HttpcheckDuration      MetricSettings `mapstructure:"httpcheck.duration"`
+	HttpcheckError         MetricSettings `mapstructure:"httpcheck.error"`
+	HttpcheckPhaseDuration MetricSettings `mapstructure:"httpcheck.phase.duration"`
+	HttpcheckStatus        MetricSettings `mapstructure:"httpcheck.status"`
+	HttpcheckTLSNotAfter   MetricSettings `mapstructure:"httpcheck.tls.not_after"`
+	HttpcheckTLSTimeLeft   MetricSettings `mapstructure:"httpcheck.tls.time_left"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -46,9 +49,18 @@ func DefaultMetricsSettings() MetricsSettings {
 		HttpcheckError: MetricSettings{
 			Enabled: true,
 		},
+		HttpcheckPhaseDuration: MetricSettings{
+			Enabled: true,
+		},
 		HttpcheckStatus: MetricSettings{
 			Enabled: true,
 		},
+		HttpcheckTLSNotAfter: MetricSettings{
+			Enabled: true,
+		},
+		HttpcheckTLSTimeLeft: MetricSettings{
+			Enabled: true,
+		},
 	}
 }
 
@@ -79,6 +91,40 @@ func DefaultResourceAttributesSettings() ResourceAttributesSettings {
 	return ResourceAttributesSettings{}
 }
 
+// AttributePhase specifies the a value phase attribute.
+type AttributePhase int
+
+const (
+	_ AttributePhase = iota
+	AttributePhaseDns
+	AttributePhaseConnect
+	AttributePhaseTls
+	AttributePhaseTtfb
+)
+
+// String returns the string representation of the AttributePhase.
+func (av AttributePhase) String() string {
+	switch av {
+	case AttributePhaseDns:
+		return "dns"
+	case AttributePhaseConnect:
+		return "connect"
+	case AttributePhaseTls:
+		return "tls"
+	case AttributePhaseTtfb:
+		return "ttfb"
+	}
+	return ""
+}
+
+// MapAttributePhase is a helper map of string to AttributePhase attribute value.
+var MapAttributePhase = map[string]AttributePhase{
+	"dns":     AttributePhaseDns,
+	"connect": AttributePhaseConnect,
+	"tls":     AttributePhaseTls,
+	"ttfb":    AttributePhaseTtfb,
+}
+
 type metricHttpcheckDuration struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -184,6 +230,58 @@ func newMetricHttpcheckError(settings MetricSettings) metricHttpcheckError {
 	return m
 }
 
+type metricHttpcheckPhaseDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.phase.duration metric with initial data.
+func (m *metricHttpcheckPhaseDuration) init() {
+	m.data.SetName("httpcheck.phase.duration")
+	m.data.SetDescription("Measures the duration of each phase of the HTTP check. The ttfb phase is the time from the start of the request to the first response byte.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckPhaseDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string, phaseAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+	dp.Attributes().PutStr("phase", phaseAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckPhaseDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckPhaseDuration) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckPhaseDuration(settings MetricSettings) metricHttpcheckPhaseDuration {
+	m := metricHttpcheckPhaseDuration{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHttpcheckStatus struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -240,18 +338,123 @@ func newMetricHttpcheckStatus(settings MetricSettings) metricHttpcheckStatus {
 	return m
 }
 
+type metricHttpcheckTLSNotAfter struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.tls.not_after metric with initial data.
+func (m *metricHttpcheckTLSNotAfter) init() {
+	m.data.SetName("httpcheck.tls.not_after")
+	m.data.SetDescription("The time after which the TLS certificate presented by the endpoint is no longer valid, as seconds since the Unix epoch.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTLSNotAfter) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTLSNotAfter) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTLSNotAfter) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTLSNotAfter(settings MetricSettings) metricHttpcheckTLSNotAfter {
+	m := metricHttpcheckTLSNotAfter{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTLSTimeLeft struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.tls.time_left metric with initial data.
+func (m *metricHttpcheckTLSTimeLeft) init() {
+	m.data.SetName("httpcheck.tls.time_left")
+	m.data.SetDescription("The time left until the TLS certificate presented by the endpoint expires.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTLSTimeLeft) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTLSTimeLeft) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTLSTimeLeft) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTLSTimeLeft(settings MetricSettings) metricHttpcheckTLSTimeLeft {
+	m := metricHttpcheckTLSTimeLeft{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                  pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity            int                 // maximum observed number of metrics per resource.
-	resourceCapacity           int                 // maximum observed number of resource attributes.
-	metricsBuffer              pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                  component.BuildInfo // contains version information
-	resourceAttributesSettings ResourceAttributesSettings
-	metricHttpcheckDuration    metricHttpcheckDuration
-	metricHttpcheckError       metricHttpcheckError
-	metricHttpcheckStatus      metricHttpcheckStatus
+	startTime                    pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity              int                 // maximum observed number of metrics per resource.
+	resourceCapacity             int                 // maximum observed number of resource attributes.
+	metricsBuffer                pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                    component.BuildInfo // contains version information
+	resourceAttributesSettings   ResourceAttributesSettings
+	metricHttpcheckDuration      metricHttpcheckDuration
+	metricHttpcheckError         metricHttpcheckError
+	metricHttpcheckPhaseDuration metricHttpcheckPhaseDuration
+	metricHttpcheckStatus        metricHttpcheckStatus
+	metricHttpcheckTLSNotAfter   metricHttpcheckTLSNotAfter
+	metricHttpcheckTLSTimeLeft   metricHttpcheckTLSTimeLeft
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -273,13 +476,16 @@ func WithResourceAttributesSettings(ras ResourceAttributesSettings) metricBuilde
 
 func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                  pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:              pmetric.NewMetrics(),
-		buildInfo:                  settings.BuildInfo,
-		resourceAttributesSettings: DefaultResourceAttributesSettings(),
-		metricHttpcheckDuration:    newMetricHttpcheckDuration(ms.HttpcheckDuration),
-		metricHttpcheckError:       newMetricHttpcheckError(ms.HttpcheckError),
-		metricHttpcheckStatus:      newMetricHttpcheckStatus(ms.HttpcheckStatus),
+		startTime:                    pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                pmetric.NewMetrics(),
+		buildInfo:                    settings.BuildInfo,
+		resourceAttributesSettings:   DefaultResourceAttributesSettings(),
+		metricHttpcheckDuration:      newMetricHttpcheckDuration(ms.HttpcheckDuration),
+		metricHttpcheckError:         newMetricHttpcheckError(ms.HttpcheckError),
+		metricHttpcheckPhaseDuration: newMetricHttpcheckPhaseDuration(ms.HttpcheckPhaseDuration),
+		metricHttpcheckStatus:        newMetricHttpcheckStatus(ms.HttpcheckStatus),
+		metricHttpcheckTLSNotAfter:   newMetricHttpcheckTLSNotAfter(ms.HttpcheckTLSNotAfter),
+		metricHttpcheckTLSTimeLeft:   newMetricHttpcheckTLSTimeLeft(ms.HttpcheckTLSTimeLeft),
 	}
 	for _, op := range options {
 		op(mb)
@@ -334,7 +540,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricHttpcheckDuration.emit(ils.Metrics())
 	mb.metricHttpcheckError.emit(ils.Metrics())
+	mb.metricHttpcheckPhaseDuration.emit(ils.Metrics())
 	mb.metricHttpcheckStatus.emit(ils.Metrics())
+	mb.metricHttpcheckTLSNotAfter.emit(ils.Metrics())
+	mb.metricHttpcheckTLSTimeLeft.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(mb.resourceAttributesSettings, rm)
@@ -365,11 +574,26 @@ func (mb *MetricsBuilder) RecordHttpcheckErrorDataPoint(ts pcommon.Timestamp, va
 	mb.metricHttpcheckError.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, errorMessageAttributeValue)
 }
 
+// RecordHttpcheckPhaseDurationDataPoint adds a data point to httpcheck.phase.duration metric.
+func (mb *MetricsBuilder) RecordHttpcheckPhaseDurationDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, phaseAttributeValue AttributePhase) {
+	mb.metricHttpcheckPhaseDuration.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, phaseAttributeValue.String())
+}
+
 // RecordHttpcheckStatusDataPoint adds a data point to httpcheck.status metric.
 func (mb *MetricsBuilder) RecordHttpcheckStatusDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string, httpStatusCodeAttributeValue int64, httpMethodAttributeValue string, httpStatusClassAttributeValue string) {
 	mb.metricHttpcheckStatus.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpStatusCodeAttributeValue, httpMethodAttributeValue, httpStatusClassAttributeValue)
 }
 
+// RecordHttpcheckTLSNotAfterDataPoint adds a data point to httpcheck.tls.not_after metric.
+func (mb *MetricsBuilder) RecordHttpcheckTLSNotAfterDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
+	mb.metricHttpcheckTLSNotAfter.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
+}
+
+// RecordHttpcheckTLSTimeLeftDataPoint adds a data point to httpcheck.tls.time_left metric.
+func (mb *MetricsBuilder) RecordHttpcheckTLSTimeLeftDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
+	mb.metricHttpcheckTLSTimeLeft.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -66,10 +66,22 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHttpcheckErrorDataPoint(ts, 1, "attr-val", "attr-val")
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckPhaseDurationDataPoint(ts, 1, "attr-val", AttributePhase(1))
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordHttpcheckStatusDataPoint(ts, 1, "attr-val", 1, "attr-val", "attr-val")
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTLSNotAfterDataPoint(ts, 1, "attr-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTLSTimeLeftDataPoint(ts, 1, "attr-val")
+
 			metrics := mb.Emit()
 
 			if test.metricsSet == testMetricsSetNo {
@@ -130,6 +142,24 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("error.message")
 					assert.True(t, ok)
 					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "httpcheck.phase.duration":
+					assert.False(t, validatedMetrics["httpcheck.phase.duration"], "Found a duplicate in the metrics slice: httpcheck.phase.duration")
+					validatedMetrics["httpcheck.phase.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Measures the duration of each phase of the HTTP check. The ttfb phase is the time from the start of the request to the first response byte.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("http.url")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("phase")
+					assert.True(t, ok)
+					assert.Equal(t, "dns", attrVal.Str())
 				case "httpcheck.status":
 					assert.False(t, validatedMetrics["httpcheck.status"], "Found a duplicate in the metrics slice: httpcheck.status")
 					validatedMetrics["httpcheck.status"] = true
@@ -156,6 +186,36 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("http.status_class")
 					assert.True(t, ok)
 					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "httpcheck.tls.not_after":
+					assert.False(t, validatedMetrics["httpcheck.tls.not_after"], "Found a duplicate in the metrics slice: httpcheck.tls.not_after")
+					validatedMetrics["httpcheck.tls.not_after"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time after which the TLS certificate presented by the endpoint is no longer valid, as seconds since the Unix epoch.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("http.url")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
+				case "httpcheck.tls.time_left":
+					assert.False(t, validatedMetrics["httpcheck.tls.time_left"], "Found a duplicate in the metrics slice: httpcheck.tls.time_left")
+					validatedMetrics["httpcheck.tls.time_left"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time left until the TLS certificate presented by the endpoint expires.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("http.url")
+					assert.True(t, ok)
+					assert.EqualValues(t, "attr-val", attrVal.Str())
 				}
 			}
 		})
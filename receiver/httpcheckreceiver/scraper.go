@@ -16,8 +16,15 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptrace"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -28,58 +35,203 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
 )
 
+// maxBodySize is the maximum number of bytes of the response body matched against the expected body.
+const maxBodySize = 1024 * 1024
+
 var (
 	errClientNotInit    = errors.New("client not initialized")
 	httpResponseClasses = map[string]int{"1xx": 1, "2xx": 2, "3xx": 3, "4xx": 4, "5xx": 5}
 )
 
 type httpcheckScraper struct {
-	client   *http.Client
+	checks   []*check
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+	// mu guards mb, which is recorded to from a goroutine per check
+	mu sync.Mutex
+}
+
+// check is a target with its client and compiled expected body
+type check struct {
+	target       *TargetConfig
+	client       *http.Client
+	expectedBody *regexp.Regexp
 }
 
-// start starts the scraper by creating a new HTTP Client on the scraper
-func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err error) {
-	h.client, err = h.cfg.ToClient(host, h.settings)
-	return
+func (h *httpcheckScraper) start(ctx context.Context, host component.Host) error {
+	for _, target := range h.cfg.targets() {
+		client, err := target.ToClient(host, h.settings)
+		if err != nil {
+			return err
+		}
+		c := &check{target: target, client: client}
+		if target.ExpectedBody != "" {
+			if c.expectedBody, err = regexp.Compile(target.ExpectedBody); err != nil {
+				return err
+			}
+		}
+		h.checks = append(h.checks, c)
+	}
+	return nil
 }
 
-// scrape connects to the endpoint and produces metrics based on the response
 func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	if h.client == nil {
+	if len(h.checks) == 0 {
 		return pmetric.NewMetrics(), errClientNotInit
 	}
 
+	wg := &sync.WaitGroup{}
+	for _, c := range h.checks {
+		wg.Add(1)
+		go func(c *check) {
+			defer wg.Done()
+			h.runCheck(ctx, c)
+		}(c)
+	}
+	wg.Wait()
+
+	return h.mb.Emit(), nil
+}
+
+func (h *httpcheckScraper) runCheck(ctx context.Context, c *check) {
 	now := pcommon.NewTimestampFromTime(time.Now())
+	endpoint := c.target.Endpoint
 
-	req, err := http.NewRequestWithContext(ctx, h.cfg.Method, h.cfg.Endpoint, http.NoBody)
+	var body io.Reader = http.NoBody
+	if c.target.Body != "" {
+		body = strings.NewReader(c.target.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, c.target.Method, endpoint, body)
 	if err != nil {
-		return pmetric.Metrics{}, err
+		h.mu.Lock()
+		h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), endpoint, err.Error())
+		h.mu.Unlock()
+		return
 	}
 
+	timings := &phaseTimings{}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), timings.clientTrace()))
+
 	start := time.Now()
-	resp, err := h.client.Do(req)
-	h.mb.RecordHttpcheckDurationDataPoint(now, time.Since(start).Milliseconds(), h.cfg.Endpoint)
+	resp, err := c.client.Do(req)
+	duration := time.Since(start)
+
+	var respBody []byte
+	var readErr error
+	if err == nil {
+		if c.expectedBody != nil {
+			respBody, readErr = io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+		}
+		// drain the body so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.mb.RecordHttpcheckDurationDataPoint(now, duration.Milliseconds(), endpoint)
+	timings.record(h.mb, now, start, endpoint)
 
 	statusCode := 0
 	if err != nil {
-		h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), h.cfg.Endpoint, err.Error())
+		h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), endpoint, err.Error())
 	} else {
 		statusCode = resp.StatusCode
+		h.recordTLS(now, resp.TLS, endpoint)
+		if expectErr := c.checkExpectations(statusCode, respBody, readErr); expectErr != nil {
+			h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), endpoint, expectErr.Error())
+		}
 	}
 
 	for class, intVal := range httpResponseClasses {
 		if statusCode/100 == intVal {
-			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), h.cfg.Endpoint, int64(statusCode), req.Method, class)
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), endpoint, int64(statusCode), req.Method, class)
 		} else {
-			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), h.cfg.Endpoint, int64(statusCode), req.Method, class)
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), endpoint, int64(statusCode), req.Method, class)
 		}
+	}
+}
 
+// checkExpectations returns an error if the response does not have one of the expected status codes or does not match the expected body.
+func (c *check) checkExpectations(statusCode int, body []byte, readErr error) error {
+	if len(c.target.ExpectedStatus) > 0 {
+		expected := false
+		for _, status := range c.target.ExpectedStatus {
+			if status == statusCode {
+				expected = true
+				break
+			}
+		}
+		if !expected {
+			return fmt.Errorf("unexpected status code %d", statusCode)
+		}
 	}
 
-	return h.mb.Emit(), nil
+	if c.expectedBody != nil {
+		if readErr != nil {
+			return fmt.Errorf("failed to read response body: %w", readErr)
+		}
+		if !c.expectedBody.Match(body) {
+			return fmt.Errorf("response body does not match %q", c.expectedBody.String())
+		}
+	}
+	return nil
+}
+
+func (h *httpcheckScraper) recordTLS(now pcommon.Timestamp, state *tls.ConnectionState, endpoint string) {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return
+	}
+	notAfter := state.PeerCertificates[0].NotAfter
+	h.mb.RecordHttpcheckTLSNotAfterDataPoint(now, notAfter.Unix(), endpoint)
+	h.mb.RecordHttpcheckTLSTimeLeftDataPoint(now, int64(time.Until(notAfter).Seconds()), endpoint)
+}
+
+// phaseTimings collects the start and end of each phase of a request from httptrace hooks.
+type phaseTimings struct {
+	mu                       sync.Mutex
+	dnsStart, dnsDone        time.Time
+	connectStart, connectEnd time.Time
+	tlsStart, tlsDone        time.Time
+	firstByte                time.Time
+}
+
+func (p *phaseTimings) set(t *time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	*t = time.Now()
+}
+
+func (p *phaseTimings) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart:             func(httptrace.DNSStartInfo) { p.set(&p.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { p.set(&p.dnsDone) },
+		ConnectStart:         func(string, string) { p.set(&p.connectStart) },
+		ConnectDone:          func(string, string, error) { p.set(&p.connectEnd) },
+		TLSHandshakeStart:    func() { p.set(&p.tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { p.set(&p.tlsDone) },
+		GotFirstResponseByte: func() { p.set(&p.firstByte) },
+	}
+}
+
+// record records the duration of each phase that completed. Phases that did not happen,
+// such as dns, connect and tls when a connection is reused, are not recorded.
+func (p *phaseTimings) record(mb *metadata.MetricsBuilder, now pcommon.Timestamp, start time.Time, endpoint string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	recordPhase := func(from, to time.Time, phase metadata.AttributePhase) {
+		if from.IsZero() || to.IsZero() {
+			return
+		}
+		mb.RecordHttpcheckPhaseDurationDataPoint(now, to.Sub(from).Milliseconds(), endpoint, phase)
+	}
+	recordPhase(p.dnsStart, p.dnsDone, metadata.AttributePhaseDns)
+	recordPhase(p.connectStart, p.connectEnd, metadata.AttributePhaseConnect)
+	recordPhase(p.tlsStart, p.tlsDone, metadata.AttributePhaseTls)
+	recordPhase(start, p.firstByte, metadata.AttributePhaseTtfb)
 }
 
 func newScraper(conf *Config, settings receiver.CreateSettings) *httpcheckScraper {
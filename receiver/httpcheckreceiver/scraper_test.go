@@ -16,14 +16,17 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
@@ -160,6 +163,8 @@ func TestScaperScrape(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
 			cfg := createDefaultConfig().(*Config)
+			// phase durations depend on the network and are covered by TestScraperPhasesAndTLS
+			cfg.Metrics.HttpcheckPhaseDuration.Enabled = false
 			if len(tc.endpoint) > 0 {
 				cfg.Endpoint = tc.endpoint
 			} else {
@@ -184,6 +189,113 @@ func TestScaperScrape(t *testing.T) {
 	}
 }
 
+func TestScraperPhasesAndTLS(t *testing.T) {
+	ms := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ms.URL
+	cfg.TLSSetting.InsecureSkipVerify = true
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := metricsByName(actualMetrics)
+
+	phases := map[string]bool{}
+	dps := metrics["httpcheck.phase.duration"].Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		phase, ok := dps.At(i).Attributes().Get("phase")
+		require.True(t, ok)
+		phases[phase.Str()] = true
+	}
+	require.Equal(t, map[string]bool{"connect": true, "tls": true, "ttfb": true}, phases)
+
+	notAfter := ms.Certificate().NotAfter
+	require.Equal(t, notAfter.Unix(), metrics["httpcheck.tls.not_after"].Gauge().DataPoints().At(0).IntValue())
+	timeLeft := metrics["httpcheck.tls.time_left"].Gauge().DataPoints().At(0).IntValue()
+	require.InDelta(t, time.Until(notAfter).Seconds(), float64(timeLeft), 60)
+}
+
+func TestScraperTargets(t *testing.T) {
+	var gotHeader, gotBody, gotMethod string
+	ms := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/ok":
+			gotMethod = req.Method
+			gotHeader = req.Header.Get("X-Test")
+			body, _ := io.ReadAll(req.Body)
+			gotBody = string(body)
+			_, _ = rw.Write([]byte(`{"status":"ok"}`))
+		case "/degraded":
+			_, _ = rw.Write([]byte(`{"status":"degraded"}`))
+		default:
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.HttpcheckPhaseDuration.Enabled = false
+	cfg.Targets = []*TargetConfig{
+		{
+			HTTPClientSettings: confighttp.HTTPClientSettings{
+				Endpoint: ms.URL + "/ok",
+				Headers:  map[string]configopaque.String{"X-Test": "value"},
+			},
+			Method:         http.MethodPost,
+			Body:           `{"ping":true}`,
+			ExpectedStatus: []int{200},
+			ExpectedBody:   `"status":"ok"`,
+		},
+		{
+			HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: ms.URL + "/degraded"},
+			ExpectedBody:       `"status":"ok"`,
+		},
+		{
+			HTTPClientSettings: confighttp.HTTPClientSettings{Endpoint: ms.URL + "/down"},
+			ExpectedStatus:     []int{200, 204},
+		},
+	}
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, http.MethodPost, gotMethod)
+	require.Equal(t, "value", gotHeader)
+	require.Equal(t, `{"ping":true}`, gotBody)
+
+	metrics := metricsByName(actualMetrics)
+	require.Equal(t, 3, metrics["httpcheck.duration"].Gauge().DataPoints().Len())
+
+	errs := map[string]string{}
+	dps := metrics["httpcheck.error"].Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		url, _ := dps.At(i).Attributes().Get("http.url")
+		msg, _ := dps.At(i).Attributes().Get("error.message")
+		errs[url.Str()] = msg.Str()
+	}
+	require.Equal(t, map[string]string{
+		ms.URL + "/degraded": `response body does not match "\"status\":\"ok\""`,
+		ms.URL + "/down":     "unexpected status code 503",
+	}, errs)
+}
+
+func metricsByName(md pmetric.Metrics) map[string]pmetric.Metric {
+	metrics := map[string]pmetric.Metric{}
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	return metrics
+}
+
 func TestNilClient(t *testing.T) {
 	scraper := newScraper(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings())
 	actualMetrics, err := scraper.scrape(context.Background())
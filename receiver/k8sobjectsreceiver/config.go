@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -59,6 +60,10 @@ type Config struct {
 
 	Objects []*K8sObjectsConfig `mapstructure:"objects"`
 
+	// StorageID is the ID of the storage extension used to persist the last observed
+	// resource version of watched objects, so that watches resume from it after a restart.
+	StorageID *component.ID `mapstructure:"storage"`
+
 	// For mocking purposes only.
 	makeDiscoveryClient func() (discovery.ServerResourcesInterface, error)
 	makeDynamicClient   func() (dynamic.Interface, error)
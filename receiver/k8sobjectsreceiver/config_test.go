@@ -75,6 +75,7 @@ func TestLoadConfig(t *testing.T) {
 		},
 	}
 	assert.EqualValues(t, expected, cfg.Objects)
+	assert.Equal(t, component.NewID("file_storage"), *cfg.StorageID)
 
 }
 
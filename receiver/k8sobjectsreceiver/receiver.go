@@ -16,21 +16,33 @@ package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	apiWatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/watch"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/storageclient"
 )
 
+// relistRetryInterval is how long to wait before retrying a failed relist.
+var relistRetryInterval = 5 * time.Second
+
 type k8sobjectsreceiver struct {
 	setting         receiver.CreateSettings
 	objects         []*K8sObjectsConfig
@@ -38,6 +50,8 @@ type k8sobjectsreceiver struct {
 	client          dynamic.Interface
 	consumer        consumer.Logs
 	obsrecv         *obsreport.Receiver
+	storageID       *component.ID
+	storageClient   storage.Client
 	mu              sync.Mutex
 }
 
@@ -58,18 +72,25 @@ func newReceiver(params receiver.CreateSettings, config *Config, consumer consum
 	}
 
 	return &k8sobjectsreceiver{
-		client:   client,
-		setting:  params,
-		consumer: consumer,
-		objects:  config.Objects,
-		obsrecv:  obsrecv,
-		mu:       sync.Mutex{},
+		client:    client,
+		setting:   params,
+		consumer:  consumer,
+		objects:   config.Objects,
+		obsrecv:   obsrecv,
+		storageID: config.StorageID,
+		mu:        sync.Mutex{},
 	}, nil
 }
 
 func (kr *k8sobjectsreceiver) Start(ctx context.Context, host component.Host) error {
 	kr.setting.Logger.Info("Object Receiver started")
 
+	storageClient, err := storageclient.Get(ctx, host, kr.storageID, kr.setting.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get storage client: %w", err)
+	}
+	kr.storageClient = storageClient
+
 	for _, object := range kr.objects {
 		kr.start(ctx, object)
 	}
@@ -83,6 +104,9 @@ func (kr *k8sobjectsreceiver) Shutdown(context.Context) error {
 		close(stopperChan)
 	}
 	kr.mu.Unlock()
+	if kr.storageClient != nil {
+		return kr.storageClient.Close(context.Background())
+	}
 	return nil
 }
 
@@ -102,10 +126,10 @@ func (kr *k8sobjectsreceiver) start(ctx context.Context, object *K8sObjectsConfi
 
 	case WatchMode:
 		if len(object.Namespaces) == 0 {
-			go kr.startWatch(ctx, object, resource)
+			go kr.startWatch(ctx, object, resource, "")
 		} else {
 			for _, ns := range object.Namespaces {
-				go kr.startWatch(ctx, object, resource.Namespace(ns))
+				go kr.startWatch(ctx, object, resource.Namespace(ns), ns)
 			}
 		}
 	}
@@ -141,45 +165,194 @@ func (kr *k8sobjectsreceiver) startPull(ctx context.Context, config *K8sObjectsC
 
 }
 
-func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface) {
+func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, namespace string) {
 
 	stopperChan := make(chan struct{})
 	kr.mu.Lock()
 	kr.stopperChanList = append(kr.stopperChanList, stopperChan)
 	kr.mu.Unlock()
 
+	key := resourceVersionKey(config, namespace)
+	// watermark is the last resource version observed, starting from the one stored
+	// before a restart, if any. It's also kept in memory, for when no storage is configured.
+	watermark := kr.loadResourceVersion(ctx, key, "")
+	resourceVersion := watermark
+	if resourceVersion == "" {
+		resourceVersion = config.ResourceVersion
+	}
+	// seen holds the resource version of the objects observed so far, so that
+	// a relist only emits the objects that changed since they were last observed.
+	// Objects observed before a restart are not in seen, so a relist skips the
+	// ones that have not changed since watermark.
+	seen := make(map[types.UID]string)
+
+	for {
+		if kr.doWatch(ctx, config, resource, resourceVersion, key, seen, &watermark, stopperChan) {
+			return
+		}
+		for {
+			var err error
+			resourceVersion, err = kr.relist(ctx, config, resource, key, watermark, seen)
+			if err == nil {
+				watermark = resourceVersion
+				break
+			}
+			kr.setting.Logger.Error("error in relisting object", zap.String("resource", config.gvr.String()), zap.Error(err))
+			select {
+			case <-time.After(relistRetryInterval):
+			case <-stopperChan:
+				return
+			}
+		}
+	}
+}
+
+// doWatch watches the resource starting at resourceVersion, and sets watermark to the
+// resource version of each event. It returns true when the receiver is stopped and
+// false when the objects need to be relisted.
+func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, resourceVersion string, key string, seen map[types.UID]string, watermark *string, stopperChan chan struct{}) bool {
 	watchFunc := func(options metav1.ListOptions) (apiWatch.Interface, error) {
-		return resource.Watch(ctx, metav1.ListOptions{
-			FieldSelector: config.FieldSelector,
-			LabelSelector: config.LabelSelector,
-		})
+		options.FieldSelector = config.FieldSelector
+		options.LabelSelector = config.LabelSelector
+		return resource.Watch(ctx, options)
 	}
 
-	watch, err := watch.NewRetryWatcher(config.ResourceVersion, &cache.ListWatch{WatchFunc: watchFunc})
+	watch, err := watch.NewRetryWatcher(resourceVersion, &cache.ListWatch{WatchFunc: watchFunc})
 	if err != nil {
-		kr.setting.Logger.Error("error in watching object", zap.String("resource", config.gvr.String()), zap.Error(err))
-		return
+		kr.setting.Logger.Warn("error in watching object, relisting", zap.String("resource", config.gvr.String()), zap.Error(err))
+		return false
 	}
+	defer watch.Stop()
 
 	res := watch.ResultChan()
 	for {
 		select {
 		case data, ok := <-res:
 			if !ok {
-				kr.setting.Logger.Warn("Watch channel closed unexpectedly", zap.String("resource", config.gvr.String()))
-				return
+				kr.setting.Logger.Warn("Watch channel closed unexpectedly, relisting", zap.String("resource", config.gvr.String()))
+				return false
+			}
+			switch data.Type {
+			case apiWatch.Error:
+				if status, ok := apierrors.FromObject(data.Object).(apierrors.APIStatus); ok && status.Status().Code == http.StatusGone {
+					kr.setting.Logger.Info("Resource version expired, relisting", zap.String("resource", config.gvr.String()), zap.String("resource_version", resourceVersion))
+					return false
+				}
+				kr.setting.Logger.Warn("error in watching object", zap.String("resource", config.gvr.String()), zap.Any("status", data.Object))
+				continue
+			case apiWatch.Bookmark:
+				if udata, ok := data.Object.(*unstructured.Unstructured); ok {
+					resourceVersion = udata.GetResourceVersion()
+					*watermark = resourceVersion
+					kr.storeResourceVersion(ctx, key, resourceVersion)
+				}
+				continue
 			}
+
+			udata, ok := data.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			if data.Type == apiWatch.Deleted {
+				delete(seen, udata.GetUID())
+			} else {
+				seen[udata.GetUID()] = udata.GetResourceVersion()
+			}
+
 			logs := watchObjectsToLogData(&data, time.Now(), config)
 
 			obsCtx := kr.obsrecv.StartLogsOp(ctx)
 			err := kr.consumer.ConsumeLogs(obsCtx, logs)
 			kr.obsrecv.EndLogsOp(obsCtx, typeStr, 1, err)
+
+			resourceVersion = udata.GetResourceVersion()
+			*watermark = resourceVersion
+			kr.storeResourceVersion(ctx, key, resourceVersion)
 		case <-stopperChan:
-			watch.Stop()
-			return
+			return true
 		}
 	}
+}
+
+// relist lists the objects of the resource and emits the ones not observed yet, or
+// modified since they were last observed, as watch events. Objects not in seen whose
+// resource version is not newer than watermark were already observed, by the watch or
+// before a restart, and are skipped. It returns the resource version to resume watching from.
+func (kr *k8sobjectsreceiver) relist(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, key string, watermark string, seen map[types.UID]string) (string, error) {
+	objects, err := resource.List(ctx, metav1.ListOptions{
+		FieldSelector: config.FieldSelector,
+		LabelSelector: config.LabelSelector,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i := range objects.Items {
+		udata := &objects.Items[i]
+		eventType := apiWatch.Added
+		if resourceVersion, ok := seen[udata.GetUID()]; ok {
+			if resourceVersion == udata.GetResourceVersion() {
+				continue
+			}
+			eventType = apiWatch.Modified
+		} else if !newerResourceVersion(udata.GetResourceVersion(), watermark) {
+			seen[udata.GetUID()] = udata.GetResourceVersion()
+			continue
+		}
+		seen[udata.GetUID()] = udata.GetResourceVersion()
+
+		logs := watchObjectsToLogData(&apiWatch.Event{Type: eventType, Object: udata}, time.Now(), config)
+		obsCtx := kr.obsrecv.StartLogsOp(ctx)
+		err = kr.consumer.ConsumeLogs(obsCtx, logs)
+		kr.obsrecv.EndLogsOp(obsCtx, typeStr, 1, err)
+	}
 
+	resourceVersion := objects.GetResourceVersion()
+	if resourceVersion == "" {
+		resourceVersion = defaultResourceVersion
+	}
+	kr.storeResourceVersion(ctx, key, resourceVersion)
+	return resourceVersion, nil
+}
+
+// newerResourceVersion reports whether resourceVersion is newer than watermark.
+// Resource versions are opaque to clients, so when either of them is not numeric
+// the object is considered newer and emitted again rather than dropped.
+func newerResourceVersion(resourceVersion string, watermark string) bool {
+	rv, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if err != nil {
+		return true
+	}
+	wm, err := strconv.ParseUint(watermark, 10, 64)
+	if err != nil {
+		return true
+	}
+	return rv > wm
+}
+
+// resourceVersionKey is the storage key of the last resource version observed
+// for the resource of config in namespace.
+func resourceVersionKey(config *K8sObjectsConfig, namespace string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", config.gvr.Group, config.gvr.Version, config.gvr.Resource, namespace)
+}
+
+// loadResourceVersion returns the stored resource version for key, or defaultVersion if there is none.
+func (kr *k8sobjectsreceiver) loadResourceVersion(ctx context.Context, key string, defaultVersion string) string {
+	data, err := kr.storageClient.Get(ctx, key)
+	if err != nil {
+		kr.setting.Logger.Info("unable to load resource version from storage client, continuing with the configured one", zap.String("key", key), zap.Error(err))
+		return defaultVersion
+	}
+	if len(data) == 0 {
+		return defaultVersion
+	}
+	return string(data)
+}
+
+func (kr *k8sobjectsreceiver) storeResourceVersion(ctx context.Context, key string, resourceVersion string) {
+	if err := kr.storageClient.Set(ctx, key, []byte(resourceVersion)); err != nil {
+		kr.setting.Logger.Warn("unable to store resource version", zap.String("key", key), zap.Error(err))
+	}
 }
 
 // Start ticking immediately.
@@ -16,14 +16,24 @@ package k8sobjectsreceiver
 
 import (
 	"context"
+	"net/http"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	apiWatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 )
 
 func TestNewReceiver(t *testing.T) {
@@ -150,3 +160,307 @@ func TestWatchObject(t *testing.T) {
 
 	assert.NoError(t, r.Shutdown(ctx))
 }
+
+func TestWatchObjectResumesFromStoredResourceVersion(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+	var mu sync.Mutex
+	var watchedVersions []string
+	mockClient.client.(*fake.FakeDynamicClient).PrependWatchReactor("pods", func(action k8stesting.Action) (bool, apiWatch.Interface, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		watchedVersions = append(watchedVersions, action.(k8stesting.WatchAction).GetWatchRestrictions().ResourceVersion)
+		return false, nil, nil
+	})
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+	storageID := storagetest.NewStorageID("k8sobjects")
+	rCfg.StorageID = &storageID
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+	require.NoError(t, rCfg.Validate())
+
+	settings := receivertest.NewNopCreateSettings()
+	ext := storagetest.NewFileBackedStorageExtension("k8sobjects", t.TempDir())
+	client, err := ext.GetClient(context.Background(), component.KindReceiver, settings.ID, "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(context.Background(), "/v1/pods/default", []byte("42")))
+	require.NoError(t, client.Close(context.Background()))
+
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(settings, rCfg, consumer)
+	require.NoError(t, err)
+	host := storagetest.NewStorageHost().WithExtension(storageID, ext)
+	require.NoError(t, r.Start(context.Background(), host))
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(watchedVersions) > 0
+	}, time.Second, 10*time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, "42", watchedVersions[0])
+	mu.Unlock()
+
+	pod := generatePod("pod1", "default", nil)
+	pod.SetResourceVersion("43")
+	mockClient.createPods(pod)
+	assert.Eventually(t, func() bool {
+		return consumer.Count() == 1
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	client, err = ext.GetClient(context.Background(), component.KindReceiver, settings.ID, "")
+	require.NoError(t, err)
+	stored, err := client.Get(context.Background(), "/v1/pods/default")
+	require.NoError(t, err)
+	assert.Equal(t, "43", string(stored))
+	require.NoError(t, client.Close(context.Background()))
+}
+
+func TestWatchObjectRelistsOnGone(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+	pod1 := generatePod("pod1", "default", nil)
+	pod1.SetUID("uid1")
+	pod2 := generatePod("pod2", "default", nil)
+	pod2.SetUID("uid2")
+	mockClient.createPods(pod1, pod2)
+
+	var once sync.Once
+	mockClient.client.(*fake.FakeDynamicClient).PrependWatchReactor("pods", func(action k8stesting.Action) (bool, apiWatch.Interface, error) {
+		handled := false
+		watcher := apiWatch.NewFake()
+		once.Do(func() {
+			handled = true
+			go watcher.Error(&metav1.Status{
+				Status: metav1.StatusFailure,
+				Code:   http.StatusGone,
+				Reason: metav1.StatusReasonGone,
+			})
+		})
+		return handled, watcher, nil
+	})
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+	require.NoError(t, rCfg.Validate())
+
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(receivertest.NewNopCreateSettings(), rCfg, consumer)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	assert.Eventually(t, func() bool {
+		return consumer.Count() == 2
+	}, time.Second, 10*time.Millisecond)
+
+	pod3 := generatePod("pod3", "default", nil)
+	pod3.SetUID("uid3")
+	mockClient.createPods(pod3)
+	assert.Eventually(t, func() bool {
+		return consumer.Count() == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestWatchObjectRelistAfterRestartSkipsObservedObjects(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+	pod1 := generatePod("pod1", "default", nil)
+	pod1.SetUID("uid1")
+	pod1.SetResourceVersion("40")
+	pod2 := generatePod("pod2", "default", nil)
+	pod2.SetUID("uid2")
+	pod2.SetResourceVersion("45")
+	mockClient.createPods(pod1, pod2)
+
+	var once sync.Once
+	mockClient.client.(*fake.FakeDynamicClient).PrependWatchReactor("pods", func(action k8stesting.Action) (bool, apiWatch.Interface, error) {
+		handled := false
+		watcher := apiWatch.NewFake()
+		once.Do(func() {
+			handled = true
+			go watcher.Error(&metav1.Status{
+				Status: metav1.StatusFailure,
+				Code:   http.StatusGone,
+				Reason: metav1.StatusReasonGone,
+			})
+		})
+		return handled, watcher, nil
+	})
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+	storageID := storagetest.NewStorageID("k8sobjects")
+	rCfg.StorageID = &storageID
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+	require.NoError(t, rCfg.Validate())
+
+	settings := receivertest.NewNopCreateSettings()
+	ext := storagetest.NewFileBackedStorageExtension("k8sobjects", t.TempDir())
+	client, err := ext.GetClient(context.Background(), component.KindReceiver, settings.ID, "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(context.Background(), "/v1/pods/default", []byte("42")))
+	require.NoError(t, client.Close(context.Background()))
+
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(settings, rCfg, consumer)
+	require.NoError(t, err)
+	host := storagetest.NewStorageHost().WithExtension(storageID, ext)
+	require.NoError(t, r.Start(context.Background(), host))
+
+	assert.Eventually(t, func() bool {
+		return consumer.Count() == 1
+	}, time.Second, 10*time.Millisecond)
+	logs := consumer.Logs()
+	body := logs[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Map().AsRaw()
+	object := body["object"].(map[string]interface{})
+	assert.Equal(t, "pod2", object["metadata"].(map[string]interface{})["name"])
+
+	pod3 := generatePod("pod3", "default", nil)
+	pod3.SetUID("uid3")
+	pod3.SetResourceVersion("46")
+	mockClient.createPods(pod3)
+	assert.Eventually(t, func() bool {
+		return consumer.Count() == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestWatchObjectRelistWithoutStorageSkipsObservedObjects(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+	pod1 := generatePod("pod1", "default", nil)
+	pod1.SetUID("uid1")
+	pod1.SetResourceVersion("5")
+	pod2 := generatePod("pod2", "default", nil)
+	pod2.SetUID("uid2")
+	pod2.SetResourceVersion("10")
+	mockClient.createPods(pod1, pod2)
+
+	var mu sync.Mutex
+	watches := 0
+	mockClient.client.(*fake.FakeDynamicClient).PrependWatchReactor("pods", func(action k8stesting.Action) (bool, apiWatch.Interface, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		watches++
+		if watches > 1 {
+			return false, nil, nil
+		}
+		watcher := apiWatch.NewFake()
+		go func() {
+			watcher.Modify(pod2)
+			watcher.Error(&metav1.Status{
+				Status: metav1.StatusFailure,
+				Code:   http.StatusGone,
+				Reason: metav1.StatusReasonGone,
+			})
+		}()
+		return true, watcher, nil
+	})
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+	require.NoError(t, rCfg.Validate())
+
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(receivertest.NewNopCreateSettings(), rCfg, consumer)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	// the relist resumes the watch once done
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return watches > 1
+	}, time.Second, 10*time.Millisecond)
+	// pod1 was last modified before the resource version observed by the watch
+	assert.Equal(t, 1, consumer.Count())
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestRelistDeduplicatesObjects(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+	pod1 := generatePod("pod1", "default", nil)
+	pod1.SetUID("uid1")
+	pod2 := generatePod("pod2", "default", nil)
+	pod2.SetUID("uid2")
+	mockClient.createPods(pod1, pod2)
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name: "pods",
+			Mode: WatchMode,
+		},
+	}
+	require.NoError(t, rCfg.Validate())
+
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(receivertest.NewNopCreateSettings(), rCfg, consumer)
+	require.NoError(t, err)
+	kr := r.(*k8sobjectsreceiver)
+	kr.storageClient = storagetest.NewInMemoryClient(component.KindReceiver, kr.setting.ID, "")
+
+	object := rCfg.Objects[0]
+	resource := kr.client.Resource(*object.gvr).Namespace("default")
+	seen := map[types.UID]string{}
+
+	resourceVersion, err := kr.relist(context.Background(), object, resource, "key", "", seen)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resourceVersion)
+	assert.Equal(t, 2, consumer.Count())
+
+	_, err = kr.relist(context.Background(), object, resource, "key", "", seen)
+	require.NoError(t, err)
+	assert.Equal(t, 2, consumer.Count())
+
+	pod1.SetResourceVersion("2")
+	_, err = resource.Update(context.Background(), pod1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	_, err = kr.relist(context.Background(), object, resource, "key", "", seen)
+	require.NoError(t, err)
+	require.Equal(t, 3, consumer.Count())
+	logs := consumer.Logs()
+	body := logs[len(logs)-1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Map().AsRaw()
+	assert.Equal(t, string(apiWatch.Modified), body["type"])
+}
@@ -15,6 +15,9 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -42,6 +45,17 @@ type MessageMarking struct {
 	OnError bool `mapstructure:"on_error"`
 }
 
+// HeaderExtraction controls how Kafka record headers are propagated downstream.
+type HeaderExtraction struct {
+	// Whether or not to copy record headers into the received data (default false).
+	ExtractHeaders bool `mapstructure:"extract_headers"`
+	// The record header keys to extract. If empty, all headers are extracted.
+	Headers []string `mapstructure:"headers"`
+	// Whether or not to also add the extracted headers to the client metadata
+	// of the context passed to the next consumer (default false).
+	IncludeMetadata bool `mapstructure:"include_metadata"`
+}
+
 // Config defines configuration for Kafka receiver.
 type Config struct {
 	// The list of kafka brokers (default localhost:9092)
@@ -50,8 +64,15 @@ type Config struct {
 	ProtocolVersion string `mapstructure:"protocol_version"`
 	// The name of the kafka topic to consume from (default "otlp_spans")
 	Topic string `mapstructure:"topic"`
+	// The list of kafka topics to consume from. Takes precedence over Topic.
+	Topics []string `mapstructure:"topics"`
+	// A regular expression matching the kafka topics to consume from.
+	// Cannot be combined with Topics.
+	TopicPattern string `mapstructure:"topic_pattern"`
 	// Encoding of the messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
+	// Encoding of the messages per topic, overriding Encoding for the listed topics.
+	TopicEncodings map[string]string `mapstructure:"topic_encodings"`
 	// The consumer group that receiver will be consuming messages from (default "otel-collector")
 	GroupID string `mapstructure:"group_id"`
 	// The consumer client ID that receiver will use (default "otel-collector")
@@ -68,11 +89,38 @@ type Config struct {
 
 	// Controls the way the messages are marked as consumed
 	MessageMarking MessageMarking `mapstructure:"message_marking"`
+
+	// Controls the propagation of record headers
+	HeaderExtraction HeaderExtraction `mapstructure:"header_extraction"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.TopicPattern != "" {
+		if len(cfg.Topics) > 0 {
+			return errors.New("topics and topic_pattern cannot be used together")
+		}
+		if _, err := regexp.Compile(cfg.TopicPattern); err != nil {
+			return fmt.Errorf("invalid topic_pattern: %w", err)
+		}
+	}
+	for _, topic := range cfg.Topics {
+		if topic == "" {
+			return errors.New("topics must not contain empty topic names")
+		}
+	}
+	if cfg.HeaderExtraction.IncludeMetadata && !cfg.HeaderExtraction.ExtractHeaders {
+		return errors.New("header_extraction.include_metadata requires header_extraction.extract_headers")
+	}
 	return nil
 }
+
+// subscribedTopics returns the statically configured topics to consume from.
+func (cfg *Config) subscribedTopics() []string {
+	if len(cfg.Topics) > 0 {
+		return cfg.Topics
+	}
+	return []string{cfg.Topic}
+}
@@ -100,6 +100,56 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "multi"),
+			expected: &Config{
+				Topic:          "otlp_spans",
+				Topics:         []string{"spans", "zipkin_spans"},
+				TopicEncodings: map[string]string{"zipkin_spans": "zipkin_json"},
+				Encoding:       "otlp_proto",
+				Brokers:        []string{"foo:123"},
+				ClientID:       "otel-collector",
+				GroupID:        "otel-collector",
+				Metadata: kafkaexporter.Metadata{
+					Full: true,
+					Retry: kafkaexporter.MetadataRetry{
+						Max:     3,
+						Backoff: time.Millisecond * 250,
+					},
+				},
+				AutoCommit: AutoCommit{
+					Enable:   true,
+					Interval: 1 * time.Second,
+				},
+				HeaderExtraction: HeaderExtraction{
+					ExtractHeaders:  true,
+					Headers:         []string{"tenant"},
+					IncludeMetadata: true,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(typeStr, "pattern"),
+			expected: &Config{
+				Topic:        "otlp_spans",
+				TopicPattern: "^tenant-.*-spans$",
+				Encoding:     "otlp_proto",
+				Brokers:      []string{"foo:123"},
+				ClientID:     "otel-collector",
+				GroupID:      "otel-collector",
+				Metadata: kafkaexporter.Metadata{
+					Full: true,
+					Retry: kafkaexporter.MetadataRetry{
+						Max:     3,
+						Backoff: time.Millisecond * 250,
+					},
+				},
+				AutoCommit: AutoCommit{
+					Enable:   true,
+					Interval: 1 * time.Second,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -116,3 +166,55 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *Config
+		errMsg string
+	}{
+		{
+			name: "valid topics",
+			cfg:  &Config{Topics: []string{"a", "b"}},
+		},
+		{
+			name: "valid topic pattern",
+			cfg:  &Config{TopicPattern: "^otlp_.*"},
+		},
+		{
+			name:   "topics and topic pattern",
+			cfg:    &Config{Topics: []string{"a"}, TopicPattern: "^otlp_.*"},
+			errMsg: "topics and topic_pattern cannot be used together",
+		},
+		{
+			name:   "invalid topic pattern",
+			cfg:    &Config{TopicPattern: "("},
+			errMsg: "invalid topic_pattern",
+		},
+		{
+			name:   "empty topic",
+			cfg:    &Config{Topics: []string{"a", ""}},
+			errMsg: "topics must not contain empty topic names",
+		},
+		{
+			name:   "metadata without extraction",
+			cfg:    &Config{HeaderExtraction: HeaderExtraction{IncludeMetadata: true}},
+			errMsg: "header_extraction.include_metadata requires header_extraction.extract_headers",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestSubscribedTopics(t *testing.T) {
+	assert.Equal(t, []string{"spans"}, (&Config{Topic: "spans"}).subscribedTopics())
+	assert.Equal(t, []string{"a", "b"}, (&Config{Topic: "spans", Topics: []string{"a", "b"}}).subscribedTopics())
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// headerAttributePrefix is prepended to the header key to form the resource attribute name.
+const headerAttributePrefix = "kafka.header."
+
+// headerExtractor copies Kafka record headers into resource attributes and client metadata.
+type headerExtractor struct {
+	enabled         bool
+	headers         map[string]struct{}
+	includeMetadata bool
+}
+
+func newHeaderExtractor(cfg HeaderExtraction) headerExtractor {
+	h := headerExtractor{
+		enabled:         cfg.ExtractHeaders,
+		includeMetadata: cfg.IncludeMetadata,
+	}
+	if len(cfg.Headers) > 0 {
+		h.headers = make(map[string]struct{}, len(cfg.Headers))
+		for _, key := range cfg.Headers {
+			h.headers[key] = struct{}{}
+		}
+	}
+	return h
+}
+
+// extract returns the values of the selected headers of message, in record order.
+func (h headerExtractor) extract(message *sarama.ConsumerMessage) map[string][]string {
+	if !h.enabled || len(message.Headers) == 0 {
+		return nil
+	}
+	values := make(map[string][]string)
+	for _, header := range message.Headers {
+		if header == nil {
+			continue
+		}
+		key := string(header.Key)
+		if h.headers != nil {
+			if _, ok := h.headers[key]; !ok {
+				continue
+			}
+		}
+		values[key] = append(values[key], string(header.Value))
+	}
+	return values
+}
+
+// contextWithMetadata returns ctx with the header values added to its client metadata.
+func (h headerExtractor) contextWithMetadata(ctx context.Context, values map[string][]string) context.Context {
+	if !h.includeMetadata || len(values) == 0 {
+		return ctx
+	}
+	info := client.FromContext(ctx)
+	info.Metadata = client.NewMetadata(values)
+	return client.NewContext(ctx, info)
+}
+
+// putHeaderAttributes sets the first value of every header as a resource attribute.
+func putHeaderAttributes(attrs pcommon.Map, values map[string][]string) {
+	for key, value := range values {
+		attrs.PutStr(headerAttributePrefix+key, value[0])
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestHeaderExtractor(t *testing.T) {
+	message := &sarama.ConsumerMessage{
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("tenant"), Value: []byte("acme")},
+			{Key: []byte("tenant"), Value: []byte("other")},
+			{Key: []byte("region"), Value: []byte("eu")},
+			nil,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      HeaderExtraction
+		expected map[string][]string
+	}{
+		{
+			name: "disabled",
+			cfg:  HeaderExtraction{Headers: []string{"tenant"}},
+		},
+		{
+			name: "all headers",
+			cfg:  HeaderExtraction{ExtractHeaders: true},
+			expected: map[string][]string{
+				"tenant": {"acme", "other"},
+				"region": {"eu"},
+			},
+		},
+		{
+			name: "selected headers",
+			cfg:  HeaderExtraction{ExtractHeaders: true, Headers: []string{"region", "missing"}},
+			expected: map[string][]string{
+				"region": {"eu"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := newHeaderExtractor(tt.cfg).extract(message)
+			if tt.expected == nil {
+				assert.Empty(t, values)
+				return
+			}
+			assert.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestHeaderExtractorContextWithMetadata(t *testing.T) {
+	values := map[string][]string{"tenant": {"acme", "other"}}
+
+	ctx := newHeaderExtractor(HeaderExtraction{ExtractHeaders: true}).contextWithMetadata(context.Background(), values)
+	assert.Empty(t, client.FromContext(ctx).Metadata.Get("tenant"))
+
+	h := newHeaderExtractor(HeaderExtraction{ExtractHeaders: true, IncludeMetadata: true})
+	ctx = h.contextWithMetadata(client.NewContext(context.Background(), client.Info{}), values)
+	assert.Equal(t, []string{"acme", "other"}, client.FromContext(ctx).Metadata.Get("tenant"))
+}
+
+func TestPutHeaderAttributes(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("service.name", "svc")
+	putHeaderAttributes(attrs, map[string][]string{"tenant": {"acme", "other"}})
+	assert.Equal(t, map[string]interface{}{
+		"service.name":        "svc",
+		"kafka.header.tenant": "acme",
+	}, attrs.AsRaw())
+}
@@ -27,8 +27,6 @@ import (
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
-
-	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
 )
 
 const (
@@ -41,9 +39,11 @@ var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 type kafkaTracesConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Traces
-	topics            []string
+	subscription      subscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       TracesUnmarshaler
+	topicUnmarshalers map[string]TracesUnmarshaler
+	headerExtractor   headerExtractor
 
 	settings receiver.CreateSettings
 
@@ -55,9 +55,11 @@ type kafkaTracesConsumer struct {
 type kafkaMetricsConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Metrics
-	topics            []string
+	subscription      subscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       MetricsUnmarshaler
+	topicUnmarshalers map[string]MetricsUnmarshaler
+	headerExtractor   headerExtractor
 
 	settings receiver.CreateSettings
 
@@ -69,9 +71,11 @@ type kafkaMetricsConsumer struct {
 type kafkaLogsConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Logs
-	topics            []string
+	subscription      subscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       LogsUnmarshaler
+	topicUnmarshalers map[string]LogsUnmarshaler
+	headerExtractor   headerExtractor
 
 	settings receiver.CreateSettings
 
@@ -88,33 +92,24 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshalers
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
-
-	c := sarama.NewConfig()
-	c.ClientID = config.ClientID
-	c.Metadata.Full = config.Metadata.Full
-	c.Metadata.Retry.Max = config.Metadata.Retry.Max
-	c.Metadata.Retry.Backoff = config.Metadata.Retry.Backoff
-	c.Consumer.Offsets.AutoCommit.Enable = config.AutoCommit.Enable
-	c.Consumer.Offsets.AutoCommit.Interval = config.AutoCommit.Interval
-	if config.ProtocolVersion != "" {
-		version, err := sarama.ParseKafkaVersion(config.ProtocolVersion)
-		if err != nil {
-			return nil, err
+	topicUnmarshalers := make(map[string]TracesUnmarshaler, len(config.TopicEncodings))
+	for topic, encoding := range config.TopicEncodings {
+		if topicUnmarshalers[topic] = unmarshalers[encoding]; topicUnmarshalers[topic] == nil {
+			return nil, fmt.Errorf("%w %q for topic %q", errUnrecognizedEncoding, encoding, topic)
 		}
-		c.Version = version
-	}
-	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
-		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+
+	client, sub, err := newConsumerGroup(config)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaTracesConsumer{
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		subscription:      sub,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
+		topicUnmarshalers: topicUnmarshalers,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
@@ -135,6 +130,8 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, host component.Host) erro
 	consumerGroup := &tracesConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
+		topicUnmarshalers: c.topicUnmarshalers,
+		headerExtractor:   c.headerExtractor,
 		nextConsumer:      c.nextConsumer,
 		ready:             make(chan bool),
 		obsrecv:           obsrecv,
@@ -146,29 +143,24 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, host component.Host) erro
 			host.ReportFatalError(err)
 		}
 	}()
-	<-consumerGroup.ready
+	// Topics matching a pattern may not exist yet, so only wait for the
+	// first session when consuming from a static list of topics.
+	if c.subscription.pattern == nil {
+		<-consumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
-	for {
-		// `Consume` should be called inside an infinite loop, when a
-		// server-side rebalance happens, the consumer session will need to be
-		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
-			c.settings.Logger.Error("Error from consumer", zap.Error(err))
-		}
-		// check if context was cancelled, signaling that the consumer should stop
-		if ctx.Err() != nil {
-			c.settings.Logger.Info("Consumer stopped", zap.Error(ctx.Err()))
-			return ctx.Err()
-		}
-	}
+	return consumeSubscription(ctx, c.consumerGroup, c.subscription, handler, c.settings.Logger)
 }
 
 func (c *kafkaTracesConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	if err := c.consumerGroup.Close(); err != nil {
+		return err
+	}
+	return c.subscription.close()
 }
 
 func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]MetricsUnmarshaler, nextConsumer consumer.Metrics) (*kafkaMetricsConsumer, error) {
@@ -176,34 +168,24 @@ func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
-
-	c := sarama.NewConfig()
-	c.ClientID = config.ClientID
-	c.Metadata.Full = config.Metadata.Full
-	c.Metadata.Retry.Max = config.Metadata.Retry.Max
-	c.Metadata.Retry.Backoff = config.Metadata.Retry.Backoff
-	c.Consumer.Offsets.AutoCommit.Enable = config.AutoCommit.Enable
-	c.Consumer.Offsets.AutoCommit.Interval = config.AutoCommit.Interval
-
-	if config.ProtocolVersion != "" {
-		version, err := sarama.ParseKafkaVersion(config.ProtocolVersion)
-		if err != nil {
-			return nil, err
+	topicUnmarshalers := make(map[string]MetricsUnmarshaler, len(config.TopicEncodings))
+	for topic, encoding := range config.TopicEncodings {
+		if topicUnmarshalers[topic] = unmarshalers[encoding]; topicUnmarshalers[topic] == nil {
+			return nil, fmt.Errorf("%w %q for topic %q", errUnrecognizedEncoding, encoding, topic)
 		}
-		c.Version = version
-	}
-	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
-		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+
+	client, sub, err := newConsumerGroup(config)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaMetricsConsumer{
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		subscription:      sub,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
+		topicUnmarshalers: topicUnmarshalers,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
@@ -224,6 +206,8 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, host component.Host) err
 	metricsConsumerGroup := &metricsConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
+		topicUnmarshalers: c.topicUnmarshalers,
+		headerExtractor:   c.headerExtractor,
 		nextConsumer:      c.nextConsumer,
 		ready:             make(chan bool),
 		obsrecv:           obsrecv,
@@ -235,29 +219,24 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, host component.Host) err
 			host.ReportFatalError(err)
 		}
 	}()
-	<-metricsConsumerGroup.ready
+	// Topics matching a pattern may not exist yet, so only wait for the
+	// first session when consuming from a static list of topics.
+	if c.subscription.pattern == nil {
+		<-metricsConsumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
-	for {
-		// `Consume` should be called inside an infinite loop, when a
-		// server-side rebalance happens, the consumer session will need to be
-		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
-			c.settings.Logger.Error("Error from consumer", zap.Error(err))
-		}
-		// check if context was cancelled, signaling that the consumer should stop
-		if ctx.Err() != nil {
-			c.settings.Logger.Info("Consumer stopped", zap.Error(ctx.Err()))
-			return ctx.Err()
-		}
-	}
+	return consumeSubscription(ctx, c.consumerGroup, c.subscription, handler, c.settings.Logger)
 }
 
 func (c *kafkaMetricsConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	if err := c.consumerGroup.Close(); err != nil {
+		return err
+	}
+	return c.subscription.close()
 }
 
 func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]LogsUnmarshaler, nextConsumer consumer.Logs) (*kafkaLogsConsumer, error) {
@@ -265,33 +244,24 @@ func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers ma
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
-
-	c := sarama.NewConfig()
-	c.ClientID = config.ClientID
-	c.Metadata.Full = config.Metadata.Full
-	c.Metadata.Retry.Max = config.Metadata.Retry.Max
-	c.Metadata.Retry.Backoff = config.Metadata.Retry.Backoff
-	c.Consumer.Offsets.AutoCommit.Enable = config.AutoCommit.Enable
-	c.Consumer.Offsets.AutoCommit.Interval = config.AutoCommit.Interval
-	if config.ProtocolVersion != "" {
-		version, err := sarama.ParseKafkaVersion(config.ProtocolVersion)
-		if err != nil {
-			return nil, err
+	topicUnmarshalers := make(map[string]LogsUnmarshaler, len(config.TopicEncodings))
+	for topic, encoding := range config.TopicEncodings {
+		if topicUnmarshalers[topic] = unmarshalers[encoding]; topicUnmarshalers[topic] == nil {
+			return nil, fmt.Errorf("%w %q for topic %q", errUnrecognizedEncoding, encoding, topic)
 		}
-		c.Version = version
 	}
-	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
-		return nil, err
-	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+
+	client, sub, err := newConsumerGroup(config)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaLogsConsumer{
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		subscription:      sub,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
+		topicUnmarshalers: topicUnmarshalers,
+		headerExtractor:   newHeaderExtractor(config.HeaderExtraction),
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
@@ -313,6 +283,8 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, host component.Host) error
 	logsConsumerGroup := &logsConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
+		topicUnmarshalers: c.topicUnmarshalers,
+		headerExtractor:   c.headerExtractor,
 		nextConsumer:      c.nextConsumer,
 		ready:             make(chan bool),
 		obsrecv:           obsrecv,
@@ -324,37 +296,34 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, host component.Host) error
 			host.ReportFatalError(err)
 		}
 	}()
-	<-logsConsumerGroup.ready
+	// Topics matching a pattern may not exist yet, so only wait for the
+	// first session when consuming from a static list of topics.
+	if c.subscription.pattern == nil {
+		<-logsConsumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
-	for {
-		// `Consume` should be called inside an infinite loop, when a
-		// server-side rebalance happens, the consumer session will need to be
-		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
-			c.settings.Logger.Error("Error from consumer", zap.Error(err))
-		}
-		// check if context was cancelled, signaling that the consumer should stop
-		if ctx.Err() != nil {
-			c.settings.Logger.Info("Consumer stopped", zap.Error(ctx.Err()))
-			return ctx.Err()
-		}
-	}
+	return consumeSubscription(ctx, c.consumerGroup, c.subscription, handler, c.settings.Logger)
 }
 
 func (c *kafkaLogsConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	if err := c.consumerGroup.Close(); err != nil {
+		return err
+	}
+	return c.subscription.close()
 }
 
 type tracesConsumerGroupHandler struct {
-	id           component.ID
-	unmarshaler  TracesUnmarshaler
-	nextConsumer consumer.Traces
-	ready        chan bool
-	readyCloser  sync.Once
+	id                component.ID
+	unmarshaler       TracesUnmarshaler
+	topicUnmarshalers map[string]TracesUnmarshaler
+	headerExtractor   headerExtractor
+	nextConsumer      consumer.Traces
+	ready             chan bool
+	readyCloser       sync.Once
 
 	logger *zap.Logger
 
@@ -365,11 +334,13 @@ type tracesConsumerGroupHandler struct {
 }
 
 type metricsConsumerGroupHandler struct {
-	id           component.ID
-	unmarshaler  MetricsUnmarshaler
-	nextConsumer consumer.Metrics
-	ready        chan bool
-	readyCloser  sync.Once
+	id                component.ID
+	unmarshaler       MetricsUnmarshaler
+	topicUnmarshalers map[string]MetricsUnmarshaler
+	headerExtractor   headerExtractor
+	nextConsumer      consumer.Metrics
+	ready             chan bool
+	readyCloser       sync.Once
 
 	logger *zap.Logger
 
@@ -380,11 +351,13 @@ type metricsConsumerGroupHandler struct {
 }
 
 type logsConsumerGroupHandler struct {
-	id           component.ID
-	unmarshaler  LogsUnmarshaler
-	nextConsumer consumer.Logs
-	ready        chan bool
-	readyCloser  sync.Once
+	id                component.ID
+	unmarshaler       LogsUnmarshaler
+	topicUnmarshalers map[string]LogsUnmarshaler
+	headerExtractor   headerExtractor
+	nextConsumer      consumer.Logs
+	ready             chan bool
+	readyCloser       sync.Once
 
 	logger *zap.Logger
 
@@ -413,6 +386,14 @@ func (c *tracesConsumerGroupHandler) Cleanup(session sarama.ConsumerGroupSession
 	return nil
 }
 
+// unmarshalerFor returns the unmarshaler configured for topic, falling back to the default encoding.
+func (c *tracesConsumerGroupHandler) unmarshalerFor(topic string) TracesUnmarshaler {
+	if unmarshaler, ok := c.topicUnmarshalers[topic]; ok {
+		return unmarshaler
+	}
+	return c.unmarshaler
+}
+
 func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	c.logger.Info("Starting consumer group", zap.Int32("partition", claim.Partition()))
 	if !c.autocommitEnabled {
@@ -439,7 +420,8 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 				statMessageOffset.M(message.Offset),
 				statMessageOffsetLag.M(claim.HighWaterMarkOffset()-message.Offset-1))
 
-			traces, err := c.unmarshaler.Unmarshal(message.Value)
+			unmarshaler := c.unmarshalerFor(message.Topic)
+			traces, err := unmarshaler.Unmarshal(message.Value)
 			if err != nil {
 				c.logger.Error("failed to unmarshal message", zap.Error(err))
 				if c.messageMarking.After && c.messageMarking.OnError {
@@ -448,9 +430,14 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 				return err
 			}
 
+			headers := c.headerExtractor.extract(message)
+			for i := 0; i < traces.ResourceSpans().Len(); i++ {
+				putHeaderAttributes(traces.ResourceSpans().At(i).Resource().Attributes(), headers)
+			}
+
 			spanCount := traces.SpanCount()
-			err = c.nextConsumer.ConsumeTraces(session.Context(), traces)
-			c.obsrecv.EndTracesOp(ctx, c.unmarshaler.Encoding(), spanCount, err)
+			err = c.nextConsumer.ConsumeTraces(c.headerExtractor.contextWithMetadata(session.Context(), headers), traces)
+			c.obsrecv.EndTracesOp(ctx, unmarshaler.Encoding(), spanCount, err)
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
@@ -488,6 +475,14 @@ func (c *metricsConsumerGroupHandler) Cleanup(session sarama.ConsumerGroupSessio
 	return nil
 }
 
+// unmarshalerFor returns the unmarshaler configured for topic, falling back to the default encoding.
+func (c *metricsConsumerGroupHandler) unmarshalerFor(topic string) MetricsUnmarshaler {
+	if unmarshaler, ok := c.topicUnmarshalers[topic]; ok {
+		return unmarshaler
+	}
+	return c.unmarshaler
+}
+
 func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	c.logger.Info("Starting consumer group", zap.Int32("partition", claim.Partition()))
 	if !c.autocommitEnabled {
@@ -514,7 +509,8 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 				statMessageOffset.M(message.Offset),
 				statMessageOffsetLag.M(claim.HighWaterMarkOffset()-message.Offset-1))
 
-			metrics, err := c.unmarshaler.Unmarshal(message.Value)
+			unmarshaler := c.unmarshalerFor(message.Topic)
+			metrics, err := unmarshaler.Unmarshal(message.Value)
 			if err != nil {
 				c.logger.Error("failed to unmarshal message", zap.Error(err))
 				if c.messageMarking.After && c.messageMarking.OnError {
@@ -523,9 +519,14 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 				return err
 			}
 
+			headers := c.headerExtractor.extract(message)
+			for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+				putHeaderAttributes(metrics.ResourceMetrics().At(i).Resource().Attributes(), headers)
+			}
+
 			dataPointCount := metrics.DataPointCount()
-			err = c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
-			c.obsrecv.EndMetricsOp(ctx, c.unmarshaler.Encoding(), dataPointCount, err)
+			err = c.nextConsumer.ConsumeMetrics(c.headerExtractor.contextWithMetadata(session.Context(), headers), metrics)
+			c.obsrecv.EndMetricsOp(ctx, unmarshaler.Encoding(), dataPointCount, err)
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
@@ -567,6 +568,14 @@ func (c *logsConsumerGroupHandler) Cleanup(session sarama.ConsumerGroupSession)
 	return nil
 }
 
+// unmarshalerFor returns the unmarshaler configured for topic, falling back to the default encoding.
+func (c *logsConsumerGroupHandler) unmarshalerFor(topic string) LogsUnmarshaler {
+	if unmarshaler, ok := c.topicUnmarshalers[topic]; ok {
+		return unmarshaler
+	}
+	return c.unmarshaler
+}
+
 func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	c.logger.Info("Starting consumer group", zap.Int32("partition", claim.Partition()))
 	if !c.autocommitEnabled {
@@ -594,7 +603,8 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 				statMessageOffset.M(message.Offset),
 				statMessageOffsetLag.M(claim.HighWaterMarkOffset()-message.Offset-1))
 
-			logs, err := c.unmarshaler.Unmarshal(message.Value)
+			unmarshaler := c.unmarshalerFor(message.Topic)
+			logs, err := unmarshaler.Unmarshal(message.Value)
 			if err != nil {
 				c.logger.Error("failed to unmarshal message", zap.Error(err))
 				if c.messageMarking.After && c.messageMarking.OnError {
@@ -603,9 +613,14 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 				return err
 			}
 
-			err = c.nextConsumer.ConsumeLogs(session.Context(), logs)
+			headers := c.headerExtractor.extract(message)
+			for i := 0; i < logs.ResourceLogs().Len(); i++ {
+				putHeaderAttributes(logs.ResourceLogs().At(i).Resource().Attributes(), headers)
+			}
+
+			err = c.nextConsumer.ConsumeLogs(c.headerExtractor.contextWithMetadata(session.Context(), headers), logs)
 			// TODO
-			c.obsrecv.EndLogsOp(ctx, c.unmarshaler.Encoding(), logs.LogRecordCount(), err)
+			c.obsrecv.EndLogsOp(ctx, unmarshaler.Encoding(), logs.LogRecordCount(), err)
 			if err != nil {
 				if c.messageMarking.After && c.messageMarking.OnError {
 					session.MarkMessage(message, "")
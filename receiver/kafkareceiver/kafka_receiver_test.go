@@ -25,8 +25,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -61,6 +63,18 @@ func TestNewTracesReceiver_encoding_err(t *testing.T) {
 	assert.EqualError(t, err, errUnrecognizedEncoding.Error())
 }
 
+func TestNewTracesReceiver_topic_encoding_err(t *testing.T) {
+	c := Config{
+		Encoding:       defaultEncoding,
+		TopicEncodings: map[string]string{"spans": "foo"},
+	}
+	r, err := newTracesReceiver(c, receivertest.NewNopCreateSettings(), defaultTracesUnmarshalers(), consumertest.NewNop())
+	require.Error(t, err)
+	assert.Nil(t, r)
+	assert.ErrorIs(t, err, errUnrecognizedEncoding)
+	assert.Contains(t, err.Error(), `"spans"`)
+}
+
 func TestNewTracesReceiver_err_auth_type(t *testing.T) {
 	c := Config{
 		ProtocolVersion: "2.0.0",
@@ -775,6 +789,68 @@ func TestLogsConsumerGroupHandler_error_nextConsumer(t *testing.T) {
 	wg.Wait()
 }
 
+func TestLogsConsumerGroupHandler_topicEncodingAndHeaders(t *testing.T) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
+	require.NoError(t, err)
+
+	var received []plog.Logs
+	var metadata [][]string
+	nextConsumer, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		received = append(received, ld)
+		metadata = append(metadata, client.FromContext(ctx).Metadata.Get("tenant"))
+		return nil
+	})
+	require.NoError(t, err)
+
+	c := logsConsumerGroupHandler{
+		unmarshaler:       newPdataLogsUnmarshaler(&plog.ProtoUnmarshaler{}, defaultEncoding),
+		topicUnmarshalers: map[string]LogsUnmarshaler{"raw_logs": newRawLogsUnmarshaler()},
+		headerExtractor: newHeaderExtractor(HeaderExtraction{
+			ExtractHeaders:  true,
+			Headers:         []string{"tenant"},
+			IncludeMetadata: true,
+		}),
+		logger:       zap.NewNop(),
+		ready:        make(chan bool),
+		nextConsumer: nextConsumer,
+		obsrecv:      obsrecv,
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	groupClaim := &testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+	go func() {
+		assert.NoError(t, c.ConsumeClaim(testConsumerGroupSession{ctx: context.Background()}, groupClaim))
+		wg.Done()
+	}()
+
+	ld := testdata.GenerateLogsOneLogRecord()
+	bts, err := (&plog.ProtoMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+	groupClaim.messageChan <- &sarama.ConsumerMessage{Topic: "otlp_logs", Value: bts}
+	groupClaim.messageChan <- &sarama.ConsumerMessage{
+		Topic: "raw_logs",
+		Value: []byte("hello"),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("tenant"), Value: []byte("acme")},
+			{Key: []byte("other"), Value: []byte("ignored")},
+		},
+	}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	require.Len(t, received, 2)
+	assert.Equal(t, ld, received[0])
+	assert.Nil(t, metadata[0])
+
+	raw := received[1].ResourceLogs().At(0)
+	assert.Equal(t, []byte("hello"), raw.ScopeLogs().At(0).LogRecords().At(0).Body().Bytes().AsRaw())
+	assert.Equal(t, map[string]interface{}{"kafka.header.tenant": "acme"}, raw.Resource().Attributes().AsRaw())
+	assert.Equal(t, []string{"acme"}, metadata[1])
+}
+
 type testConsumerGroupClaim struct {
 	messageChan chan *sarama.ConsumerMessage
 }
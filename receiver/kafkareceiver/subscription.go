@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+)
+
+// topicRefreshInterval is how often the topics matching a topic_pattern are re-resolved.
+var topicRefreshInterval = time.Minute
+
+// topicLister is the subset of sarama.Client used to resolve topic patterns.
+type topicLister interface {
+	RefreshMetadata(topics ...string) error
+	Topics() ([]string, error)
+	Close() error
+}
+
+// subscription describes the topics a consumer group consumes from, either
+// a static list of topics or all the topics matching a pattern.
+type subscription struct {
+	topics  []string
+	pattern *regexp.Regexp
+	lister  topicLister
+}
+
+// resolve returns the sorted topics the consumer group should currently consume from.
+func (s subscription) resolve() ([]string, error) {
+	if s.pattern == nil {
+		return s.topics, nil
+	}
+	if err := s.lister.RefreshMetadata(); err != nil {
+		return nil, err
+	}
+	all, err := s.lister.Topics()
+	if err != nil {
+		return nil, err
+	}
+	var topics []string
+	for _, topic := range all {
+		if s.pattern.MatchString(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+// watch cancels the consumer session once the topics matching the pattern
+// differ from current, so that the next session subscribes to them.
+func (s subscription) watch(ctx context.Context, current []string, cancel context.CancelFunc, logger *zap.Logger) {
+	ticker := time.NewTicker(topicRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			topics, err := s.resolve()
+			if err != nil {
+				logger.Warn("Failed to resolve topics matching pattern", zap.Error(err))
+				continue
+			}
+			if !equalTopics(current, topics) {
+				logger.Info("Topics matching pattern changed", zap.Strings("topics", topics))
+				cancel()
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// close releases the client used to resolve the topic pattern, if any.
+func (s subscription) close() error {
+	if s.lister == nil {
+		return nil
+	}
+	return s.lister.Close()
+}
+
+func equalTopics(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// consumeSubscription runs the consumer group until ctx is cancelled, re-subscribing
+// whenever the topics matching the subscription pattern change.
+func consumeSubscription(ctx context.Context, consumerGroup sarama.ConsumerGroup, sub subscription, handler sarama.ConsumerGroupHandler, logger *zap.Logger) error {
+	for {
+		topics, err := sub.resolve()
+		idle := true
+		switch {
+		case err != nil:
+			logger.Error("Failed to resolve topics matching pattern", zap.Error(err))
+		case sub.pattern != nil && len(topics) == 0:
+			logger.Warn("No topics match pattern", zap.String("topic_pattern", sub.pattern.String()))
+		default:
+			idle = false
+			sessionCtx, cancel := context.WithCancel(ctx)
+			if sub.pattern != nil {
+				go sub.watch(sessionCtx, topics, cancel, logger)
+			}
+			// `Consume` should be called inside an infinite loop, when a
+			// server-side rebalance happens, the consumer session will need to be
+			// recreated to get the new claims
+			if err = consumerGroup.Consume(sessionCtx, topics, handler); err != nil {
+				logger.Error("Error from consumer", zap.Error(err))
+			}
+			cancel()
+		}
+		if idle {
+			select {
+			case <-time.After(topicRefreshInterval):
+			case <-ctx.Done():
+			}
+		}
+		// check if context was cancelled, signaling that the consumer should stop
+		if ctx.Err() != nil {
+			logger.Info("Consumer stopped", zap.Error(ctx.Err()))
+			return ctx.Err()
+		}
+	}
+}
+
+// newConsumerGroup creates the sarama consumer group and topic subscription for config.
+func newConsumerGroup(config Config) (sarama.ConsumerGroup, subscription, error) {
+	sub := subscription{topics: config.subscribedTopics()}
+
+	c := sarama.NewConfig()
+	c.ClientID = config.ClientID
+	c.Metadata.Full = config.Metadata.Full
+	c.Metadata.Retry.Max = config.Metadata.Retry.Max
+	c.Metadata.Retry.Backoff = config.Metadata.Retry.Backoff
+	c.Consumer.Offsets.AutoCommit.Enable = config.AutoCommit.Enable
+	c.Consumer.Offsets.AutoCommit.Interval = config.AutoCommit.Interval
+	if config.ProtocolVersion != "" {
+		version, err := sarama.ParseKafkaVersion(config.ProtocolVersion)
+		if err != nil {
+			return nil, sub, err
+		}
+		c.Version = version
+	}
+	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
+		return nil, sub, err
+	}
+	if config.TopicPattern == "" {
+		consumerGroup, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+		return consumerGroup, sub, err
+	}
+
+	pattern, err := regexp.Compile(config.TopicPattern)
+	if err != nil {
+		return nil, sub, err
+	}
+	client, err := sarama.NewClient(config.Brokers, c)
+	if err != nil {
+		return nil, sub, err
+	}
+	consumerGroup, err := sarama.NewConsumerGroupFromClient(config.GroupID, client)
+	if err != nil {
+		_ = client.Close()
+		return nil, sub, err
+	}
+	sub.pattern = pattern
+	sub.lister = client
+	return consumerGroup, sub, nil
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkareceiver
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testTopicLister struct {
+	mu     sync.Mutex
+	topics []string
+	err    error
+	closed bool
+}
+
+func (t *testTopicLister) RefreshMetadata(...string) error {
+	return t.err
+}
+
+func (t *testTopicLister) Topics() ([]string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.topics, nil
+}
+
+func (t *testTopicLister) Close() error {
+	t.closed = true
+	return nil
+}
+
+func (t *testTopicLister) setTopics(topics []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.topics = topics
+}
+
+func TestSubscriptionResolve(t *testing.T) {
+	static := subscription{topics: []string{"b", "a"}}
+	topics, err := static.resolve()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "a"}, topics)
+	assert.NoError(t, static.close())
+
+	lister := &testTopicLister{topics: []string{"otlp_spans_b", "otlp_logs", "otlp_spans_a"}}
+	pattern := subscription{pattern: regexp.MustCompile("^otlp_spans_.*"), lister: lister}
+	topics, err = pattern.resolve()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"otlp_spans_a", "otlp_spans_b"}, topics)
+	assert.NoError(t, pattern.close())
+	assert.True(t, lister.closed)
+
+	lister.err = errors.New("metadata error")
+	_, err = pattern.resolve()
+	assert.EqualError(t, err, "metadata error")
+}
+
+type recordingConsumerGroup struct {
+	testConsumerGroup
+	sessions chan []string
+}
+
+func (r *recordingConsumerGroup) Consume(ctx context.Context, topics []string, _ sarama.ConsumerGroupHandler) error {
+	r.sessions <- topics
+	<-ctx.Done()
+	return nil
+}
+
+func TestConsumeSubscription_patternChange(t *testing.T) {
+	defer func(interval time.Duration) { topicRefreshInterval = interval }(topicRefreshInterval)
+	topicRefreshInterval = 10 * time.Millisecond
+
+	lister := &testTopicLister{}
+	sub := subscription{pattern: regexp.MustCompile("^tenant-"), lister: lister}
+	group := &recordingConsumerGroup{sessions: make(chan []string)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- consumeSubscription(ctx, group, sub, &tracesConsumerGroupHandler{ready: make(chan bool)}, zap.NewNop())
+	}()
+
+	lister.setTopics([]string{"tenant-a", "other"})
+	assert.Equal(t, []string{"tenant-a"}, <-group.sessions)
+
+	lister.setTopics([]string{"tenant-a", "tenant-b", "other"})
+	assert.Equal(t, []string{"tenant-a", "tenant-b"}, <-group.sessions)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
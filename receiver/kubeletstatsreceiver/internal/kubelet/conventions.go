@@ -28,4 +28,8 @@ const (
 	labelValueAWSEBSVolume          = "awsElasticBlockStore"
 	labelValueGCEPDVolume           = "gcePersistentDisk"
 	labelValueGlusterFSVolume       = "glusterfs"
+	labelValueCSIVolume             = "csi"
+	labelValueProjectedVolume       = "projected"
+	labelValueNFSVolume             = "nfs"
+	labelValueEphemeralVolume       = "ephemeral"
 )
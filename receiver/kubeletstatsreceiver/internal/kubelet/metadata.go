@@ -107,12 +107,12 @@ func (m *Metadata) getExtraResources(podRef stats.PodReference, extraMetadataLab
 			return nil, err
 		}
 
-		ro := getResourcesFromVolume(volume)
+		ro := getResourcesFromVolume(volume, podRef.Name)
 
-		// Get more labels from PersistentVolumeClaim volume type.
-		if volume.PersistentVolumeClaim != nil {
+		// Get more labels from volumes backed by a PersistentVolumeClaim.
+		if claim := claimName(volume, podRef.Name); claim != "" {
 			volCacheID := fmt.Sprintf("%s/%s", podRef.UID, extraMetadataFrom)
-			pvcResources, err := m.DetailedPVCResourceGetter(volCacheID, volume.PersistentVolumeClaim.ClaimName, podRef.Namespace)
+			pvcResources, err := m.DetailedPVCResourceGetter(volCacheID, claim, podRef.Namespace)
 			if err != nil {
 				return nil, fmt.Errorf("failed to set labels from volume claim: %w", err)
 			}
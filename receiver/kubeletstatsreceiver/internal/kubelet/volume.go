@@ -32,9 +32,8 @@ func addVolumeMetrics(mb *metadata.MetricsBuilder, volumeMetrics metadata.Volume
 	recordIntDataPoint(mb, volumeMetrics.InodesUsed, s.InodesUsed, currentTime)
 }
 
-func getResourcesFromVolume(volume v1.Volume) []metadata.ResourceMetricsOption {
+func getResourcesFromVolume(volume v1.Volume, podName string) []metadata.ResourceMetricsOption {
 	switch {
-	// TODO: Support more types
 	case volume.ConfigMap != nil:
 		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueConfigMapVolume)}
 	case volume.DownwardAPI != nil:
@@ -46,8 +45,18 @@ func getResourcesFromVolume(volume v1.Volume) []metadata.ResourceMetricsOption {
 	case volume.PersistentVolumeClaim != nil:
 		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValuePersistentVolumeClaim),
 			metadata.WithK8sPersistentvolumeclaimName(volume.PersistentVolumeClaim.ClaimName)}
+	case volume.Ephemeral != nil:
+		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueEphemeralVolume),
+			metadata.WithK8sPersistentvolumeclaimName(ephemeralClaimName(podName, volume.Name))}
 	case volume.HostPath != nil:
 		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueHostPathVolume)}
+	case volume.Projected != nil:
+		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueProjectedVolume)}
+	case volume.CSI != nil:
+		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueCSIVolume),
+			metadata.WithCsiDriver(volume.CSI.Driver)}
+	case volume.NFS != nil:
+		return nfsDims(*volume.NFS)
 	case volume.AWSElasticBlockStore != nil:
 		return awsElasticBlockStoreDims(*volume.AWSElasticBlockStore)
 	case volume.GCEPersistentDisk != nil:
@@ -58,11 +67,53 @@ func getResourcesFromVolume(volume v1.Volume) []metadata.ResourceMetricsOption {
 	return nil
 }
 
+// claimName returns the name of the PersistentVolumeClaim backing the volume, if any.
+func claimName(volume v1.Volume, podName string) string {
+	switch {
+	case volume.PersistentVolumeClaim != nil:
+		return volume.PersistentVolumeClaim.ClaimName
+	case volume.Ephemeral != nil:
+		return ephemeralClaimName(podName, volume.Name)
+	}
+	return ""
+}
+
+// ephemeralClaimName returns the name of the PersistentVolumeClaim created by
+// Kubernetes for a generic ephemeral volume.
+func ephemeralClaimName(podName, volumeName string) string {
+	return podName + "-" + volumeName
+}
+
+// GetPersistentVolumeClaimLabels returns the resource options describing the PersistentVolume
+// bound to a PersistentVolumeClaim: its source, name, storage class and capacity.
+func GetPersistentVolumeClaimLabels(pvc v1.PersistentVolumeClaim, pv v1.PersistentVolume) []metadata.ResourceMetricsOption {
+	ro := GetPersistentVolumeLabels(pv.Spec.PersistentVolumeSource)
+	ro = append(ro, metadata.WithK8sPersistentvolumeName(pv.Name))
+
+	storageClass := pv.Spec.StorageClassName
+	if pvc.Spec.StorageClassName != nil {
+		storageClass = *pvc.Spec.StorageClassName
+	}
+	if storageClass != "" {
+		ro = append(ro, metadata.WithK8sStorageclassName(storageClass))
+	}
+
+	if capacity, ok := pv.Spec.Capacity[v1.ResourceStorage]; ok {
+		ro = append(ro, metadata.WithK8sPersistentvolumeCapacity(capacity.Value()))
+	}
+	return ro
+}
+
 func GetPersistentVolumeLabels(pv v1.PersistentVolumeSource) []metadata.ResourceMetricsOption {
-	// TODO: Support more types
 	switch {
 	case pv.Local != nil:
 		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueLocalVolume)}
+	case pv.HostPath != nil:
+		return []metadata.ResourceMetricsOption{metadata.WithK8sVolumeType(labelValueHostPathVolume)}
+	case pv.CSI != nil:
+		return csiDims(*pv.CSI)
+	case pv.NFS != nil:
+		return nfsDims(*pv.NFS)
 	case pv.AWSElasticBlockStore != nil:
 		return awsElasticBlockStoreDims(*pv.AWSElasticBlockStore)
 	case pv.GCEPersistentDisk != nil:
@@ -101,6 +152,25 @@ func gcePersistentDiskDims(vs v1.GCEPersistentDiskVolumeSource) []metadata.Resou
 	}
 }
 
+func csiDims(vs v1.CSIPersistentVolumeSource) []metadata.ResourceMetricsOption {
+	return []metadata.ResourceMetricsOption{
+		metadata.WithK8sVolumeType(labelValueCSIVolume),
+		// CSI specific labels.
+		metadata.WithCsiDriver(vs.Driver),
+		metadata.WithCsiVolumeHandle(vs.VolumeHandle),
+		metadata.WithFsType(vs.FSType),
+	}
+}
+
+func nfsDims(vs v1.NFSVolumeSource) []metadata.ResourceMetricsOption {
+	return []metadata.ResourceMetricsOption{
+		metadata.WithK8sVolumeType(labelValueNFSVolume),
+		// NFS specific labels.
+		metadata.WithNfsServer(vs.Server),
+		metadata.WithNfsPath(vs.Path),
+	}
+}
+
 func glusterfsDims(vs v1.GlusterfsVolumeSource) []metadata.ResourceMetricsOption {
 	return []metadata.ResourceMetricsOption{
 		metadata.WithK8sVolumeType(labelValueGlusterFSVolume),
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
@@ -160,6 +161,110 @@ func TestDetailedPVCLabels(t *testing.T) {
 				"k8s.namespace.name":             "pod-namespace",
 			},
 		},
+		{
+			name:       "persistentVolumeClaim - with detailed PVC labels (CSI)",
+			volumeName: "volume0",
+			volumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: "claim-name",
+				},
+			},
+			pod: pod{uid: "uid-1234", name: "pod-name", namespace: "pod-namespace"},
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+				storageClass := "ebs-sc"
+				ro := GetPersistentVolumeClaimLabels(v1.PersistentVolumeClaim{
+					Spec: v1.PersistentVolumeClaimSpec{StorageClassName: &storageClass},
+				}, v1.PersistentVolume{
+					ObjectMeta: metav1.ObjectMeta{Name: "pv-name"},
+					Spec: v1.PersistentVolumeSpec{
+						StorageClassName: "ignored",
+						Capacity:         v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+						PersistentVolumeSource: v1.PersistentVolumeSource{
+							CSI: &v1.CSIPersistentVolumeSource{
+								Driver:       "ebs.csi.aws.com",
+								VolumeHandle: "vol-0123",
+								FSType:       "ext4",
+							},
+						},
+					},
+				})
+				return ro, nil
+			},
+			want: map[string]interface{}{
+				"k8s.volume.name":                "volume0",
+				"k8s.volume.type":                "csi",
+				"csi.driver":                     "ebs.csi.aws.com",
+				"csi.volume.handle":              "vol-0123",
+				"fs.type":                        "ext4",
+				"k8s.persistentvolume.name":      "pv-name",
+				"k8s.persistentvolume.capacity":  int64(1024 * 1024 * 1024),
+				"k8s.storageclass.name":          "ebs-sc",
+				"k8s.persistentvolumeclaim.name": "claim-name",
+				"k8s.pod.uid":                    "uid-1234",
+				"k8s.pod.name":                   "pod-name",
+				"k8s.namespace.name":             "pod-namespace",
+			},
+		},
+		{
+			name:       "persistentVolumeClaim - with detailed PVC labels (NFS)",
+			volumeName: "volume0",
+			volumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: "claim-name",
+				},
+			},
+			pod: pod{uid: "uid-1234", name: "pod-name", namespace: "pod-namespace"},
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+				ro := GetPersistentVolumeClaimLabels(v1.PersistentVolumeClaim{}, v1.PersistentVolume{
+					ObjectMeta: metav1.ObjectMeta{Name: "pv-name"},
+					Spec: v1.PersistentVolumeSpec{
+						StorageClassName: "nfs-client",
+						PersistentVolumeSource: v1.PersistentVolumeSource{
+							NFS: &v1.NFSVolumeSource{
+								Server: "nfs.example.com",
+								Path:   "/exports",
+							},
+						},
+					},
+				})
+				return ro, nil
+			},
+			want: map[string]interface{}{
+				"k8s.volume.name":                "volume0",
+				"k8s.volume.type":                "nfs",
+				"nfs.server":                     "nfs.example.com",
+				"nfs.path":                       "/exports",
+				"k8s.persistentvolume.name":      "pv-name",
+				"k8s.storageclass.name":          "nfs-client",
+				"k8s.persistentvolumeclaim.name": "claim-name",
+				"k8s.pod.uid":                    "uid-1234",
+				"k8s.pod.name":                   "pod-name",
+				"k8s.namespace.name":             "pod-namespace",
+			},
+		},
+		{
+			name:       "ephemeral - looks up the generated PVC",
+			volumeName: "scratch",
+			volumeSource: v1.VolumeSource{
+				Ephemeral: &v1.EphemeralVolumeSource{},
+			},
+			pod: pod{uid: "uid-1234", name: "pod-name", namespace: "pod-namespace"},
+			detailedPVCLabelsSetterOverride: func(volCacheID, volumeClaim, namespace string) ([]metadata.ResourceMetricsOption, error) {
+				require.Equal(t, "pod-name-scratch", volumeClaim)
+				require.Equal(t, "pod-namespace", namespace)
+				return GetPersistentVolumeLabels(v1.PersistentVolumeSource{
+					HostPath: &v1.HostPathVolumeSource{Path: "/mnt/data"},
+				}), nil
+			},
+			want: map[string]interface{}{
+				"k8s.volume.name":                "scratch",
+				"k8s.volume.type":                "hostPath",
+				"k8s.persistentvolumeclaim.name": "pod-name-scratch",
+				"k8s.pod.uid":                    "uid-1234",
+				"k8s.pod.name":                   "pod-name",
+				"k8s.namespace.name":             "pod-namespace",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -204,3 +309,63 @@ func TestDetailedPVCLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestVolumeTypes(t *testing.T) {
+	tests := []struct {
+		name         string
+		volumeSource v1.VolumeSource
+		want         map[string]interface{}
+	}{
+		{
+			name:         "hostPath",
+			volumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/log"}},
+			want:         map[string]interface{}{"k8s.volume.type": "hostPath"},
+		},
+		{
+			name:         "projected",
+			volumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{}},
+			want:         map[string]interface{}{"k8s.volume.type": "projected"},
+		},
+		{
+			name:         "csi",
+			volumeSource: v1.VolumeSource{CSI: &v1.CSIVolumeSource{Driver: "secrets-store.csi.k8s.io"}},
+			want: map[string]interface{}{
+				"k8s.volume.type": "csi",
+				"csi.driver":      "secrets-store.csi.k8s.io",
+			},
+		},
+		{
+			name:         "nfs",
+			volumeSource: v1.VolumeSource{NFS: &v1.NFSVolumeSource{Server: "10.0.0.1", Path: "/exports"}},
+			want: map[string]interface{}{
+				"k8s.volume.type": "nfs",
+				"nfs.server":      "10.0.0.1",
+				"nfs.path":        "/exports",
+			},
+		},
+		{
+			name:         "ephemeral",
+			volumeSource: v1.VolumeSource{Ephemeral: &v1.EphemeralVolumeSource{}},
+			want: map[string]interface{}{
+				"k8s.volume.type":                "ephemeral",
+				"k8s.persistentvolumeclaim.name": "pod-name-volume0",
+			},
+		},
+		{
+			name:         "unsupported",
+			volumeSource: v1.VolumeSource{ISCSI: &v1.ISCSIVolumeSource{}},
+			want:         map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ro := getResourcesFromVolume(v1.Volume{Name: "volume0", VolumeSource: tt.volumeSource}, "pod-name")
+
+			rm := pmetric.NewResourceMetrics()
+			for _, op := range ro {
+				op(metadata.DefaultResourceAttributesSettings(), rm)
+			}
+			require.Equal(t, tt.want, rm.Resource().Attributes().AsRaw())
+		})
+	}
+}
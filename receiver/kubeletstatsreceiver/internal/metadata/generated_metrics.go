@@ -231,6 +231,8 @@ func (ras *ResourceAttributeSettings) Unmarshal(parser *confmap.Conf) error {
 type ResourceAttributesSettings struct {
 	AwsVolumeID                  ResourceAttributeSettings `mapstructure:"aws.volume.id"`
 	ContainerID                  ResourceAttributeSettings `mapstructure:"container.id"`
+	CsiDriver                    ResourceAttributeSettings `mapstructure:"csi.driver"`
+	CsiVolumeHandle              ResourceAttributeSettings `mapstructure:"csi.volume.handle"`
 	FsType                       ResourceAttributeSettings `mapstructure:"fs.type"`
 	GcePdName                    ResourceAttributeSettings `mapstructure:"gce.pd.name"`
 	GlusterfsEndpointsName       ResourceAttributeSettings `mapstructure:"glusterfs.endpoints.name"`
@@ -238,11 +240,16 @@ type ResourceAttributesSettings struct {
 	K8sContainerName             ResourceAttributeSettings `mapstructure:"k8s.container.name"`
 	K8sNamespaceName             ResourceAttributeSettings `mapstructure:"k8s.namespace.name"`
 	K8sNodeName                  ResourceAttributeSettings `mapstructure:"k8s.node.name"`
+	K8sPersistentvolumeCapacity  ResourceAttributeSettings `mapstructure:"k8s.persistentvolume.capacity"`
+	K8sPersistentvolumeName      ResourceAttributeSettings `mapstructure:"k8s.persistentvolume.name"`
 	K8sPersistentvolumeclaimName ResourceAttributeSettings `mapstructure:"k8s.persistentvolumeclaim.name"`
 	K8sPodName                   ResourceAttributeSettings `mapstructure:"k8s.pod.name"`
 	K8sPodUID                    ResourceAttributeSettings `mapstructure:"k8s.pod.uid"`
+	K8sStorageclassName          ResourceAttributeSettings `mapstructure:"k8s.storageclass.name"`
 	K8sVolumeName                ResourceAttributeSettings `mapstructure:"k8s.volume.name"`
 	K8sVolumeType                ResourceAttributeSettings `mapstructure:"k8s.volume.type"`
+	NfsPath                      ResourceAttributeSettings `mapstructure:"nfs.path"`
+	NfsServer                    ResourceAttributeSettings `mapstructure:"nfs.server"`
 	Partition                    ResourceAttributeSettings `mapstructure:"partition"`
 }
 
@@ -254,6 +261,12 @@ func DefaultResourceAttributesSettings() ResourceAttributesSettings {
 		ContainerID: ResourceAttributeSettings{
 			Enabled: true,
 		},
+		CsiDriver: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		CsiVolumeHandle: ResourceAttributeSettings{
+			Enabled: true,
+		},
 		FsType: ResourceAttributeSettings{
 			Enabled: true,
 		},
@@ -275,6 +288,12 @@ func DefaultResourceAttributesSettings() ResourceAttributesSettings {
 		K8sNodeName: ResourceAttributeSettings{
 			Enabled: true,
 		},
+		K8sPersistentvolumeCapacity: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		K8sPersistentvolumeName: ResourceAttributeSettings{
+			Enabled: true,
+		},
 		K8sPersistentvolumeclaimName: ResourceAttributeSettings{
 			Enabled: true,
 		},
@@ -284,12 +303,21 @@ func DefaultResourceAttributesSettings() ResourceAttributesSettings {
 		K8sPodUID: ResourceAttributeSettings{
 			Enabled: true,
 		},
+		K8sStorageclassName: ResourceAttributeSettings{
+			Enabled: true,
+		},
 		K8sVolumeName: ResourceAttributeSettings{
 			Enabled: true,
 		},
 		K8sVolumeType: ResourceAttributeSettings{
 			Enabled: true,
 		},
+		NfsPath: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		NfsServer: ResourceAttributeSettings{
+			Enabled: true,
+		},
 		Partition: ResourceAttributeSettings{
 			Enabled: true,
 		},
@@ -2562,6 +2590,24 @@ func WithContainerID(val string) ResourceMetricsOption {
 	}
 }
 
+// WithCsiDriver sets provided value as "csi.driver" attribute for current resource.
+func WithCsiDriver(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.CsiDriver.Enabled {
+			rm.Resource().Attributes().PutStr("csi.driver", val)
+		}
+	}
+}
+
+// WithCsiVolumeHandle sets provided value as "csi.volume.handle" attribute for current resource.
+func WithCsiVolumeHandle(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.CsiVolumeHandle.Enabled {
+			rm.Resource().Attributes().PutStr("csi.volume.handle", val)
+		}
+	}
+}
+
 // WithFsType sets provided value as "fs.type" attribute for current resource.
 func WithFsType(val string) ResourceMetricsOption {
 	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
@@ -2625,6 +2671,24 @@ func WithK8sNodeName(val string) ResourceMetricsOption {
 	}
 }
 
+// WithK8sPersistentvolumeCapacity sets provided value as "k8s.persistentvolume.capacity" attribute for current resource.
+func WithK8sPersistentvolumeCapacity(val int64) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.K8sPersistentvolumeCapacity.Enabled {
+			rm.Resource().Attributes().PutInt("k8s.persistentvolume.capacity", val)
+		}
+	}
+}
+
+// WithK8sPersistentvolumeName sets provided value as "k8s.persistentvolume.name" attribute for current resource.
+func WithK8sPersistentvolumeName(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.K8sPersistentvolumeName.Enabled {
+			rm.Resource().Attributes().PutStr("k8s.persistentvolume.name", val)
+		}
+	}
+}
+
 // WithK8sPersistentvolumeclaimName sets provided value as "k8s.persistentvolumeclaim.name" attribute for current resource.
 func WithK8sPersistentvolumeclaimName(val string) ResourceMetricsOption {
 	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
@@ -2652,6 +2716,15 @@ func WithK8sPodUID(val string) ResourceMetricsOption {
 	}
 }
 
+// WithK8sStorageclassName sets provided value as "k8s.storageclass.name" attribute for current resource.
+func WithK8sStorageclassName(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.K8sStorageclassName.Enabled {
+			rm.Resource().Attributes().PutStr("k8s.storageclass.name", val)
+		}
+	}
+}
+
 // WithK8sVolumeName sets provided value as "k8s.volume.name" attribute for current resource.
 func WithK8sVolumeName(val string) ResourceMetricsOption {
 	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
@@ -2670,6 +2743,24 @@ func WithK8sVolumeType(val string) ResourceMetricsOption {
 	}
 }
 
+// WithNfsPath sets provided value as "nfs.path" attribute for current resource.
+func WithNfsPath(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.NfsPath.Enabled {
+			rm.Resource().Attributes().PutStr("nfs.path", val)
+		}
+	}
+}
+
+// WithNfsServer sets provided value as "nfs.server" attribute for current resource.
+func WithNfsServer(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.NfsServer.Enabled {
+			rm.Resource().Attributes().PutStr("nfs.server", val)
+		}
+	}
+}
+
 // WithPartition sets provided value as "partition" attribute for current resource.
 func WithPartition(val string) ResourceMetricsOption {
 	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
@@ -226,7 +226,7 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sVolumeInodesUsedDataPoint(ts, 1)
 
-			metrics := mb.Emit(WithAwsVolumeID("attr-val"), WithContainerID("attr-val"), WithFsType("attr-val"), WithGcePdName("attr-val"), WithGlusterfsEndpointsName("attr-val"), WithGlusterfsPath("attr-val"), WithK8sContainerName("attr-val"), WithK8sNamespaceName("attr-val"), WithK8sNodeName("attr-val"), WithK8sPersistentvolumeclaimName("attr-val"), WithK8sPodName("attr-val"), WithK8sPodUID("attr-val"), WithK8sVolumeName("attr-val"), WithK8sVolumeType("attr-val"), WithPartition("attr-val"))
+			metrics := mb.Emit(WithAwsVolumeID("attr-val"), WithContainerID("attr-val"), WithCsiDriver("attr-val"), WithCsiVolumeHandle("attr-val"), WithFsType("attr-val"), WithGcePdName("attr-val"), WithGlusterfsEndpointsName("attr-val"), WithGlusterfsPath("attr-val"), WithK8sContainerName("attr-val"), WithK8sNamespaceName("attr-val"), WithK8sNodeName("attr-val"), WithK8sPersistentvolumeCapacity(1), WithK8sPersistentvolumeName("attr-val"), WithK8sPersistentvolumeclaimName("attr-val"), WithK8sPodName("attr-val"), WithK8sPodUID("attr-val"), WithK8sStorageclassName("attr-val"), WithK8sVolumeName("attr-val"), WithK8sVolumeType("attr-val"), WithNfsPath("attr-val"), WithNfsServer("attr-val"), WithPartition("attr-val"))
 
 			if test.metricsSet == testMetricsSetNo {
 				assert.Equal(t, 0, metrics.ResourceMetrics().Len())
@@ -251,6 +251,20 @@ func TestMetricsBuilder(t *testing.T) {
 				enabledAttrCount++
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
+			attrVal, ok = rm.Resource().Attributes().Get("csi.driver")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.CsiDriver.Enabled, ok)
+			if mb.resourceAttributesSettings.CsiDriver.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("csi.volume.handle")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.CsiVolumeHandle.Enabled, ok)
+			if mb.resourceAttributesSettings.CsiVolumeHandle.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
 			attrVal, ok = rm.Resource().Attributes().Get("fs.type")
 			attrCount++
 			assert.Equal(t, mb.resourceAttributesSettings.FsType.Enabled, ok)
@@ -300,6 +314,20 @@ func TestMetricsBuilder(t *testing.T) {
 				enabledAttrCount++
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
+			attrVal, ok = rm.Resource().Attributes().Get("k8s.persistentvolume.capacity")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.K8sPersistentvolumeCapacity.Enabled, ok)
+			if mb.resourceAttributesSettings.K8sPersistentvolumeCapacity.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, 1, attrVal.Int())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("k8s.persistentvolume.name")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.K8sPersistentvolumeName.Enabled, ok)
+			if mb.resourceAttributesSettings.K8sPersistentvolumeName.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
 			attrVal, ok = rm.Resource().Attributes().Get("k8s.persistentvolumeclaim.name")
 			attrCount++
 			assert.Equal(t, mb.resourceAttributesSettings.K8sPersistentvolumeclaimName.Enabled, ok)
@@ -321,6 +349,13 @@ func TestMetricsBuilder(t *testing.T) {
 				enabledAttrCount++
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
+			attrVal, ok = rm.Resource().Attributes().Get("k8s.storageclass.name")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.K8sStorageclassName.Enabled, ok)
+			if mb.resourceAttributesSettings.K8sStorageclassName.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
 			attrVal, ok = rm.Resource().Attributes().Get("k8s.volume.name")
 			attrCount++
 			assert.Equal(t, mb.resourceAttributesSettings.K8sVolumeName.Enabled, ok)
@@ -335,6 +370,20 @@ func TestMetricsBuilder(t *testing.T) {
 				enabledAttrCount++
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
+			attrVal, ok = rm.Resource().Attributes().Get("nfs.path")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.NfsPath.Enabled, ok)
+			if mb.resourceAttributesSettings.NfsPath.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("nfs.server")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.NfsServer.Enabled, ok)
+			if mb.resourceAttributesSettings.NfsServer.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
 			attrVal, ok = rm.Resource().Attributes().Get("partition")
 			attrCount++
 			assert.Equal(t, mb.resourceAttributesSettings.Partition.Enabled, ok)
@@ -343,7 +392,7 @@ func TestMetricsBuilder(t *testing.T) {
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
 			assert.Equal(t, enabledAttrCount, rm.Resource().Attributes().Len())
-			assert.Equal(t, attrCount, 15)
+			assert.Equal(t, attrCount, 22)
 
 			assert.Equal(t, 1, rm.ScopeMetrics().Len())
 			ms := rm.ScopeMetrics().At(0).Metrics()
@@ -16,6 +16,7 @@ package kubeletstatsreceiver
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -59,6 +60,10 @@ var awsPersistentVolume = func() *v1.PersistentVolume {
 			UID:  "volume_name_1",
 		},
 		Spec: v1.PersistentVolumeSpec{
+			StorageClassName: "gp2",
+			Capacity: v1.ResourceList{
+				v1.ResourceStorage: resource.MustParse("10Gi"),
+			},
 			PersistentVolumeSource: v1.PersistentVolumeSource{
 				AWSElasticBlockStore: &v1.AWSElasticBlockStoreVolumeSource{
 					VolumeID:  "volume_id",
@@ -122,7 +122,7 @@ func (r *kubletScraper) detailedPVCLabelsSetter() func(volCacheID, volumeClaim,
 				return nil, err
 			}
 
-			ro := kubelet.GetPersistentVolumeLabels(pv.Spec.PersistentVolumeSource)
+			ro := kubelet.GetPersistentVolumeClaimLabels(*pvc, *pv)
 
 			// Cache collected labels.
 			r.cachedVolumeLabels[volCacheID] = ro
@@ -210,9 +210,10 @@ func TestScraperWithMetricGroups(t *testing.T) {
 }
 
 type expectedVolume struct {
-	name   string
-	typ    string
-	labels map[string]string
+	name     string
+	typ      string
+	labels   map[string]string
+	capacity int64
 }
 
 func TestScraperWithPVCDetailedLabels(t *testing.T) {
@@ -232,10 +233,13 @@ func TestScraperWithPVCDetailedLabels(t *testing.T) {
 					name: "storage-provisioner-token-qzlx6",
 					typ:  "awsElasticBlockStore",
 					labels: map[string]string{
-						"aws.volume.id": "volume_id",
-						"fs.type":       "fs_type",
-						"partition":     "10",
+						"aws.volume.id":             "volume_id",
+						"fs.type":                   "fs_type",
+						"partition":                 "10",
+						"k8s.persistentvolume.name": "storage-provisioner-token-qzlx6",
+						"k8s.storageclass.name":     "gp2",
 					},
+					capacity: 10 * 1024 * 1024 * 1024,
 				},
 				"volume_claim_2": {
 					name: "kube-proxy",
@@ -276,10 +280,13 @@ func TestScraperWithPVCDetailedLabels(t *testing.T) {
 					name: "storage-provisioner-token-qzlx6",
 					typ:  "awsElasticBlockStore",
 					labels: map[string]string{
-						"aws.volume.id": "volume_id",
-						"fs.type":       "fs_type",
-						"partition":     "10",
+						"aws.volume.id":             "volume_id",
+						"fs.type":                   "fs_type",
+						"partition":                 "10",
+						"k8s.persistentvolume.name": "storage-provisioner-token-qzlx6",
+						"k8s.storageclass.name":     "gp2",
 					},
+					capacity: 10 * 1024 * 1024 * 1024,
 				},
 				"volume_claim_2": {
 					name: "kube-proxy",
@@ -307,10 +314,13 @@ func TestScraperWithPVCDetailedLabels(t *testing.T) {
 					name: "storage-provisioner-token-qzlx6",
 					typ:  "awsElasticBlockStore",
 					labels: map[string]string{
-						"aws.volume.id": "volume_id",
-						"fs.type":       "fs_type",
-						"partition":     "10",
+						"aws.volume.id":             "volume_id",
+						"fs.type":                   "fs_type",
+						"partition":                 "10",
+						"k8s.persistentvolume.name": "storage-provisioner-token-qzlx6",
+						"k8s.storageclass.name":     "gp2",
 					},
+					capacity: 10 * 1024 * 1024 * 1024,
 				},
 				"volume_claim_2": {
 					name: "kube-proxy",
@@ -393,6 +403,11 @@ func requireExpectedVolume(t *testing.T, ev expectedVolume, resource pcommon.Res
 	for k, v := range ev.labels {
 		requireAttribute(t, resource.Attributes(), k, v)
 	}
+	if ev.capacity != 0 {
+		val, ok := resource.Attributes().Get("k8s.persistentvolume.capacity")
+		require.True(t, ok)
+		require.Equal(t, ev.capacity, val.Int())
+	}
 }
 
 func requireAttribute(t *testing.T, attr pcommon.Map, key string, value string) {
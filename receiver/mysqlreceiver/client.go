@@ -33,6 +33,8 @@ type client interface {
 	getStatementEventsStats() ([]StatementEventStats, error)
 	getTableLockWaitEventStats() ([]tableLockWaitEventStats, error)
 	getReplicaStatusStats() ([]ReplicaStatusStats, error)
+	getQueryDigestStats() ([]queryDigestStats, error)
+	getLongRunningQueries(threshold time.Duration) ([]runningQuery, error)
 	Close() error
 }
 
@@ -83,6 +85,24 @@ type StatementEventStats struct {
 	countNoIndexUsed          int64
 }
 
+// queryDigestStats contains the cumulative execution statistics of a normalized statement
+type queryDigestStats struct {
+	schema       string
+	digest       string
+	digestText   string
+	countStar    int64
+	sumTimerWait int64
+}
+
+// runningQuery is a statement currently being executed by a client connection
+type runningQuery struct {
+	user      string
+	schema    string
+	digest    string
+	queryText string
+	timerWait int64
+}
+
 type tableLockWaitEventStats struct {
 	schema                        string
 	name                          string
@@ -305,6 +325,67 @@ func (c *mySQLClient) getStatementEventsStats() ([]StatementEventStats, error) {
 	return stats, nil
 }
 
+func (c *mySQLClient) getQueryDigestStats() ([]queryDigestStats, error) {
+	query := fmt.Sprintf("SELECT ifnull(SCHEMA_NAME, 'NONE') as SCHEMA_NAME, DIGEST, "+
+		"LEFT(DIGEST_TEXT, %d) as DIGEST_TEXT, COUNT_STAR, SUM_TIMER_WAIT "+
+		"FROM performance_schema.events_statements_summary_by_digest "+
+		"WHERE DIGEST IS NOT NULL "+
+		"AND SCHEMA_NAME NOT IN ('mysql', 'performance_schema', 'information_schema')",
+		c.statementEventsDigestTextLimit)
+
+	rows, err := c.client.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []queryDigestStats
+	for rows.Next() {
+		var s queryDigestStats
+		if err := rows.Scan(&s.schema, &s.digest, &s.digestText, &s.countStar, &s.sumTimerWait); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
+func (c *mySQLClient) getLongRunningQueries(threshold time.Duration) ([]runningQuery, error) {
+	// The digest text of a statement is only set in events_statements_current once it ends,
+	// so it's looked up in the digest summary. The raw SQL text is never read, as it contains
+	// the literals of the query.
+	query := fmt.Sprintf("SELECT ifnull(t.PROCESSLIST_USER, ''), ifnull(t.PROCESSLIST_DB, 'NONE'), "+
+		"ifnull(s.DIGEST, ''), LEFT(ifnull(s.DIGEST_TEXT, ifnull(d.DIGEST_TEXT, '')), %d), s.TIMER_WAIT "+
+		"FROM performance_schema.events_statements_current s "+
+		"JOIN performance_schema.threads t ON s.THREAD_ID = t.THREAD_ID "+
+		"LEFT JOIN performance_schema.events_statements_summary_by_digest d "+
+		"ON d.DIGEST = s.DIGEST AND d.SCHEMA_NAME <=> s.CURRENT_SCHEMA "+
+		"WHERE t.PROCESSLIST_ID IS NOT NULL "+
+		"AND t.PROCESSLIST_ID <> CONNECTION_ID() "+
+		"AND s.END_EVENT_ID IS NULL "+
+		"AND s.TIMER_WAIT >= %d",
+		c.statementEventsDigestTextLimit,
+		threshold.Nanoseconds()*picosecondsInNanoseconds)
+
+	rows, err := c.client.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var queries []runningQuery
+	for rows.Next() {
+		var q runningQuery
+		if err := rows.Scan(&q.user, &q.schema, &q.digest, &q.queryText, &q.timerWait); err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+
+	return queries, nil
+}
+
 func (c *mySQLClient) getTableLockWaitEventStats() ([]tableLockWaitEventStats, error) {
 	query := "SELECT OBJECT_SCHEMA, OBJECT_NAME, COUNT_READ_NORMAL, COUNT_READ_WITH_SHARED_LOCKS," +
 		"COUNT_READ_HIGH_PRIORITY, COUNT_READ_NO_INSERT, COUNT_READ_EXTERNAL, COUNT_WRITE_ALLOW_WRITE," +
@@ -27,6 +27,8 @@ const (
 	defaultStatementEventsDigestTextLimit = 120
 	defaultStatementEventsLimit           = 250
 	defaultStatementEventsTimeLimit       = 24 * time.Hour
+	defaultTopN                           = 10
+	defaultLongRunningQueryThreshold      = 10 * time.Second
 )
 
 type Config struct {
@@ -38,6 +40,7 @@ type Config struct {
 	confignet.NetAddr                       `mapstructure:",squash"`
 	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
 	StatementEvents                         StatementEventsConfig    `mapstructure:"statement_events"`
+	QueryLogs                               QueryLogsConfig          `mapstructure:"query_logs"`
 }
 
 type StatementEventsConfig struct {
@@ -45,3 +48,13 @@ type StatementEventsConfig struct {
 	Limit           int           `mapstructure:"limit"`
 	TimeLimit       time.Duration `mapstructure:"time_limit"`
 }
+
+// QueryLogsConfig configures the query log records emitted when the receiver is used in a logs pipeline.
+type QueryLogsConfig struct {
+	// TopN is the number of queries with the highest total execution time since the
+	// previous collection that are emitted. A value of 0 disables top query log records.
+	TopN int `mapstructure:"top_n"`
+	// LongRunningQueryThreshold is the minimum time a query must have been running for to be
+	// emitted as a long running query. A value of 0 disables long running query log records.
+	LongRunningQueryThreshold time.Duration `mapstructure:"long_running_query_threshold"`
+}
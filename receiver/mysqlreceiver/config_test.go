@@ -41,6 +41,10 @@ func TestLoadConfig(t *testing.T) {
 	expected.Password = "${env:MYSQL_PASSWORD}"
 	expected.Database = "otel"
 	expected.CollectionInterval = 10 * time.Second
+	expected.QueryLogs = QueryLogsConfig{
+		TopN:                      20,
+		LongRunningQueryThreshold: 30 * time.Second,
+	}
 
 	require.Equal(t, expected, cfg)
 }
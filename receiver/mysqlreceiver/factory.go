@@ -28,15 +28,17 @@ import (
 )
 
 const (
-	typeStr   = "mysql"
-	stability = component.StabilityLevelBeta
+	typeStr       = "mysql"
+	stability     = component.StabilityLevelBeta
+	logsStability = component.StabilityLevelDevelopment
 )
 
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, stability))
+		receiver.WithMetrics(createMetricsReceiver, stability),
+		receiver.WithLogs(createLogsReceiver, logsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -56,6 +58,10 @@ func createDefaultConfig() component.Config {
 			Limit:           defaultStatementEventsLimit,
 			TimeLimit:       defaultStatementEventsTimeLimit,
 		},
+		QueryLogs: QueryLogsConfig{
+			TopN:                      defaultTopN,
+			LongRunningQueryThreshold: defaultLongRunningQueryThreshold,
+		},
 	}
 }
 
@@ -80,3 +86,13 @@ func createMetricsReceiver(
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg := rConf.(*Config)
+	return newMySQLLogsReceiver(params, cfg, consumer), nil
+}
@@ -0,0 +1,218 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysqlreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mysqlreceiver"
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	topQueryEventName         = "db.top_query"
+	longRunningQueryEventName = "db.long_running_query"
+)
+
+// mySQLLogsReceiver periodically emits the most expensive queries since the previous collection
+// and the queries that have been running for longer than a threshold as log records.
+type mySQLLogsReceiver struct {
+	logger    *zap.Logger
+	config    *Config
+	consumer  consumer.Logs
+	buildInfo component.BuildInfo
+	newClient func(*Config) client
+	sqlclient client
+
+	// previousDigests holds the cumulative statistics of each digest, keyed by schema and digest,
+	// as of the previous collection. It is nil until the first collection.
+	previousDigests map[string]queryDigestStats
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newMySQLLogsReceiver(settings receiver.CreateSettings, config *Config, consumer consumer.Logs) *mySQLLogsReceiver {
+	return &mySQLLogsReceiver{
+		logger:    settings.Logger,
+		config:    config,
+		consumer:  consumer,
+		buildInfo: settings.BuildInfo,
+		newClient: newMySQLClient,
+	}
+}
+
+// Start connects to the database and begins collecting on the configured interval.
+func (r *mySQLLogsReceiver) Start(_ context.Context, _ component.Host) error {
+	sqlclient := r.newClient(r.config)
+	if err := sqlclient.Connect(); err != nil {
+		return err
+	}
+	r.sqlclient = sqlclient
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.CollectionInterval)
+		defer ticker.Stop()
+
+		for {
+			r.collect(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the collection and closes the db connection.
+func (r *mySQLLogsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.sqlclient == nil {
+		return nil
+	}
+	return r.sqlclient.Close()
+}
+
+func (r *mySQLLogsReceiver) collect(ctx context.Context) {
+	logs, err := r.buildLogs(time.Now())
+	if err != nil {
+		r.logger.Error("Failed to collect query logs", zap.Error(err))
+	}
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		r.logger.Error("Failed to consume query logs", zap.Error(err))
+	}
+}
+
+func (r *mySQLLogsReceiver) buildLogs(now time.Time) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("mysql.instance.endpoint", r.config.Endpoint)
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("otelcol/mysqlreceiver")
+	sl.Scope().SetVersion(r.buildInfo.Version)
+
+	ts := pcommon.NewTimestampFromTime(now)
+	var errs error
+	if r.config.QueryLogs.TopN > 0 {
+		errs = multierr.Append(errs, r.recordTopQueries(ts, sl.LogRecords()))
+	}
+	if r.config.QueryLogs.LongRunningQueryThreshold > 0 {
+		errs = multierr.Append(errs, r.recordLongRunningQueries(ts, sl.LogRecords()))
+	}
+	return logs, errs
+}
+
+// recordTopQueries records the queries with the highest total execution time since the previous collection.
+// The first collection only establishes the baseline.
+func (r *mySQLLogsReceiver) recordTopQueries(ts pcommon.Timestamp, records plog.LogRecordSlice) error {
+	stats, err := r.sqlclient.getQueryDigestStats()
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]queryDigestStats, len(stats))
+	var deltas []queryDigestStats
+	for _, s := range stats {
+		key := s.schema + "|" + s.digest
+		current[key] = s
+		if r.previousDigests == nil {
+			continue
+		}
+		delta := s
+		// A digest whose count decreased has been reset, in which case the current values are the delta.
+		if prev, ok := r.previousDigests[key]; ok && s.countStar >= prev.countStar {
+			delta.countStar -= prev.countStar
+			delta.sumTimerWait -= prev.sumTimerWait
+		}
+		if delta.countStar > 0 {
+			deltas = append(deltas, delta)
+		}
+	}
+	r.previousDigests = current
+
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].sumTimerWait > deltas[j].sumTimerWait
+	})
+	if len(deltas) > r.config.QueryLogs.TopN {
+		deltas = deltas[:r.config.QueryLogs.TopN]
+	}
+
+	for _, d := range deltas {
+		lr := newQueryLogRecord(records, ts, topQueryEventName, d.digestText)
+		attrs := lr.Attributes()
+		attrs.PutStr("db.name", d.schema)
+		attrs.PutStr("db.query.digest", d.digest)
+		attrs.PutInt("db.query.calls", d.countStar)
+		attrs.PutDouble("db.query.duration", picosecondsToMilliseconds(d.sumTimerWait))
+	}
+	return nil
+}
+
+func (r *mySQLLogsReceiver) recordLongRunningQueries(ts pcommon.Timestamp, records plog.LogRecordSlice) error {
+	queries, err := r.sqlclient.getLongRunningQueries(r.config.QueryLogs.LongRunningQueryThreshold)
+	if err != nil {
+		return err
+	}
+
+	for _, q := range queries {
+		lr := newQueryLogRecord(records, ts, longRunningQueryEventName, q.queryText)
+		attrs := lr.Attributes()
+		attrs.PutStr("db.name", q.schema)
+		attrs.PutStr("db.user", q.user)
+		if q.digest != "" {
+			attrs.PutStr("db.query.digest", q.digest)
+		}
+		attrs.PutDouble("db.query.duration", picosecondsToMilliseconds(q.timerWait))
+	}
+	return nil
+}
+
+func newQueryLogRecord(records plog.LogRecordSlice, ts pcommon.Timestamp, eventName string, query string) plog.LogRecord {
+	lr := records.AppendEmpty()
+	lr.SetTimestamp(ts)
+	lr.SetObservedTimestamp(ts)
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	if query != "" {
+		lr.Body().SetStr(query)
+	}
+	lr.Attributes().PutStr("event.name", eventName)
+	lr.Attributes().PutStr("db.system", "mysql")
+	return lr
+}
+
+func picosecondsToMilliseconds(picoseconds int64) float64 {
+	return float64(picoseconds) / 1e9
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysqlreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestBuildLogsTopQueries(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.QueryLogs.TopN = 2
+	cfg.QueryLogs.LongRunningQueryThreshold = 0
+
+	mc := &mockClient{
+		queryDigests: []queryDigestStats{
+			{schema: "otel", digest: "a", digestText: "SELECT * FROM `a`", countStar: 10, sumTimerWait: 10e9},
+			{schema: "otel", digest: "b", digestText: "SELECT * FROM `b`", countStar: 10, sumTimerWait: 10e9},
+			{schema: "otel", digest: "c", digestText: "SELECT * FROM `c`", countStar: 10, sumTimerWait: 10e9},
+		},
+	}
+	r := newMySQLLogsReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	r.sqlclient = mc
+
+	// the first collection only establishes the baseline
+	logs, err := r.buildLogs(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.LogRecordCount())
+
+	mc.queryDigests = []queryDigestStats{
+		// unchanged since the previous collection
+		{schema: "otel", digest: "a", digestText: "SELECT * FROM `a`", countStar: 10, sumTimerWait: 10e9},
+		{schema: "otel", digest: "b", digestText: "SELECT * FROM `b`", countStar: 12, sumTimerWait: 15e9},
+		// reset since the previous collection
+		{schema: "otel", digest: "c", digestText: "SELECT * FROM `c`", countStar: 1, sumTimerWait: 2e9},
+		{schema: "otel", digest: "d", digestText: "SELECT * FROM `d`", countStar: 3, sumTimerWait: 1e9},
+	}
+	logs, err = r.buildLogs(time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	rl := logs.ResourceLogs().At(0)
+	endpoint, ok := rl.Resource().Attributes().Get("mysql.instance.endpoint")
+	require.True(t, ok)
+	assert.Equal(t, "localhost:3306", endpoint.Str())
+
+	records := rl.ScopeLogs().At(0).LogRecords()
+	assertQueryRecord(t, records.At(0), topQueryEventName, "SELECT * FROM `b`", map[string]interface{}{
+		"db.system":         "mysql",
+		"db.name":           "otel",
+		"db.query.digest":   "b",
+		"db.query.calls":    int64(2),
+		"db.query.duration": 5.0,
+	})
+	assertQueryRecord(t, records.At(1), topQueryEventName, "SELECT * FROM `c`", map[string]interface{}{
+		"db.system":         "mysql",
+		"db.name":           "otel",
+		"db.query.digest":   "c",
+		"db.query.calls":    int64(1),
+		"db.query.duration": 2.0,
+	})
+}
+
+func TestBuildLogsLongRunningQueries(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.QueryLogs.TopN = 0
+
+	r := newMySQLLogsReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	r.sqlclient = &mockClient{
+		runningQueries: []runningQuery{
+			{user: "otel", schema: "otel", digest: "a", queryText: "SELECT SLEEP(?)", timerWait: 30e12},
+			// The digest of a statement isn't known yet while it's being parsed.
+			{user: "otel", schema: "otel", timerWait: 20e12},
+		},
+	}
+
+	logs, err := r.buildLogs(time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+	assertQueryRecord(t, logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0), longRunningQueryEventName, "SELECT SLEEP(?)", map[string]interface{}{
+		"db.system":         "mysql",
+		"db.name":           "otel",
+		"db.user":           "otel",
+		"db.query.digest":   "a",
+		"db.query.duration": 30000.0,
+	})
+	assertQueryRecord(t, logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1), longRunningQueryEventName, "", map[string]interface{}{
+		"db.system":         "mysql",
+		"db.name":           "otel",
+		"db.user":           "otel",
+		"db.query.duration": 20000.0,
+	})
+}
+
+func TestLogsReceiverStartShutdown(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CollectionInterval = 10 * time.Millisecond
+
+	sink := new(consumertest.LogsSink)
+	r := newMySQLLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	r.newClient = func(*Config) client {
+		return &mockClient{
+			runningQueries: []runningQuery{
+				{user: "otel", schema: "otel", queryText: "SELECT SLEEP(?)", timerWait: 30e12},
+			},
+		}
+	}
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() > 1
+	}, 2*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func assertQueryRecord(t *testing.T, lr plog.LogRecord, eventName string, query string, attrs map[string]interface{}) {
+	assert.Equal(t, query, lr.Body().Str())
+	expected := map[string]interface{}{"event.name": eventName}
+	for k, v := range attrs {
+		expected[k] = v
+	}
+	assert.Equal(t, expected, lr.Attributes().AsRaw())
+}
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -141,6 +142,8 @@ type mockClient struct {
 	statementEventsFile         string
 	tableLockWaitEventStatsFile string
 	replicaStatusFile           string
+	queryDigests                []queryDigestStats
+	runningQueries              []runningQuery
 }
 
 func readFile(fname string) (map[string]string, error) {
@@ -385,6 +388,14 @@ func (c *mockClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 	return stats, nil
 }
 
+func (c *mockClient) getQueryDigestStats() ([]queryDigestStats, error) {
+	return c.queryDigests, nil
+}
+
+func (c *mockClient) getLongRunningQueries(time.Duration) ([]runningQuery, error) {
+	return c.runningQueries, nil
+}
+
 func (c *mockClient) Close() error {
 	return nil
 }
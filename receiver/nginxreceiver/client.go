@@ -0,0 +1,103 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/model"
+)
+
+// plusClient retrieves the statistics of the NGINX Plus API.
+type plusClient struct {
+	httpClient *http.Client
+	// endpoint is the URL of the API, including the version.
+	endpoint string
+}
+
+func newPlusClient(httpClient *http.Client, endpoint string, version int) *plusClient {
+	return &plusClient{
+		httpClient: httpClient,
+		endpoint:   strings.TrimSuffix(endpoint, "/") + "/" + strconv.Itoa(version),
+	}
+}
+
+// getStats retrieves the server zones, upstreams, caches and SSL statistics.
+func (c *plusClient) getStats(ctx context.Context) (*model.PlusStats, error) {
+	stats := &model.PlusStats{}
+	if err := getJSON(ctx, c.httpClient, c.endpoint+"/http/server_zones", &stats.ServerZones); err != nil {
+		return nil, err
+	}
+	if err := getJSON(ctx, c.httpClient, c.endpoint+"/http/upstreams", &stats.Upstreams); err != nil {
+		return nil, err
+	}
+	if err := getJSON(ctx, c.httpClient, c.endpoint+"/http/caches", &stats.Caches); err != nil {
+		return nil, err
+	}
+	if err := getJSON(ctx, c.httpClient, c.endpoint+"/ssl", &stats.SSL); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// vtsClient retrieves the statistics of the nginx-module-vts module.
+type vtsClient struct {
+	httpClient *http.Client
+	endpoint   string
+}
+
+func newVTSClient(httpClient *http.Client, endpoint string) *vtsClient {
+	return &vtsClient{
+		httpClient: httpClient,
+		endpoint:   endpoint,
+	}
+}
+
+func (c *vtsClient) getStats(ctx context.Context) (*model.VTSStats, error) {
+	stats := &model.VTSStats{}
+	if err := getJSON(ctx, c.httpClient, c.endpoint, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// getJSON retrieves the URL and decodes the JSON response into v.
+func getJSON(ctx context.Context, httpClient *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request for %s: %w", url, err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// Drain the body so that the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return fmt.Errorf("expected 200 response from %s, got %d", url, resp.StatusCode)
+	}
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+	return nil
+}
@@ -15,6 +15,10 @@
 package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
@@ -24,5 +28,44 @@ import (
 type Config struct {
 	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
 	confighttp.HTTPClientSettings           `mapstructure:",squash"`
-	Metrics                                 metadata.MetricsSettings `mapstructure:"metrics"`
+	// PlusAPI configures the collection of the metrics of the NGINX Plus API.
+	PlusAPI PlusAPIConfig `mapstructure:"plus_api"`
+	// VTS configures the collection of the metrics of the nginx-module-vts module.
+	VTS     VTSConfig                `mapstructure:"vts"`
+	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+}
+
+type PlusAPIConfig struct {
+	// Endpoint is the URL of the NGINX Plus API, e.g. http://localhost:8080/api.
+	// The metrics of the NGINX Plus API are not collected when empty.
+	Endpoint string `mapstructure:"endpoint"`
+	// Version is the version of the NGINX Plus API.
+	Version int `mapstructure:"version"`
+}
+
+type VTSConfig struct {
+	// Endpoint is the URL of the JSON status of the nginx-module-vts module,
+	// e.g. http://localhost:80/status/format/json. The metrics of the module
+	// are not collected when empty.
+	Endpoint string `mapstructure:"endpoint"`
+}
+
+var errInvalidPlusAPIVersion = errors.New("plus_api version must be greater than 0")
+
+// Validate checks the endpoints of the NGINX Plus API and of the VTS module.
+func (cfg *Config) Validate() error {
+	if cfg.PlusAPI.Endpoint != "" {
+		if _, err := url.ParseRequestURI(cfg.PlusAPI.Endpoint); err != nil {
+			return fmt.Errorf("invalid plus_api endpoint: %w", err)
+		}
+		if cfg.PlusAPI.Version <= 0 {
+			return errInvalidPlusAPIVersion
+		}
+	}
+	if cfg.VTS.Endpoint != "" {
+		if _, err := url.ParseRequestURI(cfg.VTS.Endpoint); err != nil {
+			return fmt.Errorf("invalid vts endpoint: %w", err)
+		}
+	}
+	return nil
 }
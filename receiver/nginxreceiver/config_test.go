@@ -35,4 +35,68 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+
+	cfg = factory.CreateDefaultConfig()
+	sub, err = cm.Sub(component.NewIDWithName(typeStr, "extended").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.PlusAPI = PlusAPIConfig{
+		Endpoint: "http://localhost:8080/api",
+		Version:  6,
+	}
+	expected.VTS = VTSConfig{
+		Endpoint: "http://localhost:80/vts/format/json",
+	}
+	assert.Equal(t, expected, cfg)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		mutate      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			desc:   "default config",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			desc: "invalid plus_api endpoint",
+			mutate: func(cfg *Config) {
+				cfg.PlusAPI.Endpoint = "://localhost:8080/api"
+			},
+			expectedErr: "invalid plus_api endpoint",
+		},
+		{
+			desc: "invalid plus_api version",
+			mutate: func(cfg *Config) {
+				cfg.PlusAPI.Endpoint = "http://localhost:8080/api"
+				cfg.PlusAPI.Version = 0
+			},
+			expectedErr: errInvalidPlusAPIVersion.Error(),
+		},
+		{
+			desc: "invalid vts endpoint",
+			mutate: func(cfg *Config) {
+				cfg.VTS.Endpoint = "status/format/json"
+			},
+			expectedErr: "invalid vts endpoint",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tc.mutate(cfg)
+			err := cfg.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
 }
@@ -30,6 +30,9 @@ import (
 const (
 	typeStr   = "nginx"
 	stability = component.StabilityLevelBeta
+
+	// defaultPlusAPIVersion is the version of the NGINX Plus API since NGINX Plus R27.
+	defaultPlusAPIVersion = 8
 )
 
 // NewFactory creates a factory for nginx receiver.
@@ -49,6 +52,9 @@ func createDefaultConfig() component.Config {
 			Endpoint: "http://localhost:80/status",
 			Timeout:  10 * time.Second,
 		},
+		PlusAPI: PlusAPIConfig{
+			Version: defaultPlusAPIVersion,
+		},
 		Metrics: metadata.DefaultMetricsSettings(),
 	}
 }
@@ -33,14 +33,40 @@ func (ms *MetricSettings) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsSettings provides settings for nginxreceiver metrics.
 type MetricsSettings struct {
-	NginxConnectionsAccepted MetricSettings `mapstructure:"nginx.connections_accepted"`
-	NginxConnectionsCurrent  MetricSettings `mapstructure:"nginx.connections_current"`
-	NginxConnectionsHandled  MetricSettings `mapstructure:"nginx.connections_handled"`
-	NginxRequests            MetricSettings `mapstructure:"nginx.requests"`
+	NginxCacheHitRatio            MetricSettings `mapstructure:"nginx.cache.hit_ratio"`
+	NginxCacheResponses           MetricSettings `mapstructure:"nginx.cache.responses"`
+	NginxCacheSize                MetricSettings `mapstructure:"nginx.cache.size"`
+	NginxConnectionsAccepted      MetricSettings `mapstructure:"nginx.connections_accepted"`
+	NginxConnectionsCurrent       MetricSettings `mapstructure:"nginx.connections_current"`
+	NginxConnectionsHandled       MetricSettings `mapstructure:"nginx.connections_handled"`
+	NginxRequests                 MetricSettings `mapstructure:"nginx.requests"`
+	NginxServerZoneIo             MetricSettings `mapstructure:"nginx.server_zone.io"`
+	NginxServerZoneRequests       MetricSettings `mapstructure:"nginx.server_zone.requests"`
+	NginxServerZoneResponses      MetricSettings `mapstructure:"nginx.server_zone.responses"`
+	NginxSslHandshakes            MetricSettings `mapstructure:"nginx.ssl.handshakes"`
+	NginxSslSessionReuses         MetricSettings `mapstructure:"nginx.ssl.session_reuses"`
+	NginxUpstreamPeerConnections  MetricSettings `mapstructure:"nginx.upstream.peer.connections"`
+	NginxUpstreamPeerFails        MetricSettings `mapstructure:"nginx.upstream.peer.fails"`
+	NginxUpstreamPeerHeaderTime   MetricSettings `mapstructure:"nginx.upstream.peer.header_time"`
+	NginxUpstreamPeerHealthChecks MetricSettings `mapstructure:"nginx.upstream.peer.health_checks"`
+	NginxUpstreamPeerIo           MetricSettings `mapstructure:"nginx.upstream.peer.io"`
+	NginxUpstreamPeerRequests     MetricSettings `mapstructure:"nginx.upstream.peer.requests"`
+	NginxUpstreamPeerResponseTime MetricSettings `mapstructure:"nginx.upstream.peer.response_time"`
+	NginxUpstreamPeerResponses    MetricSettings `mapstructure:"nginx.upstream.peer.responses"`
+	NginxUpstreamPeerState        MetricSettings `mapstructure:"nginx.upstream.peer.state"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
 	return MetricsSettings{
+		NginxCacheHitRatio: MetricSettings{
+			Enabled: true,
+		},
+		NginxCacheResponses: MetricSettings{
+			Enabled: true,
+		},
+		NginxCacheSize: MetricSettings{
+			Enabled: true,
+		},
 		NginxConnectionsAccepted: MetricSettings{
 			Enabled: true,
 		},
@@ -53,87 +79,1176 @@ func DefaultMetricsSettings() MetricsSettings {
 		NginxRequests: MetricSettings{
 			Enabled: true,
 		},
+		NginxServerZoneIo: MetricSettings{
+			Enabled: true,
+		},
+		NginxServerZoneRequests: MetricSettings{
+			Enabled: true,
+		},
+		NginxServerZoneResponses: MetricSettings{
+			Enabled: true,
+		},
+		NginxSslHandshakes: MetricSettings{
+			Enabled: true,
+		},
+		NginxSslSessionReuses: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerConnections: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerFails: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerHeaderTime: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerHealthChecks: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerIo: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerRequests: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerResponseTime: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerResponses: MetricSettings{
+			Enabled: true,
+		},
+		NginxUpstreamPeerState: MetricSettings{
+			Enabled: true,
+		},
+	}
+}
+
+// ResourceAttributeSettings provides common settings for a particular metric.
+type ResourceAttributeSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	enabledProvidedByUser bool
+}
+
+func (ras *ResourceAttributeSettings) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+	err := parser.Unmarshal(ras, confmap.WithErrorUnused())
+	if err != nil {
+		return err
+	}
+	ras.enabledProvidedByUser = parser.IsSet("enabled")
+	return nil
+}
+
+// ResourceAttributesSettings provides settings for nginxreceiver metrics.
+type ResourceAttributesSettings struct {
+}
+
+func DefaultResourceAttributesSettings() ResourceAttributesSettings {
+	return ResourceAttributesSettings{}
+}
+
+// AttributeCacheStatus specifies the a value cache_status attribute.
+type AttributeCacheStatus int
+
+const (
+	_ AttributeCacheStatus = iota
+	AttributeCacheStatusHit
+	AttributeCacheStatusStale
+	AttributeCacheStatusUpdating
+	AttributeCacheStatusRevalidated
+	AttributeCacheStatusMiss
+	AttributeCacheStatusExpired
+	AttributeCacheStatusBypass
+	AttributeCacheStatusScarce
+)
+
+// String returns the string representation of the AttributeCacheStatus.
+func (av AttributeCacheStatus) String() string {
+	switch av {
+	case AttributeCacheStatusHit:
+		return "hit"
+	case AttributeCacheStatusStale:
+		return "stale"
+	case AttributeCacheStatusUpdating:
+		return "updating"
+	case AttributeCacheStatusRevalidated:
+		return "revalidated"
+	case AttributeCacheStatusMiss:
+		return "miss"
+	case AttributeCacheStatusExpired:
+		return "expired"
+	case AttributeCacheStatusBypass:
+		return "bypass"
+	case AttributeCacheStatusScarce:
+		return "scarce"
+	}
+	return ""
+}
+
+// MapAttributeCacheStatus is a helper map of string to AttributeCacheStatus attribute value.
+var MapAttributeCacheStatus = map[string]AttributeCacheStatus{
+	"hit":         AttributeCacheStatusHit,
+	"stale":       AttributeCacheStatusStale,
+	"updating":    AttributeCacheStatusUpdating,
+	"revalidated": AttributeCacheStatusRevalidated,
+	"miss":        AttributeCacheStatusMiss,
+	"expired":     AttributeCacheStatusExpired,
+	"bypass":      AttributeCacheStatusBypass,
+	"scarce":      AttributeCacheStatusScarce,
+}
+
+// AttributeDirection specifies the a value direction attribute.
+type AttributeDirection int
+
+const (
+	_ AttributeDirection = iota
+	AttributeDirectionReceived
+	AttributeDirectionSent
+)
+
+// String returns the string representation of the AttributeDirection.
+func (av AttributeDirection) String() string {
+	switch av {
+	case AttributeDirectionReceived:
+		return "received"
+	case AttributeDirectionSent:
+		return "sent"
+	}
+	return ""
+}
+
+// MapAttributeDirection is a helper map of string to AttributeDirection attribute value.
+var MapAttributeDirection = map[string]AttributeDirection{
+	"received": AttributeDirectionReceived,
+	"sent":     AttributeDirectionSent,
+}
+
+// AttributeHandshakeResult specifies the a value handshake_result attribute.
+type AttributeHandshakeResult int
+
+const (
+	_ AttributeHandshakeResult = iota
+	AttributeHandshakeResultSuccessful
+	AttributeHandshakeResultFailed
+)
+
+// String returns the string representation of the AttributeHandshakeResult.
+func (av AttributeHandshakeResult) String() string {
+	switch av {
+	case AttributeHandshakeResultSuccessful:
+		return "successful"
+	case AttributeHandshakeResultFailed:
+		return "failed"
+	}
+	return ""
+}
+
+// MapAttributeHandshakeResult is a helper map of string to AttributeHandshakeResult attribute value.
+var MapAttributeHandshakeResult = map[string]AttributeHandshakeResult{
+	"successful": AttributeHandshakeResultSuccessful,
+	"failed":     AttributeHandshakeResultFailed,
+}
+
+// AttributeHealthCheckResult specifies the a value health_check_result attribute.
+type AttributeHealthCheckResult int
+
+const (
+	_ AttributeHealthCheckResult = iota
+	AttributeHealthCheckResultPassed
+	AttributeHealthCheckResultFailed
+)
+
+// String returns the string representation of the AttributeHealthCheckResult.
+func (av AttributeHealthCheckResult) String() string {
+	switch av {
+	case AttributeHealthCheckResultPassed:
+		return "passed"
+	case AttributeHealthCheckResultFailed:
+		return "failed"
+	}
+	return ""
+}
+
+// MapAttributeHealthCheckResult is a helper map of string to AttributeHealthCheckResult attribute value.
+var MapAttributeHealthCheckResult = map[string]AttributeHealthCheckResult{
+	"passed": AttributeHealthCheckResultPassed,
+	"failed": AttributeHealthCheckResultFailed,
+}
+
+// AttributePeerState specifies the a value peer_state attribute.
+type AttributePeerState int
+
+const (
+	_ AttributePeerState = iota
+	AttributePeerStateUp
+	AttributePeerStateDown
+	AttributePeerStateUnavail
+	AttributePeerStateChecking
+	AttributePeerStateUnhealthy
+	AttributePeerStateDraining
+)
+
+// String returns the string representation of the AttributePeerState.
+func (av AttributePeerState) String() string {
+	switch av {
+	case AttributePeerStateUp:
+		return "up"
+	case AttributePeerStateDown:
+		return "down"
+	case AttributePeerStateUnavail:
+		return "unavail"
+	case AttributePeerStateChecking:
+		return "checking"
+	case AttributePeerStateUnhealthy:
+		return "unhealthy"
+	case AttributePeerStateDraining:
+		return "draining"
+	}
+	return ""
+}
+
+// MapAttributePeerState is a helper map of string to AttributePeerState attribute value.
+var MapAttributePeerState = map[string]AttributePeerState{
+	"up":        AttributePeerStateUp,
+	"down":      AttributePeerStateDown,
+	"unavail":   AttributePeerStateUnavail,
+	"checking":  AttributePeerStateChecking,
+	"unhealthy": AttributePeerStateUnhealthy,
+	"draining":  AttributePeerStateDraining,
+}
+
+// AttributeState specifies the a value state attribute.
+type AttributeState int
+
+const (
+	_ AttributeState = iota
+	AttributeStateActive
+	AttributeStateReading
+	AttributeStateWriting
+	AttributeStateWaiting
+)
+
+// String returns the string representation of the AttributeState.
+func (av AttributeState) String() string {
+	switch av {
+	case AttributeStateActive:
+		return "active"
+	case AttributeStateReading:
+		return "reading"
+	case AttributeStateWriting:
+		return "writing"
+	case AttributeStateWaiting:
+		return "waiting"
+	}
+	return ""
+}
+
+// MapAttributeState is a helper map of string to AttributeState attribute value.
+var MapAttributeState = map[string]AttributeState{
+	"active":  AttributeStateActive,
+	"reading": AttributeStateReading,
+	"writing": AttributeStateWriting,
+	"waiting": AttributeStateWaiting,
+}
+
+// AttributeStatusRange specifies the a value status_range attribute.
+type AttributeStatusRange int
+
+const (
+	_ AttributeStatusRange = iota
+	AttributeStatusRange1xx
+	AttributeStatusRange2xx
+	AttributeStatusRange3xx
+	AttributeStatusRange4xx
+	AttributeStatusRange5xx
+)
+
+// String returns the string representation of the AttributeStatusRange.
+func (av AttributeStatusRange) String() string {
+	switch av {
+	case AttributeStatusRange1xx:
+		return "1xx"
+	case AttributeStatusRange2xx:
+		return "2xx"
+	case AttributeStatusRange3xx:
+		return "3xx"
+	case AttributeStatusRange4xx:
+		return "4xx"
+	case AttributeStatusRange5xx:
+		return "5xx"
+	}
+	return ""
+}
+
+// MapAttributeStatusRange is a helper map of string to AttributeStatusRange attribute value.
+var MapAttributeStatusRange = map[string]AttributeStatusRange{
+	"1xx": AttributeStatusRange1xx,
+	"2xx": AttributeStatusRange2xx,
+	"3xx": AttributeStatusRange3xx,
+	"4xx": AttributeStatusRange4xx,
+	"5xx": AttributeStatusRange5xx,
+}
+
+type metricNginxCacheHitRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.cache.hit_ratio metric with initial data.
+func (m *metricNginxCacheHitRatio) init() {
+	m.data.SetName("nginx.cache.hit_ratio")
+	m.data.SetDescription("The ratio of the responses of the cache zone that were cache hits, since the counters of the cache zone were last reset. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxCacheHitRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, cacheAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("cache", cacheAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxCacheHitRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxCacheHitRatio) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxCacheHitRatio(settings MetricSettings) metricNginxCacheHitRatio {
+	m := metricNginxCacheHitRatio{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxCacheResponses struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.cache.responses metric with initial data.
+func (m *metricNginxCacheResponses) init() {
+	m.data.SetName("nginx.cache.responses")
+	m.data.SetDescription("The total number of responses of the cache zone, by cache status. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("{responses}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxCacheResponses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cacheAttributeValue string, cacheStatusAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cache", cacheAttributeValue)
+	dp.Attributes().PutStr("cache_status", cacheStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxCacheResponses) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxCacheResponses) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxCacheResponses(settings MetricSettings) metricNginxCacheResponses {
+	m := metricNginxCacheResponses{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxCacheSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.cache.size metric with initial data.
+func (m *metricNginxCacheSize) init() {
+	m.data.SetName("nginx.cache.size")
+	m.data.SetDescription("The current size of the cache zone. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxCacheSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cacheAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("cache", cacheAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxCacheSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxCacheSize) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxCacheSize(settings MetricSettings) metricNginxCacheSize {
+	m := metricNginxCacheSize{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxConnectionsAccepted struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.connections_accepted metric with initial data.
+func (m *metricNginxConnectionsAccepted) init() {
+	m.data.SetName("nginx.connections_accepted")
+	m.data.SetDescription("The total number of accepted client connections")
+	m.data.SetUnit("connections")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricNginxConnectionsAccepted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxConnectionsAccepted) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxConnectionsAccepted) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxConnectionsAccepted(settings MetricSettings) metricNginxConnectionsAccepted {
+	m := metricNginxConnectionsAccepted{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxConnectionsCurrent struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.connections_current metric with initial data.
+func (m *metricNginxConnectionsCurrent) init() {
+	m.data.SetName("nginx.connections_current")
+	m.data.SetDescription("The current number of nginx connections by state")
+	m.data.SetUnit("connections")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxConnectionsCurrent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, stateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", stateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxConnectionsCurrent) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxConnectionsCurrent) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxConnectionsCurrent(settings MetricSettings) metricNginxConnectionsCurrent {
+	m := metricNginxConnectionsCurrent{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxConnectionsHandled struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.connections_handled metric with initial data.
+func (m *metricNginxConnectionsHandled) init() {
+	m.data.SetName("nginx.connections_handled")
+	m.data.SetDescription("The total number of handled connections. Generally, the parameter value is the same as nginx.connections_accepted unless some resource limits have been reached (for example, the worker_connections limit).")
+	m.data.SetUnit("connections")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricNginxConnectionsHandled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxConnectionsHandled) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxConnectionsHandled) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxConnectionsHandled(settings MetricSettings) metricNginxConnectionsHandled {
+	m := metricNginxConnectionsHandled{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxRequests struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.requests metric with initial data.
+func (m *metricNginxRequests) init() {
+	m.data.SetName("nginx.requests")
+	m.data.SetDescription("Total number of requests made to the server since it started")
+	m.data.SetUnit("requests")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricNginxRequests) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxRequests) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxRequests) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxRequests(settings MetricSettings) metricNginxRequests {
+	m := metricNginxRequests{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneIo struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.io metric with initial data.
+func (m *metricNginxServerZoneIo) init() {
+	m.data.SetName("nginx.server_zone.io")
+	m.data.SetDescription("The total number of bytes received from and sent to clients by the server zone. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, zoneAttributeValue string, directionAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("zone", zoneAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneIo) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneIo(settings MetricSettings) metricNginxServerZoneIo {
+	m := metricNginxServerZoneIo{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneRequests struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.requests metric with initial data.
+func (m *metricNginxServerZoneRequests) init() {
+	m.data.SetName("nginx.server_zone.requests")
+	m.data.SetDescription("The total number of client requests received by the server zone. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneRequests) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, zoneAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("zone", zoneAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneRequests) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneRequests) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneRequests(settings MetricSettings) metricNginxServerZoneRequests {
+	m := metricNginxServerZoneRequests{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneResponses struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.responses metric with initial data.
+func (m *metricNginxServerZoneResponses) init() {
+	m.data.SetName("nginx.server_zone.responses")
+	m.data.SetDescription("The total number of responses sent to clients by the server zone, by range of status codes. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("{responses}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneResponses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, zoneAttributeValue string, statusRangeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("zone", zoneAttributeValue)
+	dp.Attributes().PutStr("status_range", statusRangeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneResponses) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneResponses) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneResponses(settings MetricSettings) metricNginxServerZoneResponses {
+	m := metricNginxServerZoneResponses{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxSslHandshakes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.ssl.handshakes metric with initial data.
+func (m *metricNginxSslHandshakes) init() {
+	m.data.SetName("nginx.ssl.handshakes")
+	m.data.SetDescription("The total number of SSL handshakes, by result. Collected from the NGINX Plus API.")
+	m.data.SetUnit("{handshakes}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxSslHandshakes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, handshakeResultAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("result", handshakeResultAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxSslHandshakes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxSslHandshakes) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxSslHandshakes(settings MetricSettings) metricNginxSslHandshakes {
+	m := metricNginxSslHandshakes{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxSslSessionReuses struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.ssl.session_reuses metric with initial data.
+func (m *metricNginxSslSessionReuses) init() {
+	m.data.SetName("nginx.ssl.session_reuses")
+	m.data.SetDescription("The total number of session reuses during SSL handshakes. Collected from the NGINX Plus API.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricNginxSslSessionReuses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxSslSessionReuses) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxSslSessionReuses) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxSslSessionReuses(settings MetricSettings) metricNginxSslSessionReuses {
+	m := metricNginxSslSessionReuses{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerConnections struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.connections metric with initial data.
+func (m *metricNginxUpstreamPeerConnections) init() {
+	m.data.SetName("nginx.upstream.peer.connections")
+	m.data.SetDescription("The current number of active connections to the server of the upstream group. Collected from the NGINX Plus API.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerConnections) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerConnections) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerConnections) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerConnections(settings MetricSettings) metricNginxUpstreamPeerConnections {
+	m := metricNginxUpstreamPeerConnections{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerFails struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.fails metric with initial data.
+func (m *metricNginxUpstreamPeerFails) init() {
+	m.data.SetName("nginx.upstream.peer.fails")
+	m.data.SetDescription("The total number of unsuccessful attempts to communicate with the server of the upstream group. Collected from the NGINX Plus API.")
+	m.data.SetUnit("{attempts}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerFails) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerFails) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
-// ResourceAttributeSettings provides common settings for a particular metric.
-type ResourceAttributeSettings struct {
-	Enabled bool `mapstructure:"enabled"`
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerFails) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
 
-	enabledProvidedByUser bool
+func newMetricNginxUpstreamPeerFails(settings MetricSettings) metricNginxUpstreamPeerFails {
+	m := metricNginxUpstreamPeerFails{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
 }
 
-func (ras *ResourceAttributeSettings) Unmarshal(parser *confmap.Conf) error {
-	if parser == nil {
-		return nil
+type metricNginxUpstreamPeerHeaderTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.header_time metric with initial data.
+func (m *metricNginxUpstreamPeerHeaderTime) init() {
+	m.data.SetName("nginx.upstream.peer.header_time")
+	m.data.SetDescription("The average time to get the response header from the server of the upstream group. Collected from the NGINX Plus API.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerHeaderTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
 	}
-	err := parser.Unmarshal(ras, confmap.WithErrorUnused())
-	if err != nil {
-		return err
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerHeaderTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
-	ras.enabledProvidedByUser = parser.IsSet("enabled")
-	return nil
 }
 
-// ResourceAttributesSettings provides settings for nginxreceiver metrics.
-type ResourceAttributesSettings struct {
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerHeaderTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
 }
 
-func DefaultResourceAttributesSettings() ResourceAttributesSettings {
-	return ResourceAttributesSettings{}
+func newMetricNginxUpstreamPeerHeaderTime(settings MetricSettings) metricNginxUpstreamPeerHeaderTime {
+	m := metricNginxUpstreamPeerHeaderTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
 }
 
-// AttributeState specifies the a value state attribute.
-type AttributeState int
+type metricNginxUpstreamPeerHealthChecks struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
 
-const (
-	_ AttributeState = iota
-	AttributeStateActive
-	AttributeStateReading
-	AttributeStateWriting
-	AttributeStateWaiting
-)
+// init fills nginx.upstream.peer.health_checks metric with initial data.
+func (m *metricNginxUpstreamPeerHealthChecks) init() {
+	m.data.SetName("nginx.upstream.peer.health_checks")
+	m.data.SetDescription("The total number of health check requests made to the server of the upstream group, by result. Collected from the NGINX Plus API.")
+	m.data.SetUnit("{checks}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
 
-// String returns the string representation of the AttributeState.
-func (av AttributeState) String() string {
-	switch av {
-	case AttributeStateActive:
-		return "active"
-	case AttributeStateReading:
-		return "reading"
-	case AttributeStateWriting:
-		return "writing"
-	case AttributeStateWaiting:
-		return "waiting"
+func (m *metricNginxUpstreamPeerHealthChecks) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string, healthCheckResultAttributeValue string) {
+	if !m.settings.Enabled {
+		return
 	}
-	return ""
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
+	dp.Attributes().PutStr("result", healthCheckResultAttributeValue)
 }
 
-// MapAttributeState is a helper map of string to AttributeState attribute value.
-var MapAttributeState = map[string]AttributeState{
-	"active":  AttributeStateActive,
-	"reading": AttributeStateReading,
-	"writing": AttributeStateWriting,
-	"waiting": AttributeStateWaiting,
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerHealthChecks) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
 }
 
-type metricNginxConnectionsAccepted struct {
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerHealthChecks) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerHealthChecks(settings MetricSettings) metricNginxUpstreamPeerHealthChecks {
+	m := metricNginxUpstreamPeerHealthChecks{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerIo struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills nginx.connections_accepted metric with initial data.
-func (m *metricNginxConnectionsAccepted) init() {
-	m.data.SetName("nginx.connections_accepted")
-	m.data.SetDescription("The total number of accepted client connections")
-	m.data.SetUnit("connections")
+// init fills nginx.upstream.peer.io metric with initial data.
+func (m *metricNginxUpstreamPeerIo) init() {
+	m.data.SetName("nginx.upstream.peer.io")
+	m.data.SetDescription("The total number of bytes received from and sent to the server of the upstream group. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("By")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricNginxConnectionsAccepted) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricNginxUpstreamPeerIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string, directionAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -141,17 +1256,20 @@ func (m *metricNginxConnectionsAccepted) recordDataPoint(start pcommon.Timestamp
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricNginxConnectionsAccepted) updateCapacity() {
+func (m *metricNginxUpstreamPeerIo) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricNginxConnectionsAccepted) emit(metrics pmetric.MetricSlice) {
+func (m *metricNginxUpstreamPeerIo) emit(metrics pmetric.MetricSlice) {
 	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -159,8 +1277,8 @@ func (m *metricNginxConnectionsAccepted) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricNginxConnectionsAccepted(settings MetricSettings) metricNginxConnectionsAccepted {
-	m := metricNginxConnectionsAccepted{settings: settings}
+func newMetricNginxUpstreamPeerIo(settings MetricSettings) metricNginxUpstreamPeerIo {
+	m := metricNginxUpstreamPeerIo{settings: settings}
 	if settings.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -168,22 +1286,76 @@ func newMetricNginxConnectionsAccepted(settings MetricSettings) metricNginxConne
 	return m
 }
 
-type metricNginxConnectionsCurrent struct {
+type metricNginxUpstreamPeerRequests struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills nginx.connections_current metric with initial data.
-func (m *metricNginxConnectionsCurrent) init() {
-	m.data.SetName("nginx.connections_current")
-	m.data.SetDescription("The current number of nginx connections by state")
-	m.data.SetUnit("connections")
+// init fills nginx.upstream.peer.requests metric with initial data.
+func (m *metricNginxUpstreamPeerRequests) init() {
+	m.data.SetName("nginx.upstream.peer.requests")
+	m.data.SetDescription("The total number of client requests forwarded to the server of the upstream group. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("{requests}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerRequests) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerRequests) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerRequests) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerRequests(settings MetricSettings) metricNginxUpstreamPeerRequests {
+	m := metricNginxUpstreamPeerRequests{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerResponseTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.response_time metric with initial data.
+func (m *metricNginxUpstreamPeerResponseTime) init() {
+	m.data.SetName("nginx.upstream.peer.response_time")
+	m.data.SetDescription("The average time to get the full response from the server of the upstream group. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("ms")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricNginxConnectionsCurrent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, stateAttributeValue string) {
+func (m *metricNginxUpstreamPeerResponseTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -191,18 +1363,19 @@ func (m *metricNginxConnectionsCurrent) recordDataPoint(start pcommon.Timestamp,
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
-	dp.Attributes().PutStr("state", stateAttributeValue)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricNginxConnectionsCurrent) updateCapacity() {
+func (m *metricNginxUpstreamPeerResponseTime) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricNginxConnectionsCurrent) emit(metrics pmetric.MetricSlice) {
+func (m *metricNginxUpstreamPeerResponseTime) emit(metrics pmetric.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -210,8 +1383,8 @@ func (m *metricNginxConnectionsCurrent) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricNginxConnectionsCurrent(settings MetricSettings) metricNginxConnectionsCurrent {
-	m := metricNginxConnectionsCurrent{settings: settings}
+func newMetricNginxUpstreamPeerResponseTime(settings MetricSettings) metricNginxUpstreamPeerResponseTime {
+	m := metricNginxUpstreamPeerResponseTime{settings: settings}
 	if settings.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -219,23 +1392,24 @@ func newMetricNginxConnectionsCurrent(settings MetricSettings) metricNginxConnec
 	return m
 }
 
-type metricNginxConnectionsHandled struct {
+type metricNginxUpstreamPeerResponses struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills nginx.connections_handled metric with initial data.
-func (m *metricNginxConnectionsHandled) init() {
-	m.data.SetName("nginx.connections_handled")
-	m.data.SetDescription("The total number of handled connections. Generally, the parameter value is the same as nginx.connections_accepted unless some resource limits have been reached (for example, the worker_connections limit).")
-	m.data.SetUnit("connections")
+// init fills nginx.upstream.peer.responses metric with initial data.
+func (m *metricNginxUpstreamPeerResponses) init() {
+	m.data.SetName("nginx.upstream.peer.responses")
+	m.data.SetDescription("The total number of responses obtained from the server of the upstream group, by range of status codes. Collected from the NGINX Plus API or the VTS module.")
+	m.data.SetUnit("{responses}")
 	m.data.SetEmptySum()
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricNginxConnectionsHandled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricNginxUpstreamPeerResponses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string, statusRangeAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -243,17 +1417,20 @@ func (m *metricNginxConnectionsHandled) recordDataPoint(start pcommon.Timestamp,
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
+	dp.Attributes().PutStr("status_range", statusRangeAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricNginxConnectionsHandled) updateCapacity() {
+func (m *metricNginxUpstreamPeerResponses) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricNginxConnectionsHandled) emit(metrics pmetric.MetricSlice) {
+func (m *metricNginxUpstreamPeerResponses) emit(metrics pmetric.MetricSlice) {
 	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -261,8 +1438,8 @@ func (m *metricNginxConnectionsHandled) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricNginxConnectionsHandled(settings MetricSettings) metricNginxConnectionsHandled {
-	m := metricNginxConnectionsHandled{settings: settings}
+func newMetricNginxUpstreamPeerResponses(settings MetricSettings) metricNginxUpstreamPeerResponses {
+	m := metricNginxUpstreamPeerResponses{settings: settings}
 	if settings.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -270,50 +1447,52 @@ func newMetricNginxConnectionsHandled(settings MetricSettings) metricNginxConnec
 	return m
 }
 
-type metricNginxRequests struct {
+type metricNginxUpstreamPeerState struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills nginx.requests metric with initial data.
-func (m *metricNginxRequests) init() {
-	m.data.SetName("nginx.requests")
-	m.data.SetDescription("Total number of requests made to the server since it started")
-	m.data.SetUnit("requests")
-	m.data.SetEmptySum()
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+// init fills nginx.upstream.peer.state metric with initial data.
+func (m *metricNginxUpstreamPeerState) init() {
+	m.data.SetName("nginx.upstream.peer.state")
+	m.data.SetDescription("The current state of the server of the upstream group, 1 for the current state and 0 for the others. Collected from the NGINX Plus API, or from the VTS module with the up and down states only.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricNginxRequests) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+func (m *metricNginxUpstreamPeerState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string, peerStateAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntValue(val)
+	dp.Attributes().PutStr("upstream", upstreamAttributeValue)
+	dp.Attributes().PutStr("peer", peerAttributeValue)
+	dp.Attributes().PutStr("state", peerStateAttributeValue)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricNginxRequests) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricNginxUpstreamPeerState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricNginxRequests) emit(metrics pmetric.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricNginxUpstreamPeerState) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricNginxRequests(settings MetricSettings) metricNginxRequests {
-	m := metricNginxRequests{settings: settings}
+func newMetricNginxUpstreamPeerState(settings MetricSettings) metricNginxUpstreamPeerState {
+	m := metricNginxUpstreamPeerState{settings: settings}
 	if settings.Enabled {
 		m.data = pmetric.NewMetric()
 		m.init()
@@ -324,16 +1503,33 @@ func newMetricNginxRequests(settings MetricSettings) metricNginxRequests {
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                      pcommon.Timestamp   // start time that will be applied to all recorded data points.
-	metricsCapacity                int                 // maximum observed number of metrics per resource.
-	resourceCapacity               int                 // maximum observed number of resource attributes.
-	metricsBuffer                  pmetric.Metrics     // accumulates metrics data before emitting.
-	buildInfo                      component.BuildInfo // contains version information
-	resourceAttributesSettings     ResourceAttributesSettings
-	metricNginxConnectionsAccepted metricNginxConnectionsAccepted
-	metricNginxConnectionsCurrent  metricNginxConnectionsCurrent
-	metricNginxConnectionsHandled  metricNginxConnectionsHandled
-	metricNginxRequests            metricNginxRequests
+	startTime                           pcommon.Timestamp   // start time that will be applied to all recorded data points.
+	metricsCapacity                     int                 // maximum observed number of metrics per resource.
+	resourceCapacity                    int                 // maximum observed number of resource attributes.
+	metricsBuffer                       pmetric.Metrics     // accumulates metrics data before emitting.
+	buildInfo                           component.BuildInfo // contains version information
+	resourceAttributesSettings          ResourceAttributesSettings
+	metricNginxCacheHitRatio            metricNginxCacheHitRatio
+	metricNginxCacheResponses           metricNginxCacheResponses
+	metricNginxCacheSize                metricNginxCacheSize
+	metricNginxConnectionsAccepted      metricNginxConnectionsAccepted
+	metricNginxConnectionsCurrent       metricNginxConnectionsCurrent
+	metricNginxConnectionsHandled       metricNginxConnectionsHandled
+	metricNginxRequests                 metricNginxRequests
+	metricNginxServerZoneIo             metricNginxServerZoneIo
+	metricNginxServerZoneRequests       metricNginxServerZoneRequests
+	metricNginxServerZoneResponses      metricNginxServerZoneResponses
+	metricNginxSslHandshakes            metricNginxSslHandshakes
+	metricNginxSslSessionReuses         metricNginxSslSessionReuses
+	metricNginxUpstreamPeerConnections  metricNginxUpstreamPeerConnections
+	metricNginxUpstreamPeerFails        metricNginxUpstreamPeerFails
+	metricNginxUpstreamPeerHeaderTime   metricNginxUpstreamPeerHeaderTime
+	metricNginxUpstreamPeerHealthChecks metricNginxUpstreamPeerHealthChecks
+	metricNginxUpstreamPeerIo           metricNginxUpstreamPeerIo
+	metricNginxUpstreamPeerRequests     metricNginxUpstreamPeerRequests
+	metricNginxUpstreamPeerResponseTime metricNginxUpstreamPeerResponseTime
+	metricNginxUpstreamPeerResponses    metricNginxUpstreamPeerResponses
+	metricNginxUpstreamPeerState        metricNginxUpstreamPeerState
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -355,14 +1551,31 @@ func WithResourceAttributesSettings(ras ResourceAttributesSettings) metricBuilde
 
 func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                  pmetric.NewMetrics(),
-		buildInfo:                      settings.BuildInfo,
-		resourceAttributesSettings:     DefaultResourceAttributesSettings(),
-		metricNginxConnectionsAccepted: newMetricNginxConnectionsAccepted(ms.NginxConnectionsAccepted),
-		metricNginxConnectionsCurrent:  newMetricNginxConnectionsCurrent(ms.NginxConnectionsCurrent),
-		metricNginxConnectionsHandled:  newMetricNginxConnectionsHandled(ms.NginxConnectionsHandled),
-		metricNginxRequests:            newMetricNginxRequests(ms.NginxRequests),
+		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                       pmetric.NewMetrics(),
+		buildInfo:                           settings.BuildInfo,
+		resourceAttributesSettings:          DefaultResourceAttributesSettings(),
+		metricNginxCacheHitRatio:            newMetricNginxCacheHitRatio(ms.NginxCacheHitRatio),
+		metricNginxCacheResponses:           newMetricNginxCacheResponses(ms.NginxCacheResponses),
+		metricNginxCacheSize:                newMetricNginxCacheSize(ms.NginxCacheSize),
+		metricNginxConnectionsAccepted:      newMetricNginxConnectionsAccepted(ms.NginxConnectionsAccepted),
+		metricNginxConnectionsCurrent:       newMetricNginxConnectionsCurrent(ms.NginxConnectionsCurrent),
+		metricNginxConnectionsHandled:       newMetricNginxConnectionsHandled(ms.NginxConnectionsHandled),
+		metricNginxRequests:                 newMetricNginxRequests(ms.NginxRequests),
+		metricNginxServerZoneIo:             newMetricNginxServerZoneIo(ms.NginxServerZoneIo),
+		metricNginxServerZoneRequests:       newMetricNginxServerZoneRequests(ms.NginxServerZoneRequests),
+		metricNginxServerZoneResponses:      newMetricNginxServerZoneResponses(ms.NginxServerZoneResponses),
+		metricNginxSslHandshakes:            newMetricNginxSslHandshakes(ms.NginxSslHandshakes),
+		metricNginxSslSessionReuses:         newMetricNginxSslSessionReuses(ms.NginxSslSessionReuses),
+		metricNginxUpstreamPeerConnections:  newMetricNginxUpstreamPeerConnections(ms.NginxUpstreamPeerConnections),
+		metricNginxUpstreamPeerFails:        newMetricNginxUpstreamPeerFails(ms.NginxUpstreamPeerFails),
+		metricNginxUpstreamPeerHeaderTime:   newMetricNginxUpstreamPeerHeaderTime(ms.NginxUpstreamPeerHeaderTime),
+		metricNginxUpstreamPeerHealthChecks: newMetricNginxUpstreamPeerHealthChecks(ms.NginxUpstreamPeerHealthChecks),
+		metricNginxUpstreamPeerIo:           newMetricNginxUpstreamPeerIo(ms.NginxUpstreamPeerIo),
+		metricNginxUpstreamPeerRequests:     newMetricNginxUpstreamPeerRequests(ms.NginxUpstreamPeerRequests),
+		metricNginxUpstreamPeerResponseTime: newMetricNginxUpstreamPeerResponseTime(ms.NginxUpstreamPeerResponseTime),
+		metricNginxUpstreamPeerResponses:    newMetricNginxUpstreamPeerResponses(ms.NginxUpstreamPeerResponses),
+		metricNginxUpstreamPeerState:        newMetricNginxUpstreamPeerState(ms.NginxUpstreamPeerState),
 	}
 	for _, op := range options {
 		op(mb)
@@ -415,10 +1628,27 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/nginxreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricNginxCacheHitRatio.emit(ils.Metrics())
+	mb.metricNginxCacheResponses.emit(ils.Metrics())
+	mb.metricNginxCacheSize.emit(ils.Metrics())
 	mb.metricNginxConnectionsAccepted.emit(ils.Metrics())
 	mb.metricNginxConnectionsCurrent.emit(ils.Metrics())
 	mb.metricNginxConnectionsHandled.emit(ils.Metrics())
 	mb.metricNginxRequests.emit(ils.Metrics())
+	mb.metricNginxServerZoneIo.emit(ils.Metrics())
+	mb.metricNginxServerZoneRequests.emit(ils.Metrics())
+	mb.metricNginxServerZoneResponses.emit(ils.Metrics())
+	mb.metricNginxSslHandshakes.emit(ils.Metrics())
+	mb.metricNginxSslSessionReuses.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerConnections.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerFails.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerHeaderTime.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerHealthChecks.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerIo.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerRequests.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerResponseTime.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerResponses.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerState.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(mb.resourceAttributesSettings, rm)
@@ -439,6 +1669,21 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordNginxCacheHitRatioDataPoint adds a data point to nginx.cache.hit_ratio metric.
+func (mb *MetricsBuilder) RecordNginxCacheHitRatioDataPoint(ts pcommon.Timestamp, val float64, cacheAttributeValue string) {
+	mb.metricNginxCacheHitRatio.recordDataPoint(mb.startTime, ts, val, cacheAttributeValue)
+}
+
+// RecordNginxCacheResponsesDataPoint adds a data point to nginx.cache.responses metric.
+func (mb *MetricsBuilder) RecordNginxCacheResponsesDataPoint(ts pcommon.Timestamp, val int64, cacheAttributeValue string, cacheStatusAttributeValue AttributeCacheStatus) {
+	mb.metricNginxCacheResponses.recordDataPoint(mb.startTime, ts, val, cacheAttributeValue, cacheStatusAttributeValue.String())
+}
+
+// RecordNginxCacheSizeDataPoint adds a data point to nginx.cache.size metric.
+func (mb *MetricsBuilder) RecordNginxCacheSizeDataPoint(ts pcommon.Timestamp, val int64, cacheAttributeValue string) {
+	mb.metricNginxCacheSize.recordDataPoint(mb.startTime, ts, val, cacheAttributeValue)
+}
+
 // RecordNginxConnectionsAcceptedDataPoint adds a data point to nginx.connections_accepted metric.
 func (mb *MetricsBuilder) RecordNginxConnectionsAcceptedDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricNginxConnectionsAccepted.recordDataPoint(mb.startTime, ts, val)
@@ -459,6 +1704,76 @@ func (mb *MetricsBuilder) RecordNginxRequestsDataPoint(ts pcommon.Timestamp, val
 	mb.metricNginxRequests.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordNginxServerZoneIoDataPoint adds a data point to nginx.server_zone.io metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneIoDataPoint(ts pcommon.Timestamp, val int64, zoneAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricNginxServerZoneIo.recordDataPoint(mb.startTime, ts, val, zoneAttributeValue, directionAttributeValue.String())
+}
+
+// RecordNginxServerZoneRequestsDataPoint adds a data point to nginx.server_zone.requests metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneRequestsDataPoint(ts pcommon.Timestamp, val int64, zoneAttributeValue string) {
+	mb.metricNginxServerZoneRequests.recordDataPoint(mb.startTime, ts, val, zoneAttributeValue)
+}
+
+// RecordNginxServerZoneResponsesDataPoint adds a data point to nginx.server_zone.responses metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneResponsesDataPoint(ts pcommon.Timestamp, val int64, zoneAttributeValue string, statusRangeAttributeValue AttributeStatusRange) {
+	mb.metricNginxServerZoneResponses.recordDataPoint(mb.startTime, ts, val, zoneAttributeValue, statusRangeAttributeValue.String())
+}
+
+// RecordNginxSslHandshakesDataPoint adds a data point to nginx.ssl.handshakes metric.
+func (mb *MetricsBuilder) RecordNginxSslHandshakesDataPoint(ts pcommon.Timestamp, val int64, handshakeResultAttributeValue AttributeHandshakeResult) {
+	mb.metricNginxSslHandshakes.recordDataPoint(mb.startTime, ts, val, handshakeResultAttributeValue.String())
+}
+
+// RecordNginxSslSessionReusesDataPoint adds a data point to nginx.ssl.session_reuses metric.
+func (mb *MetricsBuilder) RecordNginxSslSessionReusesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricNginxSslSessionReuses.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordNginxUpstreamPeerConnectionsDataPoint adds a data point to nginx.upstream.peer.connections metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerConnectionsDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	mb.metricNginxUpstreamPeerConnections.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue)
+}
+
+// RecordNginxUpstreamPeerFailsDataPoint adds a data point to nginx.upstream.peer.fails metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerFailsDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	mb.metricNginxUpstreamPeerFails.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue)
+}
+
+// RecordNginxUpstreamPeerHeaderTimeDataPoint adds a data point to nginx.upstream.peer.header_time metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerHeaderTimeDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	mb.metricNginxUpstreamPeerHeaderTime.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue)
+}
+
+// RecordNginxUpstreamPeerHealthChecksDataPoint adds a data point to nginx.upstream.peer.health_checks metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerHealthChecksDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string, healthCheckResultAttributeValue AttributeHealthCheckResult) {
+	mb.metricNginxUpstreamPeerHealthChecks.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue, healthCheckResultAttributeValue.String())
+}
+
+// RecordNginxUpstreamPeerIoDataPoint adds a data point to nginx.upstream.peer.io metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerIoDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricNginxUpstreamPeerIo.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue, directionAttributeValue.String())
+}
+
+// RecordNginxUpstreamPeerRequestsDataPoint adds a data point to nginx.upstream.peer.requests metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerRequestsDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	mb.metricNginxUpstreamPeerRequests.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue)
+}
+
+// RecordNginxUpstreamPeerResponseTimeDataPoint adds a data point to nginx.upstream.peer.response_time metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerResponseTimeDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string) {
+	mb.metricNginxUpstreamPeerResponseTime.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue)
+}
+
+// RecordNginxUpstreamPeerResponsesDataPoint adds a data point to nginx.upstream.peer.responses metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerResponsesDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string, statusRangeAttributeValue AttributeStatusRange) {
+	mb.metricNginxUpstreamPeerResponses.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue, statusRangeAttributeValue.String())
+}
+
+// RecordNginxUpstreamPeerStateDataPoint adds a data point to nginx.upstream.peer.state metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerStateDataPoint(ts pcommon.Timestamp, val int64, upstreamAttributeValue string, peerAttributeValue string, peerStateAttributeValue AttributePeerState) {
+	mb.metricNginxUpstreamPeerState.recordDataPoint(mb.startTime, ts, val, upstreamAttributeValue, peerAttributeValue, peerStateAttributeValue.String())
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -0,0 +1,93 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/model"
+
+// PlusStats holds the responses of the endpoints of the NGINX Plus API the scraper retrieves.
+type PlusStats struct {
+	ServerZones map[string]PlusServerZone
+	Upstreams   map[string]PlusUpstream
+	Caches      map[string]PlusCache
+	SSL         PlusSSL
+}
+
+// PlusServerZone represents a server zone of the /http/server_zones endpoint.
+// The struct is not exhaustive; It only provides the values relevant to the metrics retrieved by the scraper.
+type PlusServerZone struct {
+	Requests  int64         `json:"requests"`
+	Responses PlusResponses `json:"responses"`
+	Received  int64         `json:"received"`
+	Sent      int64         `json:"sent"`
+}
+
+// PlusResponses counts the responses by range of status codes.
+type PlusResponses struct {
+	Responses1xx int64 `json:"1xx"`
+	Responses2xx int64 `json:"2xx"`
+	Responses3xx int64 `json:"3xx"`
+	Responses4xx int64 `json:"4xx"`
+	Responses5xx int64 `json:"5xx"`
+}
+
+// PlusUpstream represents an upstream group of the /http/upstreams endpoint.
+type PlusUpstream struct {
+	Peers []PlusPeer `json:"peers"`
+}
+
+// PlusPeer represents a server of an upstream group.
+type PlusPeer struct {
+	Server       string           `json:"server"`
+	State        string           `json:"state"`
+	Active       int64            `json:"active"`
+	Requests     int64            `json:"requests"`
+	Responses    PlusResponses    `json:"responses"`
+	Sent         int64            `json:"sent"`
+	Received     int64            `json:"received"`
+	Fails        int64            `json:"fails"`
+	HealthChecks PlusHealthChecks `json:"health_checks"`
+	// HeaderTime and ResponseTime are only reported once the server has responded.
+	HeaderTime   *int64 `json:"header_time"`
+	ResponseTime *int64 `json:"response_time"`
+}
+
+// PlusHealthChecks counts the health checks of a server of an upstream group.
+type PlusHealthChecks struct {
+	Checks int64 `json:"checks"`
+	Fails  int64 `json:"fails"`
+}
+
+// PlusCache represents a cache zone of the /http/caches endpoint.
+type PlusCache struct {
+	Size        int64             `json:"size"`
+	Hit         PlusCacheResponse `json:"hit"`
+	Stale       PlusCacheResponse `json:"stale"`
+	Updating    PlusCacheResponse `json:"updating"`
+	Revalidated PlusCacheResponse `json:"revalidated"`
+	Miss        PlusCacheResponse `json:"miss"`
+	Expired     PlusCacheResponse `json:"expired"`
+	Bypass      PlusCacheResponse `json:"bypass"`
+}
+
+// PlusCacheResponse counts the responses of a cache zone with a cache status.
+type PlusCacheResponse struct {
+	Responses int64 `json:"responses"`
+	Bytes     int64 `json:"bytes"`
+}
+
+// PlusSSL represents the response of the /ssl endpoint.
+type PlusSSL struct {
+	Handshakes       int64 `json:"handshakes"`
+	HandshakesFailed int64 `json:"handshakes_failed"`
+	SessionReuses    int64 `json:"session_reuses"`
+}
@@ -0,0 +1,66 @@
+// Copyright 2020, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/model"
+
+// VTSStats represents the response of the /status/format/json endpoint of the
+// nginx-module-vts module. The struct is not exhaustive; It only provides the
+// values relevant to the metrics retrieved by the scraper.
+type VTSStats struct {
+	ServerZones   map[string]VTSServerZone     `json:"serverZones"`
+	UpstreamZones map[string][]VTSUpstreamPeer `json:"upstreamZones"`
+	CacheZones    map[string]VTSCacheZone      `json:"cacheZones"`
+}
+
+// VTSServerZone represents a server zone.
+type VTSServerZone struct {
+	RequestCounter int64        `json:"requestCounter"`
+	InBytes        int64        `json:"inBytes"`
+	OutBytes       int64        `json:"outBytes"`
+	Responses      VTSResponses `json:"responses"`
+}
+
+// VTSResponses counts the responses by range of status codes and by cache status.
+type VTSResponses struct {
+	Responses1xx int64 `json:"1xx"`
+	Responses2xx int64 `json:"2xx"`
+	Responses3xx int64 `json:"3xx"`
+	Responses4xx int64 `json:"4xx"`
+	Responses5xx int64 `json:"5xx"`
+	Miss         int64 `json:"miss"`
+	Bypass       int64 `json:"bypass"`
+	Expired      int64 `json:"expired"`
+	Stale        int64 `json:"stale"`
+	Updating     int64 `json:"updating"`
+	Revalidated  int64 `json:"revalidated"`
+	Hit          int64 `json:"hit"`
+	Scarce       int64 `json:"scarce"`
+}
+
+// VTSUpstreamPeer represents a server of an upstream group.
+type VTSUpstreamPeer struct {
+	Server         string       `json:"server"`
+	RequestCounter int64        `json:"requestCounter"`
+	InBytes        int64        `json:"inBytes"`
+	OutBytes       int64        `json:"outBytes"`
+	Responses      VTSResponses `json:"responses"`
+	ResponseMsec   int64        `json:"responseMsec"`
+	Down           bool         `json:"down"`
+}
+
+// VTSCacheZone represents a cache zone.
+type VTSCacheZone struct {
+	UsedSize  int64        `json:"usedSize"`
+	Responses VTSResponses `json:"responses"`
+}
@@ -24,14 +24,22 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/model"
 )
 
+// stubStatusMetricsCount is the number of metrics recorded from the stub status.
+const stubStatusMetricsCount = 4
+
 type nginxScraper struct {
 	httpClient *http.Client
 	client     *client.NginxClient
+	// plusClient and vtsClient are nil when the NGINX Plus API and the VTS module aren't configured.
+	plusClient *plusClient
+	vtsClient  *vtsClient
 
 	settings component.TelemetrySettings
 	cfg      *Config
@@ -56,28 +64,48 @@ func (r *nginxScraper) start(_ context.Context, host component.Host) error {
 	}
 	r.httpClient = httpClient
 
+	if r.cfg.PlusAPI.Endpoint != "" {
+		r.plusClient = newPlusClient(httpClient, r.cfg.PlusAPI.Endpoint, r.cfg.PlusAPI.Version)
+	}
+	if r.cfg.VTS.Endpoint != "" {
+		r.vtsClient = newVTSClient(httpClient, r.cfg.VTS.Endpoint)
+	}
 	return nil
 }
 
-func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
+func (r *nginxScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	var errs scrapererror.ScrapeErrors
+	if err := r.scrapeStubStatus(now); err != nil {
+		// The stub status is the only source unless the NGINX Plus API or the VTS
+		// module are configured, its failure then fails the whole scrape.
+		if r.plusClient == nil && r.vtsClient == nil {
+			return pmetric.Metrics{}, err
+		}
+		errs.AddPartial(stubStatusMetricsCount, err)
+	}
+	r.scrapeExtendedStatus(ctx, now, &errs)
+	return r.mb.Emit(), errs.Combine()
+}
+
+func (r *nginxScraper) scrapeStubStatus(now pcommon.Timestamp) error {
 	// Init client in scrape method in case there are transient errors in the constructor.
 	if r.client == nil {
 		var err error
 		r.client, err = client.NewNginxClient(r.httpClient, r.cfg.HTTPClientSettings.Endpoint)
 		if err != nil {
 			r.client = nil
-			return pmetric.Metrics{}, err
+			return err
 		}
 	}
 
 	stats, err := r.client.GetStubStats()
 	if err != nil {
 		r.settings.Logger.Error("Failed to fetch nginx stats", zap.Error(err))
-		return pmetric.Metrics{}, err
+		return err
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
-
 	r.mb.RecordNginxRequestsDataPoint(now, stats.Requests)
 	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, stats.Connections.Accepted)
 	r.mb.RecordNginxConnectionsHandledDataPoint(now, stats.Connections.Handled)
@@ -85,6 +113,152 @@ func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Reading, metadata.AttributeStateReading)
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Writing, metadata.AttributeStateWriting)
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Waiting, metadata.AttributeStateWaiting)
+	return nil
+}
+
+// scrapeExtendedStatus records the metrics of the NGINX Plus API and of the VTS module, when configured.
+func (r *nginxScraper) scrapeExtendedStatus(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if r.plusClient != nil {
+		stats, err := r.plusClient.getStats(ctx)
+		if err != nil {
+			r.settings.Logger.Error("Failed to fetch NGINX Plus API stats", zap.Error(err))
+			errs.AddPartial(1, err)
+		} else {
+			r.recordPlusMetrics(now, stats)
+		}
+	}
+	if r.vtsClient != nil {
+		stats, err := r.vtsClient.getStats(ctx)
+		if err != nil {
+			r.settings.Logger.Error("Failed to fetch VTS module stats", zap.Error(err))
+			errs.AddPartial(1, err)
+		} else {
+			r.recordVTSMetrics(now, stats)
+		}
+	}
+}
+
+func (r *nginxScraper) recordPlusMetrics(now pcommon.Timestamp, stats *model.PlusStats) {
+	for name, zone := range stats.ServerZones {
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, zone.Requests, name)
+		r.recordServerZoneResponses(now, name, zone.Responses.Responses1xx, zone.Responses.Responses2xx,
+			zone.Responses.Responses3xx, zone.Responses.Responses4xx, zone.Responses.Responses5xx)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.Received, name, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.Sent, name, metadata.AttributeDirectionSent)
+	}
+
+	for name, upstream := range stats.Upstreams {
+		for _, peer := range upstream.Peers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, peer.Requests, name, peer.Server)
+			r.recordUpstreamPeerResponses(now, name, peer.Server, peer.Responses.Responses1xx, peer.Responses.Responses2xx,
+				peer.Responses.Responses3xx, peer.Responses.Responses4xx, peer.Responses.Responses5xx)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.Received, name, peer.Server, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.Sent, name, peer.Server, metadata.AttributeDirectionSent)
+			if peer.ResponseTime != nil {
+				r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, *peer.ResponseTime, name, peer.Server)
+			}
+			if peer.HeaderTime != nil {
+				r.mb.RecordNginxUpstreamPeerHeaderTimeDataPoint(now, *peer.HeaderTime, name, peer.Server)
+			}
+			r.mb.RecordNginxUpstreamPeerConnectionsDataPoint(now, peer.Active, name, peer.Server)
+			for state, attr := range metadata.MapAttributePeerState {
+				r.mb.RecordNginxUpstreamPeerStateDataPoint(now, boolToInt64(peer.State == state), name, peer.Server, attr)
+			}
+			r.mb.RecordNginxUpstreamPeerFailsDataPoint(now, peer.Fails, name, peer.Server)
+			r.mb.RecordNginxUpstreamPeerHealthChecksDataPoint(now, peer.HealthChecks.Checks-peer.HealthChecks.Fails,
+				name, peer.Server, metadata.AttributeHealthCheckResultPassed)
+			r.mb.RecordNginxUpstreamPeerHealthChecksDataPoint(now, peer.HealthChecks.Fails,
+				name, peer.Server, metadata.AttributeHealthCheckResultFailed)
+		}
+	}
+
+	for name, cache := range stats.Caches {
+		r.mb.RecordNginxCacheSizeDataPoint(now, cache.Size, name)
+		r.recordCacheResponses(now, name, map[metadata.AttributeCacheStatus]int64{
+			metadata.AttributeCacheStatusHit:         cache.Hit.Responses,
+			metadata.AttributeCacheStatusStale:       cache.Stale.Responses,
+			metadata.AttributeCacheStatusUpdating:    cache.Updating.Responses,
+			metadata.AttributeCacheStatusRevalidated: cache.Revalidated.Responses,
+			metadata.AttributeCacheStatusMiss:        cache.Miss.Responses,
+			metadata.AttributeCacheStatusExpired:     cache.Expired.Responses,
+			metadata.AttributeCacheStatusBypass:      cache.Bypass.Responses,
+		})
+	}
+
+	r.mb.RecordNginxSslHandshakesDataPoint(now, stats.SSL.Handshakes, metadata.AttributeHandshakeResultSuccessful)
+	r.mb.RecordNginxSslHandshakesDataPoint(now, stats.SSL.HandshakesFailed, metadata.AttributeHandshakeResultFailed)
+	r.mb.RecordNginxSslSessionReusesDataPoint(now, stats.SSL.SessionReuses)
+}
+
+func (r *nginxScraper) recordVTSMetrics(now pcommon.Timestamp, stats *model.VTSStats) {
+	for name, zone := range stats.ServerZones {
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, zone.RequestCounter, name)
+		r.recordServerZoneResponses(now, name, zone.Responses.Responses1xx, zone.Responses.Responses2xx,
+			zone.Responses.Responses3xx, zone.Responses.Responses4xx, zone.Responses.Responses5xx)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.InBytes, name, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.OutBytes, name, metadata.AttributeDirectionSent)
+	}
+
+	for name, peers := range stats.UpstreamZones {
+		for _, peer := range peers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, peer.RequestCounter, name, peer.Server)
+			r.recordUpstreamPeerResponses(now, name, peer.Server, peer.Responses.Responses1xx, peer.Responses.Responses2xx,
+				peer.Responses.Responses3xx, peer.Responses.Responses4xx, peer.Responses.Responses5xx)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.InBytes, name, peer.Server, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.OutBytes, name, peer.Server, metadata.AttributeDirectionSent)
+			r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, peer.ResponseMsec, name, peer.Server)
+			// The module only reports whether the server is marked as down.
+			r.mb.RecordNginxUpstreamPeerStateDataPoint(now, boolToInt64(!peer.Down), name, peer.Server, metadata.AttributePeerStateUp)
+			r.mb.RecordNginxUpstreamPeerStateDataPoint(now, boolToInt64(peer.Down), name, peer.Server, metadata.AttributePeerStateDown)
+		}
+	}
 
-	return r.mb.Emit(), nil
+	for name, cache := range stats.CacheZones {
+		r.mb.RecordNginxCacheSizeDataPoint(now, cache.UsedSize, name)
+		r.recordCacheResponses(now, name, map[metadata.AttributeCacheStatus]int64{
+			metadata.AttributeCacheStatusHit:         cache.Responses.Hit,
+			metadata.AttributeCacheStatusStale:       cache.Responses.Stale,
+			metadata.AttributeCacheStatusUpdating:    cache.Responses.Updating,
+			metadata.AttributeCacheStatusRevalidated: cache.Responses.Revalidated,
+			metadata.AttributeCacheStatusMiss:        cache.Responses.Miss,
+			metadata.AttributeCacheStatusExpired:     cache.Responses.Expired,
+			metadata.AttributeCacheStatusBypass:      cache.Responses.Bypass,
+			metadata.AttributeCacheStatusScarce:      cache.Responses.Scarce,
+		})
+	}
+}
+
+func (r *nginxScraper) recordServerZoneResponses(now pcommon.Timestamp, zone string, r1xx, r2xx, r3xx, r4xx, r5xx int64) {
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, r1xx, zone, metadata.AttributeStatusRange1xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, r2xx, zone, metadata.AttributeStatusRange2xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, r3xx, zone, metadata.AttributeStatusRange3xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, r4xx, zone, metadata.AttributeStatusRange4xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, r5xx, zone, metadata.AttributeStatusRange5xx)
+}
+
+func (r *nginxScraper) recordUpstreamPeerResponses(now pcommon.Timestamp, upstream, peer string, r1xx, r2xx, r3xx, r4xx, r5xx int64) {
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, r1xx, upstream, peer, metadata.AttributeStatusRange1xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, r2xx, upstream, peer, metadata.AttributeStatusRange2xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, r3xx, upstream, peer, metadata.AttributeStatusRange3xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, r4xx, upstream, peer, metadata.AttributeStatusRange4xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, r5xx, upstream, peer, metadata.AttributeStatusRange5xx)
+}
+
+// recordCacheResponses records the responses of the cache zone by cache status, and the ratio of the hits.
+func (r *nginxScraper) recordCacheResponses(now pcommon.Timestamp, cache string, responses map[metadata.AttributeCacheStatus]int64) {
+	var total int64
+	for status, count := range responses {
+		r.mb.RecordNginxCacheResponsesDataPoint(now, count, cache, status)
+		total += count
+	}
+	if total > 0 {
+		r.mb.RecordNginxCacheHitRatioDataPoint(now, float64(responses[metadata.AttributeCacheStatusHit])/float64(total), cache)
+	}
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
 }
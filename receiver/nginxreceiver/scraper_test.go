@@ -19,7 +19,9 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -28,6 +30,7 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -55,6 +58,76 @@ func TestScraper(t *testing.T) {
 		pmetrictest.IgnoreTimestamp()))
 }
 
+func TestScraperPlusAPI(t *testing.T) {
+	nginxMock := newMockServer(t)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/status"
+	cfg.PlusAPI.Endpoint = nginxMock.URL + "/api"
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_plus.json")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics, pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp(), pmetrictest.IgnoreMetricDataPointsOrder()))
+}
+
+func TestScraperVTS(t *testing.T) {
+	nginxMock := newMockServer(t)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/status"
+	cfg.VTS.Endpoint = nginxMock.URL + "/status/format/json"
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_vts.json")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics, pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp(), pmetrictest.IgnoreMetricDataPointsOrder()))
+}
+
+func TestScraperPartialError(t *testing.T) {
+	nginxMock := newMockServer(t)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/badpath"
+	cfg.PlusAPI.Endpoint = nginxMock.URL + "/api"
+	cfg.PlusAPI.Version = 3
+	cfg.VTS.Endpoint = nginxMock.URL + "/status/format/json"
+
+	scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	// The metrics of the VTS module are emitted even though the stub status and the NGINX Plus API fail.
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Contains(t, err.Error(), "expected 200 response, got 404")
+	require.Contains(t, err.Error(), "expected 200 response from "+nginxMock.URL+"/api/3/http/server_zones, got 404")
+
+	names := map[string]bool{}
+	ms := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		names[ms.At(i).Name()] = true
+	}
+	require.True(t, names["nginx.server_zone.requests"])
+	require.False(t, names["nginx.requests"])
+	require.False(t, names["nginx.ssl.handshakes"])
+}
+
 func TestScraperError(t *testing.T) {
 	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.Path == "/status" {
@@ -116,6 +189,20 @@ Reading: 6 Writing: 179 Waiting: 106
 			require.NoError(t, err)
 			return
 		}
-		rw.WriteHeader(404)
+		var file string
+		switch {
+		case req.URL.Path == "/status/format/json":
+			file = filepath.Join("testdata", "scraper", "vts.json")
+		case strings.HasPrefix(req.URL.Path, "/api/8/"):
+			file = filepath.Join("testdata", "scraper", "plus", filepath.Base(req.URL.Path)+".json")
+		default:
+			rw.WriteHeader(404)
+			return
+		}
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		rw.WriteHeader(200)
+		_, err = rw.Write(data)
+		require.NoError(t, err)
 	}))
 }
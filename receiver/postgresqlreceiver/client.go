@@ -56,6 +56,11 @@ type client interface {
 	getLatestWalAgeSeconds(ctx context.Context) (int64, error)
 	getMaxConnections(ctx context.Context) (int64, error)
 	getIndexStats(ctx context.Context, database string) (map[indexIdentifer]indexStat, error)
+	getConnectionStates(ctx context.Context, databases []string) (map[databaseName]map[string]int64, error)
+	getLockWaits(ctx context.Context, databases []string) (map[databaseName]map[string]int64, error)
+	getQueryStats(ctx context.Context, databases []string, limit int) ([]queryStats, error)
+	getStatementStats(ctx context.Context, databases []string) ([]statementStats, error)
+	getLongRunningQueries(ctx context.Context, databases []string, threshold time.Duration) ([]runningQuery, error)
 	listDatabases(ctx context.Context) ([]string, error)
 }
 
@@ -142,10 +147,11 @@ func (c *postgreSQLClient) Close() error {
 type databaseStats struct {
 	transactionCommitted int64
 	transactionRollback  int64
+	deadlocks            int64
 }
 
 func (c *postgreSQLClient) getDatabaseStats(ctx context.Context, databases []string) (map[databaseName]databaseStats, error) {
-	query := filterQueryByDatabases("SELECT datname, xact_commit, xact_rollback FROM pg_stat_database", databases, false)
+	query := filterQueryByDatabases("SELECT datname, xact_commit, xact_rollback, deadlocks FROM pg_stat_database", databases, false)
 	rows, err := c.client.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
@@ -154,8 +160,8 @@ func (c *postgreSQLClient) getDatabaseStats(ctx context.Context, databases []str
 	dbStats := map[databaseName]databaseStats{}
 	for rows.Next() {
 		var datname string
-		var transactionCommitted, transactionRollback int64
-		err = rows.Scan(&datname, &transactionCommitted, &transactionRollback)
+		var transactionCommitted, transactionRollback, deadlocks int64
+		err = rows.Scan(&datname, &transactionCommitted, &transactionRollback, &deadlocks)
 		if err != nil {
 			errs = multierr.Append(errs, err)
 			continue
@@ -164,6 +170,7 @@ func (c *postgreSQLClient) getDatabaseStats(ctx context.Context, databases []str
 			dbStats[databaseName(datname)] = databaseStats{
 				transactionCommitted: transactionCommitted,
 				transactionRollback:  transactionRollback,
+				deadlocks:            deadlocks,
 			}
 		}
 	}
@@ -195,6 +202,50 @@ func (c *postgreSQLClient) getBackends(ctx context.Context, databases []string)
 	return ars, errors
 }
 
+// getConnectionStates returns a map of database names to the number of client backends in each state
+func (c *postgreSQLClient) getConnectionStates(ctx context.Context, databases []string) (map[databaseName]map[string]int64, error) {
+	query := `SELECT datname, state, count(*) AS count
+	FROM pg_stat_activity
+	WHERE datname IS NOT NULL AND state IS NOT NULL` + databaseFilter("datname", databases) + `
+	GROUP BY datname, state;`
+	return c.countByDatabase(ctx, query)
+}
+
+// getLockWaits returns a map of database names to the number of lock requests waiting to be granted per lock mode
+func (c *postgreSQLClient) getLockWaits(ctx context.Context, databases []string) (map[databaseName]map[string]int64, error) {
+	query := `SELECT d.datname, l.mode, count(*) AS count
+	FROM pg_locks l
+	JOIN pg_database d ON l.database = d.oid
+	WHERE NOT l.granted` + databaseFilter("d.datname", databases) + `
+	GROUP BY d.datname, l.mode;`
+	return c.countByDatabase(ctx, query)
+}
+
+// countByDatabase runs a query returning rows of (database, key, count) and groups the counts by database
+func (c *postgreSQLClient) countByDatabase(ctx context.Context, query string) (map[databaseName]map[string]int64, error) {
+	rows, err := c.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	counts := map[databaseName]map[string]int64{}
+	var errs error
+	for rows.Next() {
+		var datname, key string
+		var count int64
+		err = rows.Scan(&datname, &key, &count)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		if _, ok := counts[databaseName(datname)]; !ok {
+			counts[databaseName(datname)] = map[string]int64{}
+		}
+		counts[databaseName(datname)][key] = count
+	}
+	return counts, errs
+}
+
 func (c *postgreSQLClient) getDatabaseSize(ctx context.Context, databases []string) (map[databaseName]int64, error) {
 	query := filterQueryByDatabases("SELECT datname, pg_database_size(datname) FROM pg_catalog.pg_database WHERE datistemplate = false", databases, false)
 	rows, err := c.client.QueryContext(ctx, query)
@@ -373,6 +424,166 @@ func (c *postgreSQLClient) getIndexStats(ctx context.Context, database string) (
 	return stats, multierr.Combine(errs...)
 }
 
+// queryStats contains the pg_stat_statements statistics of a normalized query, summed across users
+type queryStats struct {
+	database         string
+	queryID          string
+	query            string
+	calls            int64
+	totalExecTime    float64
+	rows             int64
+	sharedBlocksHit  int64
+	sharedBlocksRead int64
+}
+
+// getQueryStats returns the statistics of the queries with the highest total execution time, up to limit.
+// It requires the pg_stat_statements extension and PostgreSQL 13 or newer.
+func (c *postgreSQLClient) getQueryStats(ctx context.Context, databases []string, limit int) ([]queryStats, error) {
+	query := `SELECT d.datname,
+	s.queryid::text AS query_id,
+	min(s.query) AS query,
+	sum(s.calls)::bigint AS calls,
+	sum(s.total_exec_time) AS total_exec_time,
+	sum(s.rows)::bigint AS rows,
+	sum(s.shared_blks_hit)::bigint AS shared_blks_hit,
+	sum(s.shared_blks_read)::bigint AS shared_blks_read
+	FROM pg_stat_statements s
+	JOIN pg_database d ON s.dbid = d.oid
+	WHERE s.queryid IS NOT NULL` + databaseFilter("d.datname", databases) + `
+	GROUP BY d.datname, s.queryid
+	ORDER BY total_exec_time DESC
+	LIMIT $1;`
+
+	rows, err := c.client.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_stat_statements: %w", err)
+	}
+	defer rows.Close()
+	var qs []queryStats
+	var errs error
+	for rows.Next() {
+		var stat queryStats
+		err = rows.Scan(
+			&stat.database,
+			&stat.queryID,
+			&stat.query,
+			&stat.calls,
+			&stat.totalExecTime,
+			&stat.rows,
+			&stat.sharedBlocksHit,
+			&stat.sharedBlocksRead,
+		)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		qs = append(qs, stat)
+	}
+	return qs, errs
+}
+
+// statementStats contains the cumulative pg_stat_statements statistics of a normalized query run by a user
+type statementStats struct {
+	database      string
+	user          string
+	queryID       string
+	query         string
+	calls         int64
+	totalExecTime float64
+}
+
+// getStatementStats returns the statistics of every normalized query tracked by pg_stat_statements.
+// It requires the pg_stat_statements extension and PostgreSQL 13 or newer.
+func (c *postgreSQLClient) getStatementStats(ctx context.Context, databases []string) ([]statementStats, error) {
+	query := `SELECT d.datname, r.rolname, s.queryid::text AS query_id, s.query, s.calls, s.total_exec_time
+	FROM pg_stat_statements s
+	JOIN pg_database d ON s.dbid = d.oid
+	JOIN pg_roles r ON s.userid = r.oid
+	WHERE s.queryid IS NOT NULL` + databaseFilter("d.datname", databases) + `;`
+
+	rows, err := c.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_stat_statements: %w", err)
+	}
+	defer rows.Close()
+	var stats []statementStats
+	var errs error
+	for rows.Next() {
+		var stat statementStats
+		err = rows.Scan(&stat.database, &stat.user, &stat.queryID, &stat.query, &stat.calls, &stat.totalExecTime)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		stats = append(stats, stat)
+	}
+	return stats, errs
+}
+
+// runningQuery is a query currently being executed by a client backend
+type runningQuery struct {
+	database string
+	user     string
+	// queryID is the pg_stat_statements query id of the query, empty if not available.
+	queryID string
+	// query is the normalized query text from pg_stat_statements, empty if not available.
+	query    string
+	duration float64
+}
+
+// getLongRunningQueries returns the queries that have been running for at least threshold, with their duration in milliseconds.
+// The raw query text of pg_stat_activity may contain literals and is never returned. Instead, the query id and the normalized
+// query text are looked up in pg_stat_statements, which requires PostgreSQL 14 or newer with compute_query_id enabled and the
+// pg_stat_statements extension. They are left empty otherwise.
+func (c *postgreSQLClient) getLongRunningQueries(ctx context.Context, databases []string, threshold time.Duration) ([]runningQuery, error) {
+	var normalized bool
+	row := c.client.QueryRowContext(ctx, `SELECT current_setting('server_version_num')::int >= 140000
+	AND to_regclass('pg_stat_statements') IS NOT NULL;`)
+	if err := row.Scan(&normalized); err != nil {
+		return nil, fmt.Errorf("unable to determine pg_stat_statements support: %w", err)
+	}
+
+	query := `SELECT a.datname, a.usename, NULL::text AS query_id, NULL::text AS query,
+	extract('epoch' from now() - a.query_start) * 1000 AS duration
+	FROM pg_stat_activity a`
+	if normalized {
+		query = `SELECT a.datname, a.usename, a.query_id::text AS query_id, s.query,
+	extract('epoch' from now() - a.query_start) * 1000 AS duration
+	FROM pg_stat_activity a
+	LEFT JOIN LATERAL (
+		SELECT query FROM pg_stat_statements
+		WHERE queryid = a.query_id AND dbid = a.datid AND userid = a.usesysid
+		LIMIT 1
+	) s ON true`
+	}
+	query += `
+	WHERE a.state = 'active'
+	AND a.backend_type = 'client backend'
+	AND a.pid <> pg_backend_pid()
+	AND now() - a.query_start >= $1 * interval '1 millisecond'` + databaseFilter("a.datname", databases) + `;`
+
+	rows, err := c.client.QueryContext(ctx, query, threshold.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_stat_activity: %w", err)
+	}
+	defer rows.Close()
+	var queries []runningQuery
+	var errs error
+	for rows.Next() {
+		var q runningQuery
+		var queryID, queryText sql.NullString
+		err = rows.Scan(&q.database, &q.user, &queryID, &queryText, &q.duration)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		q.queryID = queryID.String
+		q.query = queryText.String
+		queries = append(queries, q)
+	}
+	return queries, errs
+}
+
 type bgStat struct {
 	checkpointsReq       int64
 	checkpointsScheduled int64
@@ -551,6 +762,18 @@ func filterQueryByDatabases(baseQuery string, databases []string, groupBy bool)
 	return baseQuery + ";"
 }
 
+// databaseFilter returns an AND clause restricting column to the given databases, or an empty string if no databases are given
+func databaseFilter(column string, databases []string) string {
+	if len(databases) == 0 {
+		return ""
+	}
+	var queryDatabases []string
+	for _, db := range databases {
+		queryDatabases = append(queryDatabases, fmt.Sprintf("'%s'", db))
+	}
+	return fmt.Sprintf(" AND %s IN (%s)", column, strings.Join(queryDatabases, ","))
+}
+
 func tableKey(database, table string) tableIdentifier {
 	return tableIdentifier(fmt.Sprintf("%s|%s", database, table))
 }
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -34,6 +35,7 @@ const (
 	ErrNotSupported        = "invalid config: field '%s' not supported"
 	ErrTransportsSupported = "invalid config: 'transport' must be 'tcp' or 'unix'"
 	ErrHostPort            = "invalid config: 'endpoint' must be in the form <host>:<port> no matter what 'transport' is configured"
+	ErrTopQueryCount       = "invalid config: 'top_query_count' must be greater than 0"
 )
 
 type Config struct {
@@ -41,9 +43,21 @@ type Config struct {
 	Username                                string                         `mapstructure:"username"`
 	Password                                string                         `mapstructure:"password"`
 	Databases                               []string                       `mapstructure:"databases"`
+	TopQueryCount                           int                            `mapstructure:"top_query_count"`
 	confignet.NetAddr                       `mapstructure:",squash"`       // provides Endpoint and Transport
 	configtls.TLSClientSetting              `mapstructure:"tls,omitempty"` // provides SSL details
 	Metrics                                 metadata.MetricsSettings       `mapstructure:"metrics"`
+	QueryLogs                               QueryLogsConfig                `mapstructure:"query_logs"`
+}
+
+// QueryLogsConfig configures the query log records emitted when the receiver is used in a logs pipeline.
+type QueryLogsConfig struct {
+	// TopN is the number of queries with the highest total execution time since the
+	// previous collection that are emitted. A value of 0 disables top query log records.
+	TopN int `mapstructure:"top_n"`
+	// LongRunningQueryThreshold is the minimum time a query must have been running for to be
+	// emitted as a long running query. A value of 0 disables long running query log records.
+	LongRunningQueryThreshold time.Duration `mapstructure:"long_running_query_threshold"`
 }
 
 func (cfg *Config) Validate() error {
@@ -76,5 +90,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, errors.New(ErrTransportsSupported))
 	}
 
+	if cfg.TopQueryCount <= 0 {
+		err = multierr.Append(err, errors.New(ErrTopQueryCount))
+	}
+
 	return err
 }
@@ -97,6 +97,17 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf(ErrNotSupported, "MinVersion"),
 			),
 		},
+		{
+			desc: "invalid top query count",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.TopQueryCount = 0
+			},
+			expected: multierr.Combine(
+				errors.New(ErrTopQueryCount),
+			),
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
@@ -148,6 +159,11 @@ func TestLoadConfig(t *testing.T) {
 		expected.Username = "otel"
 		expected.Password = "${env:POSTGRESQL_PASSWORD}"
 		expected.Databases = []string{"otel"}
+		expected.TopQueryCount = 100
+		expected.QueryLogs = QueryLogsConfig{
+			TopN:                      20,
+			LongRunningQueryThreshold: 30 * time.Second,
+		}
 		expected.CollectionInterval = 10 * time.Second
 		expected.TLSClientSetting = configtls.TLSClientSetting{
 			Insecure:           false,
@@ -29,15 +29,21 @@ import (
 )
 
 const (
-	typeStr   = "postgresql"
-	stability = component.StabilityLevelBeta
+	typeStr       = "postgresql"
+	stability     = component.StabilityLevelBeta
+	logsStability = component.StabilityLevelDevelopment
+
+	defaultTopQueryCount             = 200
+	defaultLogsTopN                  = 10
+	defaultLongRunningQueryThreshold = 10 * time.Second
 )
 
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, stability))
+		receiver.WithMetrics(createMetricsReceiver, stability),
+		receiver.WithLogs(createLogsReceiver, logsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -53,7 +59,12 @@ func createDefaultConfig() component.Config {
 			Insecure:           false,
 			InsecureSkipVerify: true,
 		},
-		Metrics: metadata.DefaultMetricsSettings(),
+		TopQueryCount: defaultTopQueryCount,
+		Metrics:       metadata.DefaultMetricsSettings(),
+		QueryLogs: QueryLogsConfig{
+			TopN:                      defaultLogsTopN,
+			LongRunningQueryThreshold: defaultLongRunningQueryThreshold,
+		},
 	}
 }
 
@@ -76,3 +87,13 @@ func createMetricsReceiver(
 		scraperhelper.AddScraper(scraper),
 	)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg := rConf.(*Config)
+	return newPostgreSQLLogsReceiver(params, cfg, consumer, &defaultClientFactory{}), nil
+}
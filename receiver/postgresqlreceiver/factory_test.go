@@ -57,3 +57,15 @@ func TestCreateMetricsReceiver(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, metricsReceiver)
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	factory := NewFactory()
+	logsReceiver, err := factory.CreateLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		factory.CreateDefaultConfig(),
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, logsReceiver)
+}
@@ -41,12 +41,20 @@ type MetricsSettings struct {
 	PostgresqlBgwriterMaxwritten       MetricSettings `mapstructure:"postgresql.bgwriter.maxwritten"`
 	PostgresqlBlocksRead               MetricSettings `mapstructure:"postgresql.blocks_read"`
 	PostgresqlCommits                  MetricSettings `mapstructure:"postgresql.commits"`
+	PostgresqlConnectionCount          MetricSettings `mapstructure:"postgresql.connection.count"`
 	PostgresqlConnectionMax            MetricSettings `mapstructure:"postgresql.connection.max"`
 	PostgresqlDatabaseCount            MetricSettings `mapstructure:"postgresql.database.count"`
 	PostgresqlDbSize                   MetricSettings `mapstructure:"postgresql.db_size"`
+	PostgresqlDeadlocks                MetricSettings `mapstructure:"postgresql.deadlocks"`
 	PostgresqlIndexScans               MetricSettings `mapstructure:"postgresql.index.scans"`
 	PostgresqlIndexSize                MetricSettings `mapstructure:"postgresql.index.size"`
+	PostgresqlLockWaits                MetricSettings `mapstructure:"postgresql.lock.waits"`
 	PostgresqlOperations               MetricSettings `mapstructure:"postgresql.operations"`
+	PostgresqlQueryCalls               MetricSettings `mapstructure:"postgresql.query.calls"`
+	PostgresqlQueryMeanExecTime        MetricSettings `mapstructure:"postgresql.query.mean_exec_time"`
+	PostgresqlQueryRows                MetricSettings `mapstructure:"postgresql.query.rows"`
+	PostgresqlQuerySharedBlocks        MetricSettings `mapstructure:"postgresql.query.shared_blocks"`
+	PostgresqlQueryTotalExecTime       MetricSettings `mapstructure:"postgresql.query.total_exec_time"`
 	PostgresqlReplicationDataDelay     MetricSettings `mapstructure:"postgresql.replication.data_delay"`
 	PostgresqlRollbacks                MetricSettings `mapstructure:"postgresql.rollbacks"`
 	PostgresqlRows                     MetricSettings `mapstructure:"postgresql.rows"`
@@ -83,6 +91,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		PostgresqlCommits: MetricSettings{
 			Enabled: true,
 		},
+		PostgresqlConnectionCount: MetricSettings{
+			Enabled: false,
+		},
 		PostgresqlConnectionMax: MetricSettings{
 			Enabled: true,
 		},
@@ -92,15 +103,36 @@ func DefaultMetricsSettings() MetricsSettings {
 		PostgresqlDbSize: MetricSettings{
 			Enabled: true,
 		},
+		PostgresqlDeadlocks: MetricSettings{
+			Enabled: false,
+		},
 		PostgresqlIndexScans: MetricSettings{
 			Enabled: true,
 		},
 		PostgresqlIndexSize: MetricSettings{
 			Enabled: true,
 		},
+		PostgresqlLockWaits: MetricSettings{
+			Enabled: false,
+		},
 		PostgresqlOperations: MetricSettings{
 			Enabled: true,
 		},
+		PostgresqlQueryCalls: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQueryMeanExecTime: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQueryRows: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQuerySharedBlocks: MetricSettings{
+			Enabled: false,
+		},
+		PostgresqlQueryTotalExecTime: MetricSettings{
+			Enabled: false,
+		},
 		PostgresqlReplicationDataDelay: MetricSettings{
 			Enabled: true,
 		},
@@ -254,6 +286,48 @@ var MapAttributeBgDurationType = map[string]AttributeBgDurationType{
 	"write": AttributeBgDurationTypeWrite,
 }
 
+// AttributeConnectionState specifies the a value connection_state attribute.
+type AttributeConnectionState int
+
+const (
+	_ AttributeConnectionState = iota
+	AttributeConnectionStateActive
+	AttributeConnectionStateIdle
+	AttributeConnectionStateIdleInTransaction
+	AttributeConnectionStateIdleInTransactionAborted
+	AttributeConnectionStateFastpathFunctionCall
+	AttributeConnectionStateDisabled
+)
+
+// String returns the string representation of the AttributeConnectionState.
+func (av AttributeConnectionState) String() string {
+	switch av {
+	case AttributeConnectionStateActive:
+		return "active"
+	case AttributeConnectionStateIdle:
+		return "idle"
+	case AttributeConnectionStateIdleInTransaction:
+		return "idle_in_transaction"
+	case AttributeConnectionStateIdleInTransactionAborted:
+		return "idle_in_transaction_aborted"
+	case AttributeConnectionStateFastpathFunctionCall:
+		return "fastpath_function_call"
+	case AttributeConnectionStateDisabled:
+		return "disabled"
+	}
+	return ""
+}
+
+// MapAttributeConnectionState is a helper map of string to AttributeConnectionState attribute value.
+var MapAttributeConnectionState = map[string]AttributeConnectionState{
+	"active":                      AttributeConnectionStateActive,
+	"idle":                        AttributeConnectionStateIdle,
+	"idle_in_transaction":         AttributeConnectionStateIdleInTransaction,
+	"idle_in_transaction_aborted": AttributeConnectionStateIdleInTransactionAborted,
+	"fastpath_function_call":      AttributeConnectionStateFastpathFunctionCall,
+	"disabled":                    AttributeConnectionStateDisabled,
+}
+
 // AttributeOperation specifies the a value operation attribute.
 type AttributeOperation int
 
@@ -288,6 +362,32 @@ var MapAttributeOperation = map[string]AttributeOperation{
 	"hot_upd": AttributeOperationHotUpd,
 }
 
+// AttributeSharedBlockType specifies the a value shared_block_type attribute.
+type AttributeSharedBlockType int
+
+const (
+	_ AttributeSharedBlockType = iota
+	AttributeSharedBlockTypeHit
+	AttributeSharedBlockTypeRead
+)
+
+// String returns the string representation of the AttributeSharedBlockType.
+func (av AttributeSharedBlockType) String() string {
+	switch av {
+	case AttributeSharedBlockTypeHit:
+		return "hit"
+	case AttributeSharedBlockTypeRead:
+		return "read"
+	}
+	return ""
+}
+
+// MapAttributeSharedBlockType is a helper map of string to AttributeSharedBlockType attribute value.
+var MapAttributeSharedBlockType = map[string]AttributeSharedBlockType{
+	"hit":  AttributeSharedBlockTypeHit,
+	"read": AttributeSharedBlockTypeRead,
+}
+
 // AttributeSource specifies the a value source attribute.
 type AttributeSource int
 
@@ -816,6 +916,59 @@ func newMetricPostgresqlCommits(settings MetricSettings) metricPostgresqlCommits
 	return m
 }
 
+type metricPostgresqlConnectionCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.connection.count metric with initial data.
+func (m *metricPostgresqlConnectionCount) init() {
+	m.data.SetName("postgresql.connection.count")
+	m.data.SetDescription("The number of client backend connections by state.")
+	m.data.SetUnit("{connections}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlConnectionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, connectionStateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", connectionStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlConnectionCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlConnectionCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlConnectionCount(settings MetricSettings) metricPostgresqlConnectionCount {
+	m := metricPostgresqlConnectionCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlConnectionMax struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -969,6 +1122,57 @@ func newMetricPostgresqlDbSize(settings MetricSettings) metricPostgresqlDbSize {
 	return m
 }
 
+type metricPostgresqlDeadlocks struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.deadlocks metric with initial data.
+func (m *metricPostgresqlDeadlocks) init() {
+	m.data.SetName("postgresql.deadlocks")
+	m.data.SetDescription("The number of deadlocks detected.")
+	m.data.SetUnit("{deadlocks}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricPostgresqlDeadlocks) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlDeadlocks) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlDeadlocks) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlDeadlocks(settings MetricSettings) metricPostgresqlDeadlocks {
+	m := metricPostgresqlDeadlocks{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlIndexScans struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1069,6 +1273,59 @@ func newMetricPostgresqlIndexSize(settings MetricSettings) metricPostgresqlIndex
 	return m
 }
 
+type metricPostgresqlLockWaits struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.lock.waits metric with initial data.
+func (m *metricPostgresqlLockWaits) init() {
+	m.data.SetName("postgresql.lock.waits")
+	m.data.SetDescription("The number of lock requests waiting to be granted.")
+	m.data.SetUnit("{locks}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlLockWaits) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, lockModeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("mode", lockModeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlLockWaits) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlLockWaits) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlLockWaits(settings MetricSettings) metricPostgresqlLockWaits {
+	m := metricPostgresqlLockWaits{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlOperations struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1124,6 +1381,275 @@ func newMetricPostgresqlOperations(settings MetricSettings) metricPostgresqlOper
 	return m
 }
 
+type metricPostgresqlQueryCalls struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.calls metric with initial data.
+func (m *metricPostgresqlQueryCalls) init() {
+	m.data.SetName("postgresql.query.calls")
+	m.data.SetDescription("The number of times the query was executed.")
+	m.data.SetUnit("{calls}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryCalls) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryCalls) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryCalls(settings MetricSettings) metricPostgresqlQueryCalls {
+	m := metricPostgresqlQueryCalls{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryMeanExecTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.mean_exec_time metric with initial data.
+func (m *metricPostgresqlQueryMeanExecTime) init() {
+	m.data.SetName("postgresql.query.mean_exec_time")
+	m.data.SetDescription("The mean time spent executing the query.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryMeanExecTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, queryIDAttributeValue string, queryAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryMeanExecTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryMeanExecTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryMeanExecTime(settings MetricSettings) metricPostgresqlQueryMeanExecTime {
+	m := metricPostgresqlQueryMeanExecTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryRows struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.rows metric with initial data.
+func (m *metricPostgresqlQueryRows) init() {
+	m.data.SetName("postgresql.query.rows")
+	m.data.SetDescription("The number of rows retrieved or affected by the query.")
+	m.data.SetUnit("{rows}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryRows) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryRows) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryRows) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryRows(settings MetricSettings) metricPostgresqlQueryRows {
+	m := metricPostgresqlQueryRows{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQuerySharedBlocks struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.shared_blocks metric with initial data.
+func (m *metricPostgresqlQuerySharedBlocks) init() {
+	m.data.SetName("postgresql.query.shared_blocks")
+	m.data.SetDescription("The number of shared blocks accessed by the query.")
+	m.data.SetUnit("{blocks}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQuerySharedBlocks) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string, sharedBlockTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+	dp.Attributes().PutStr("type", sharedBlockTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQuerySharedBlocks) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQuerySharedBlocks) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQuerySharedBlocks(settings MetricSettings) metricPostgresqlQuerySharedBlocks {
+	m := metricPostgresqlQuerySharedBlocks{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryTotalExecTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.total_exec_time metric with initial data.
+func (m *metricPostgresqlQueryTotalExecTime) init() {
+	m.data.SetName("postgresql.query.total_exec_time")
+	m.data.SetDescription("The total time spent executing the query.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryTotalExecTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, queryIDAttributeValue string, queryAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("query_id", queryIDAttributeValue)
+	dp.Attributes().PutStr("query", queryAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryTotalExecTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryTotalExecTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryTotalExecTime(settings MetricSettings) metricPostgresqlQueryTotalExecTime {
+	m := metricPostgresqlQueryTotalExecTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlReplicationDataDelay struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1554,12 +2080,20 @@ type MetricsBuilder struct {
 	metricPostgresqlBgwriterMaxwritten       metricPostgresqlBgwriterMaxwritten
 	metricPostgresqlBlocksRead               metricPostgresqlBlocksRead
 	metricPostgresqlCommits                  metricPostgresqlCommits
+	metricPostgresqlConnectionCount          metricPostgresqlConnectionCount
 	metricPostgresqlConnectionMax            metricPostgresqlConnectionMax
 	metricPostgresqlDatabaseCount            metricPostgresqlDatabaseCount
 	metricPostgresqlDbSize                   metricPostgresqlDbSize
+	metricPostgresqlDeadlocks                metricPostgresqlDeadlocks
 	metricPostgresqlIndexScans               metricPostgresqlIndexScans
 	metricPostgresqlIndexSize                metricPostgresqlIndexSize
+	metricPostgresqlLockWaits                metricPostgresqlLockWaits
 	metricPostgresqlOperations               metricPostgresqlOperations
+	metricPostgresqlQueryCalls               metricPostgresqlQueryCalls
+	metricPostgresqlQueryMeanExecTime        metricPostgresqlQueryMeanExecTime
+	metricPostgresqlQueryRows                metricPostgresqlQueryRows
+	metricPostgresqlQuerySharedBlocks        metricPostgresqlQuerySharedBlocks
+	metricPostgresqlQueryTotalExecTime       metricPostgresqlQueryTotalExecTime
 	metricPostgresqlReplicationDataDelay     metricPostgresqlReplicationDataDelay
 	metricPostgresqlRollbacks                metricPostgresqlRollbacks
 	metricPostgresqlRows                     metricPostgresqlRows
@@ -1601,12 +2135,20 @@ func NewMetricsBuilder(ms MetricsSettings, settings receiver.CreateSettings, opt
 		metricPostgresqlBgwriterMaxwritten:       newMetricPostgresqlBgwriterMaxwritten(ms.PostgresqlBgwriterMaxwritten),
 		metricPostgresqlBlocksRead:               newMetricPostgresqlBlocksRead(ms.PostgresqlBlocksRead),
 		metricPostgresqlCommits:                  newMetricPostgresqlCommits(ms.PostgresqlCommits),
+		metricPostgresqlConnectionCount:          newMetricPostgresqlConnectionCount(ms.PostgresqlConnectionCount),
 		metricPostgresqlConnectionMax:            newMetricPostgresqlConnectionMax(ms.PostgresqlConnectionMax),
 		metricPostgresqlDatabaseCount:            newMetricPostgresqlDatabaseCount(ms.PostgresqlDatabaseCount),
 		metricPostgresqlDbSize:                   newMetricPostgresqlDbSize(ms.PostgresqlDbSize),
+		metricPostgresqlDeadlocks:                newMetricPostgresqlDeadlocks(ms.PostgresqlDeadlocks),
 		metricPostgresqlIndexScans:               newMetricPostgresqlIndexScans(ms.PostgresqlIndexScans),
 		metricPostgresqlIndexSize:                newMetricPostgresqlIndexSize(ms.PostgresqlIndexSize),
+		metricPostgresqlLockWaits:                newMetricPostgresqlLockWaits(ms.PostgresqlLockWaits),
 		metricPostgresqlOperations:               newMetricPostgresqlOperations(ms.PostgresqlOperations),
+		metricPostgresqlQueryCalls:               newMetricPostgresqlQueryCalls(ms.PostgresqlQueryCalls),
+		metricPostgresqlQueryMeanExecTime:        newMetricPostgresqlQueryMeanExecTime(ms.PostgresqlQueryMeanExecTime),
+		metricPostgresqlQueryRows:                newMetricPostgresqlQueryRows(ms.PostgresqlQueryRows),
+		metricPostgresqlQuerySharedBlocks:        newMetricPostgresqlQuerySharedBlocks(ms.PostgresqlQuerySharedBlocks),
+		metricPostgresqlQueryTotalExecTime:       newMetricPostgresqlQueryTotalExecTime(ms.PostgresqlQueryTotalExecTime),
 		metricPostgresqlReplicationDataDelay:     newMetricPostgresqlReplicationDataDelay(ms.PostgresqlReplicationDataDelay),
 		metricPostgresqlRollbacks:                newMetricPostgresqlRollbacks(ms.PostgresqlRollbacks),
 		metricPostgresqlRows:                     newMetricPostgresqlRows(ms.PostgresqlRows),
@@ -1702,12 +2244,20 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricPostgresqlBgwriterMaxwritten.emit(ils.Metrics())
 	mb.metricPostgresqlBlocksRead.emit(ils.Metrics())
 	mb.metricPostgresqlCommits.emit(ils.Metrics())
+	mb.metricPostgresqlConnectionCount.emit(ils.Metrics())
 	mb.metricPostgresqlConnectionMax.emit(ils.Metrics())
 	mb.metricPostgresqlDatabaseCount.emit(ils.Metrics())
 	mb.metricPostgresqlDbSize.emit(ils.Metrics())
+	mb.metricPostgresqlDeadlocks.emit(ils.Metrics())
 	mb.metricPostgresqlIndexScans.emit(ils.Metrics())
 	mb.metricPostgresqlIndexSize.emit(ils.Metrics())
+	mb.metricPostgresqlLockWaits.emit(ils.Metrics())
 	mb.metricPostgresqlOperations.emit(ils.Metrics())
+	mb.metricPostgresqlQueryCalls.emit(ils.Metrics())
+	mb.metricPostgresqlQueryMeanExecTime.emit(ils.Metrics())
+	mb.metricPostgresqlQueryRows.emit(ils.Metrics())
+	mb.metricPostgresqlQuerySharedBlocks.emit(ils.Metrics())
+	mb.metricPostgresqlQueryTotalExecTime.emit(ils.Metrics())
 	mb.metricPostgresqlReplicationDataDelay.emit(ils.Metrics())
 	mb.metricPostgresqlRollbacks.emit(ils.Metrics())
 	mb.metricPostgresqlRows.emit(ils.Metrics())
@@ -1776,6 +2326,11 @@ func (mb *MetricsBuilder) RecordPostgresqlCommitsDataPoint(ts pcommon.Timestamp,
 	mb.metricPostgresqlCommits.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
 }
 
+// RecordPostgresqlConnectionCountDataPoint adds a data point to postgresql.connection.count metric.
+func (mb *MetricsBuilder) RecordPostgresqlConnectionCountDataPoint(ts pcommon.Timestamp, val int64, connectionStateAttributeValue AttributeConnectionState) {
+	mb.metricPostgresqlConnectionCount.recordDataPoint(mb.startTime, ts, val, connectionStateAttributeValue.String())
+}
+
 // RecordPostgresqlConnectionMaxDataPoint adds a data point to postgresql.connection.max metric.
 func (mb *MetricsBuilder) RecordPostgresqlConnectionMaxDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricPostgresqlConnectionMax.recordDataPoint(mb.startTime, ts, val)
@@ -1791,6 +2346,11 @@ func (mb *MetricsBuilder) RecordPostgresqlDbSizeDataPoint(ts pcommon.Timestamp,
 	mb.metricPostgresqlDbSize.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue)
 }
 
+// RecordPostgresqlDeadlocksDataPoint adds a data point to postgresql.deadlocks metric.
+func (mb *MetricsBuilder) RecordPostgresqlDeadlocksDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricPostgresqlDeadlocks.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordPostgresqlIndexScansDataPoint adds a data point to postgresql.index.scans metric.
 func (mb *MetricsBuilder) RecordPostgresqlIndexScansDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricPostgresqlIndexScans.recordDataPoint(mb.startTime, ts, val)
@@ -1801,11 +2361,41 @@ func (mb *MetricsBuilder) RecordPostgresqlIndexSizeDataPoint(ts pcommon.Timestam
 	mb.metricPostgresqlIndexSize.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordPostgresqlLockWaitsDataPoint adds a data point to postgresql.lock.waits metric.
+func (mb *MetricsBuilder) RecordPostgresqlLockWaitsDataPoint(ts pcommon.Timestamp, val int64, lockModeAttributeValue string) {
+	mb.metricPostgresqlLockWaits.recordDataPoint(mb.startTime, ts, val, lockModeAttributeValue)
+}
+
 // RecordPostgresqlOperationsDataPoint adds a data point to postgresql.operations metric.
 func (mb *MetricsBuilder) RecordPostgresqlOperationsDataPoint(ts pcommon.Timestamp, val int64, databaseAttributeValue string, tableAttributeValue string, operationAttributeValue AttributeOperation) {
 	mb.metricPostgresqlOperations.recordDataPoint(mb.startTime, ts, val, databaseAttributeValue, tableAttributeValue, operationAttributeValue.String())
 }
 
+// RecordPostgresqlQueryCallsDataPoint adds a data point to postgresql.query.calls metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryCallsDataPoint(ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string) {
+	mb.metricPostgresqlQueryCalls.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue)
+}
+
+// RecordPostgresqlQueryMeanExecTimeDataPoint adds a data point to postgresql.query.mean_exec_time metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryMeanExecTimeDataPoint(ts pcommon.Timestamp, val float64, queryIDAttributeValue string, queryAttributeValue string) {
+	mb.metricPostgresqlQueryMeanExecTime.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue)
+}
+
+// RecordPostgresqlQueryRowsDataPoint adds a data point to postgresql.query.rows metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryRowsDataPoint(ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string) {
+	mb.metricPostgresqlQueryRows.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue)
+}
+
+// RecordPostgresqlQuerySharedBlocksDataPoint adds a data point to postgresql.query.shared_blocks metric.
+func (mb *MetricsBuilder) RecordPostgresqlQuerySharedBlocksDataPoint(ts pcommon.Timestamp, val int64, queryIDAttributeValue string, queryAttributeValue string, sharedBlockTypeAttributeValue AttributeSharedBlockType) {
+	mb.metricPostgresqlQuerySharedBlocks.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue, sharedBlockTypeAttributeValue.String())
+}
+
+// RecordPostgresqlQueryTotalExecTimeDataPoint adds a data point to postgresql.query.total_exec_time metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryTotalExecTimeDataPoint(ts pcommon.Timestamp, val float64, queryIDAttributeValue string, queryAttributeValue string) {
+	mb.metricPostgresqlQueryTotalExecTime.recordDataPoint(mb.startTime, ts, val, queryIDAttributeValue, queryAttributeValue)
+}
+
 // RecordPostgresqlReplicationDataDelayDataPoint adds a data point to postgresql.replication.data_delay metric.
 func (mb *MetricsBuilder) RecordPostgresqlReplicationDataDelayDataPoint(ts pcommon.Timestamp, val int64, replicationClientAttributeValue string) {
 	mb.metricPostgresqlReplicationDataDelay.recordDataPoint(mb.startTime, ts, val, replicationClientAttributeValue)
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresqlreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postgresqlreceiver"
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	topQueryEventName         = "db.top_query"
+	longRunningQueryEventName = "db.long_running_query"
+)
+
+// postgreSQLLogsReceiver periodically emits the most expensive queries since the previous collection
+// and the queries that have been running for longer than a threshold as log records.
+type postgreSQLLogsReceiver struct {
+	logger        *zap.Logger
+	config        *Config
+	consumer      consumer.Logs
+	buildInfo     component.BuildInfo
+	clientFactory postgreSQLClientFactory
+
+	// previousStats holds the cumulative statistics of each query, keyed by database, user and query id,
+	// as of the previous collection. It is nil until the first collection.
+	previousStats map[string]statementStats
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newPostgreSQLLogsReceiver(
+	settings receiver.CreateSettings,
+	config *Config,
+	consumer consumer.Logs,
+	clientFactory postgreSQLClientFactory,
+) *postgreSQLLogsReceiver {
+	return &postgreSQLLogsReceiver{
+		logger:        settings.Logger,
+		config:        config,
+		consumer:      consumer,
+		buildInfo:     settings.BuildInfo,
+		clientFactory: clientFactory,
+	}
+}
+
+// Start begins collecting on the configured interval.
+func (r *postgreSQLLogsReceiver) Start(_ context.Context, _ component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.CollectionInterval)
+		defer ticker.Stop()
+
+		for {
+			r.collect(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the collection.
+func (r *postgreSQLLogsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *postgreSQLLogsReceiver) collect(ctx context.Context) {
+	logs, err := r.buildLogs(ctx, time.Now())
+	if err != nil {
+		r.logger.Error("Failed to collect query logs", zap.Error(err))
+	}
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		r.logger.Error("Failed to consume query logs", zap.Error(err))
+	}
+}
+
+func (r *postgreSQLLogsReceiver) buildLogs(ctx context.Context, now time.Time) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	listClient, err := r.clientFactory.getClient(r.config, "")
+	if err != nil {
+		return logs, err
+	}
+	defer listClient.Close()
+
+	sl := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("otelcol/postgresqlreceiver")
+	sl.Scope().SetVersion(r.buildInfo.Version)
+
+	ts := pcommon.NewTimestampFromTime(now)
+	var errs error
+	if r.config.QueryLogs.TopN > 0 {
+		errs = multierr.Append(errs, r.recordTopQueries(ctx, ts, listClient, sl.LogRecords()))
+	}
+	if r.config.QueryLogs.LongRunningQueryThreshold > 0 {
+		errs = multierr.Append(errs, r.recordLongRunningQueries(ctx, ts, listClient, sl.LogRecords()))
+	}
+	return logs, errs
+}
+
+// recordTopQueries records the queries with the highest total execution time since the previous collection.
+// The first collection only establishes the baseline.
+func (r *postgreSQLLogsReceiver) recordTopQueries(ctx context.Context, ts pcommon.Timestamp, client client, records plog.LogRecordSlice) error {
+	stats, err := client.getStatementStats(ctx, r.config.Databases)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]statementStats, len(stats))
+	var deltas []statementStats
+	for _, s := range stats {
+		key := s.database + "|" + s.user + "|" + s.queryID
+		current[key] = s
+		if r.previousStats == nil {
+			continue
+		}
+		delta := s
+		// A query whose number of calls decreased has been reset, in which case the current values are the delta.
+		if prev, ok := r.previousStats[key]; ok && s.calls >= prev.calls {
+			delta.calls -= prev.calls
+			delta.totalExecTime -= prev.totalExecTime
+		}
+		if delta.calls > 0 {
+			deltas = append(deltas, delta)
+		}
+	}
+	r.previousStats = current
+
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].totalExecTime > deltas[j].totalExecTime
+	})
+	if len(deltas) > r.config.QueryLogs.TopN {
+		deltas = deltas[:r.config.QueryLogs.TopN]
+	}
+
+	for _, d := range deltas {
+		lr := newQueryLogRecord(records, ts, topQueryEventName, d.query)
+		attrs := lr.Attributes()
+		attrs.PutStr("db.name", d.database)
+		attrs.PutStr("db.user", d.user)
+		attrs.PutStr("db.query.digest", d.queryID)
+		attrs.PutInt("db.query.calls", d.calls)
+		attrs.PutDouble("db.query.duration", d.totalExecTime)
+	}
+	return nil
+}
+
+func (r *postgreSQLLogsReceiver) recordLongRunningQueries(ctx context.Context, ts pcommon.Timestamp, client client, records plog.LogRecordSlice) error {
+	queries, err := client.getLongRunningQueries(ctx, r.config.Databases, r.config.QueryLogs.LongRunningQueryThreshold)
+	if err != nil {
+		return err
+	}
+
+	for _, q := range queries {
+		lr := newQueryLogRecord(records, ts, longRunningQueryEventName, q.query)
+		attrs := lr.Attributes()
+		attrs.PutStr("db.name", q.database)
+		attrs.PutStr("db.user", q.user)
+		if q.queryID != "" {
+			attrs.PutStr("db.query.digest", q.queryID)
+		}
+		attrs.PutDouble("db.query.duration", q.duration)
+	}
+	return nil
+}
+
+func newQueryLogRecord(records plog.LogRecordSlice, ts pcommon.Timestamp, eventName string, query string) plog.LogRecord {
+	lr := records.AppendEmpty()
+	lr.SetTimestamp(ts)
+	lr.SetObservedTimestamp(ts)
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	if query != "" {
+		lr.Body().SetStr(query)
+	}
+	lr.Attributes().PutStr("event.name", eventName)
+	lr.Attributes().PutStr("db.system", "postgresql")
+	return lr
+}
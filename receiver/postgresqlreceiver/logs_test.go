@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresqlreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestBuildLogsTopQueries(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.QueryLogs.TopN = 2
+	cfg.QueryLogs.LongRunningQueryThreshold = 0
+
+	listClient := new(mockClient)
+	listClient.On("Close").Return(nil)
+	listClient.On("getStatementStats", []string(nil)).Return([]statementStats{
+		{database: "otel", user: "otel", queryID: "1", query: "SELECT * FROM a", calls: 10, totalExecTime: 10},
+		{database: "otel", user: "otel", queryID: "2", query: "SELECT * FROM b", calls: 10, totalExecTime: 10},
+		{database: "otel", user: "otel", queryID: "3", query: "SELECT * FROM c", calls: 10, totalExecTime: 10},
+	}, nil).Once()
+	listClient.On("getStatementStats", []string(nil)).Return([]statementStats{
+		// unchanged since the previous collection
+		{database: "otel", user: "otel", queryID: "1", query: "SELECT * FROM a", calls: 10, totalExecTime: 10},
+		{database: "otel", user: "otel", queryID: "2", query: "SELECT * FROM b", calls: 12, totalExecTime: 15},
+		// reset since the previous collection
+		{database: "otel", user: "otel", queryID: "3", query: "SELECT * FROM c", calls: 1, totalExecTime: 2},
+		{database: "otel", user: "admin", queryID: "1", query: "SELECT * FROM a", calls: 3, totalExecTime: 1},
+	}, nil).Once()
+	factory := new(mockClientFactory)
+	factory.On("getClient", "").Return(listClient, nil)
+
+	r := newPostgreSQLLogsReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop(), factory)
+
+	// the first collection only establishes the baseline
+	logs, err := r.buildLogs(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.LogRecordCount())
+
+	logs, err = r.buildLogs(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	records := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assertQueryRecord(t, records.At(0), topQueryEventName, "SELECT * FROM b", map[string]interface{}{
+		"db.system":         "postgresql",
+		"db.name":           "otel",
+		"db.user":           "otel",
+		"db.query.digest":   "2",
+		"db.query.calls":    int64(2),
+		"db.query.duration": 5.0,
+	})
+	assertQueryRecord(t, records.At(1), topQueryEventName, "SELECT * FROM c", map[string]interface{}{
+		"db.system":         "postgresql",
+		"db.name":           "otel",
+		"db.user":           "otel",
+		"db.query.digest":   "3",
+		"db.query.calls":    int64(1),
+		"db.query.duration": 2.0,
+	})
+	listClient.AssertExpectations(t)
+}
+
+func TestBuildLogsLongRunningQueries(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Databases = []string{"otel"}
+	cfg.QueryLogs.TopN = 0
+
+	listClient := new(mockClient)
+	listClient.On("Close").Return(nil)
+	listClient.On("getLongRunningQueries", []string{"otel"}, defaultLongRunningQueryThreshold).Return([]runningQuery{
+		{database: "otel", user: "otel", queryID: "4", query: "SELECT pg_sleep($1)", duration: 30000},
+		// Servers without pg_stat_statements support return neither the query id nor the normalized text.
+		{database: "otel", user: "app", duration: 15000},
+	}, nil)
+	factory := new(mockClientFactory)
+	factory.On("getClient", "").Return(listClient, nil)
+
+	r := newPostgreSQLLogsReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop(), factory)
+	logs, err := r.buildLogs(context.Background(), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+	records := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assertQueryRecord(t, records.At(0), longRunningQueryEventName, "SELECT pg_sleep($1)", map[string]interface{}{
+		"db.system":         "postgresql",
+		"db.name":           "otel",
+		"db.user":           "otel",
+		"db.query.digest":   "4",
+		"db.query.duration": 30000.0,
+	})
+	assertQueryRecord(t, records.At(1), longRunningQueryEventName, "", map[string]interface{}{
+		"db.system":         "postgresql",
+		"db.name":           "otel",
+		"db.user":           "app",
+		"db.query.duration": 15000.0,
+	})
+}
+
+func TestLogsReceiverStartShutdown(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CollectionInterval = 10 * time.Millisecond
+	cfg.QueryLogs.TopN = 0
+
+	listClient := new(mockClient)
+	listClient.On("Close").Return(nil)
+	listClient.On("getLongRunningQueries", mock.Anything, mock.Anything).Return([]runningQuery{
+		{database: "otel", user: "otel", queryID: "4", query: "SELECT pg_sleep($1)", duration: 30000},
+	}, nil)
+	factory := new(mockClientFactory)
+	factory.On("getClient", "").Return(listClient, nil)
+
+	sink := new(consumertest.LogsSink)
+	r := newPostgreSQLLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink, factory)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() > 1
+	}, 2*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func assertQueryRecord(t *testing.T, lr plog.LogRecord, eventName string, query string, attrs map[string]interface{}) {
+	assert.Equal(t, query, lr.Body().Str())
+	expected := map[string]interface{}{"event.name": eventName}
+	for k, v := range attrs {
+		expected[k] = v
+	}
+	assert.Equal(t, expected, lr.Attributes().AsRaw())
+}
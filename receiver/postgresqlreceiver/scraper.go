@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -94,9 +95,12 @@ func newPostgreSQLScraper(
 
 type dbRetrieval struct {
 	sync.RWMutex
-	activityMap map[databaseName]int64
-	dbSizeMap   map[databaseName]int64
-	dbStats     map[databaseName]databaseStats
+	activityMap      map[databaseName]int64
+	dbSizeMap        map[databaseName]int64
+	dbStats          map[databaseName]databaseStats
+	connectionStates map[databaseName]map[string]int64
+	lockWaits        map[databaseName]map[string]int64
+	queryStats       map[databaseName][]queryStats
 }
 
 // scrape scrapes the metric stats, transforms them and attributes them into a metric slices.
@@ -122,9 +126,12 @@ func (p *postgreSQLScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 
 	var errs scrapererror.ScrapeErrors
 	r := &dbRetrieval{
-		activityMap: make(map[databaseName]int64),
-		dbSizeMap:   make(map[databaseName]int64),
-		dbStats:     make(map[databaseName]databaseStats),
+		activityMap:      make(map[databaseName]int64),
+		dbSizeMap:        make(map[databaseName]int64),
+		dbStats:          make(map[databaseName]databaseStats),
+		connectionStates: make(map[databaseName]map[string]int64),
+		lockWaits:        make(map[databaseName]map[string]int64),
+		queryStats:       make(map[databaseName][]queryStats),
 	}
 	p.retrieveDBMetrics(ctx, listClient, databases, r, &errs)
 
@@ -170,6 +177,26 @@ func (p *postgreSQLScraper) retrieveDBMetrics(
 	go p.retrieveDatabaseSize(ctx, wg, listClient, databases, r, errs)
 	go p.retrieveDatabaseStats(ctx, wg, listClient, databases, r, errs)
 
+	if p.emitMetricsWithResourceAttributes {
+		metrics := p.config.Metrics
+		if metrics.PostgresqlConnectionCount.Enabled {
+			wg.Add(1)
+			go p.retrieveConnectionStates(ctx, wg, listClient, databases, r, errs)
+		}
+		if metrics.PostgresqlLockWaits.Enabled {
+			wg.Add(1)
+			go p.retrieveLockWaits(ctx, wg, listClient, databases, r, errs)
+		}
+		if metrics.PostgresqlQueryCalls.Enabled ||
+			metrics.PostgresqlQueryMeanExecTime.Enabled ||
+			metrics.PostgresqlQueryRows.Enabled ||
+			metrics.PostgresqlQuerySharedBlocks.Enabled ||
+			metrics.PostgresqlQueryTotalExecTime.Enabled {
+			wg.Add(1)
+			go p.retrieveQueryStats(ctx, wg, listClient, databases, r, errs)
+		}
+	}
+
 	wg.Wait()
 }
 
@@ -186,6 +213,18 @@ func (p *postgreSQLScraper) recordDatabase(now pcommon.Timestamp, db string, r *
 		if stats, ok := r.dbStats[dbName]; ok {
 			p.mb.RecordPostgresqlCommitsDataPointWithoutDatabase(now, stats.transactionCommitted)
 			p.mb.RecordPostgresqlRollbacksDataPointWithoutDatabase(now, stats.transactionRollback)
+			p.mb.RecordPostgresqlDeadlocksDataPoint(now, stats.deadlocks)
+		}
+		for state, count := range r.connectionStates[dbName] {
+			if attr, ok := metadata.MapAttributeConnectionState[connectionStateReplacer.Replace(state)]; ok {
+				p.mb.RecordPostgresqlConnectionCountDataPoint(now, count, attr)
+			}
+		}
+		for mode, count := range r.lockWaits[dbName] {
+			p.mb.RecordPostgresqlLockWaitsDataPoint(now, count, mode)
+		}
+		for _, qs := range r.queryStats[dbName] {
+			p.recordQueryStats(now, qs)
 		}
 		p.mb.EmitForResource(metadata.WithPostgresqlDatabaseName(db))
 	} else {
@@ -202,6 +241,21 @@ func (p *postgreSQLScraper) recordDatabase(now pcommon.Timestamp, db string, r *
 	}
 }
 
+// connectionStateReplacer converts a pg_stat_activity state such as "idle in transaction (aborted)"
+// into its attribute value "idle_in_transaction_aborted".
+var connectionStateReplacer = strings.NewReplacer(" ", "_", "(", "", ")", "")
+
+func (p *postgreSQLScraper) recordQueryStats(now pcommon.Timestamp, qs queryStats) {
+	p.mb.RecordPostgresqlQueryCallsDataPoint(now, qs.calls, qs.queryID, qs.query)
+	p.mb.RecordPostgresqlQueryTotalExecTimeDataPoint(now, qs.totalExecTime, qs.queryID, qs.query)
+	if qs.calls > 0 {
+		p.mb.RecordPostgresqlQueryMeanExecTimeDataPoint(now, qs.totalExecTime/float64(qs.calls), qs.queryID, qs.query)
+	}
+	p.mb.RecordPostgresqlQueryRowsDataPoint(now, qs.rows, qs.queryID, qs.query)
+	p.mb.RecordPostgresqlQuerySharedBlocksDataPoint(now, qs.sharedBlocksHit, qs.queryID, qs.query, metadata.AttributeSharedBlockTypeHit)
+	p.mb.RecordPostgresqlQuerySharedBlocksDataPoint(now, qs.sharedBlocksRead, qs.queryID, qs.query, metadata.AttributeSharedBlockTypeRead)
+}
+
 func (p *postgreSQLScraper) collectTables(ctx context.Context, now pcommon.Timestamp, dbClient client, db string, errs *scrapererror.ScrapeErrors) (numTables int64) {
 	blockReads, err := dbClient.getBlocksReadByTable(ctx, db)
 	if err != nil {
@@ -432,3 +486,65 @@ func (p *postgreSQLScraper) retrieveBackends(
 	r.activityMap = activityByDB
 	r.Unlock()
 }
+
+func (p *postgreSQLScraper) retrieveConnectionStates(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	client client,
+	databases []string,
+	r *dbRetrieval,
+	errors *scrapererror.ScrapeErrors,
+) {
+	defer wg.Done()
+	states, err := client.getConnectionStates(ctx, databases)
+	if err != nil {
+		errors.AddPartial(1, err)
+		return
+	}
+	r.Lock()
+	r.connectionStates = states
+	r.Unlock()
+}
+
+func (p *postgreSQLScraper) retrieveLockWaits(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	client client,
+	databases []string,
+	r *dbRetrieval,
+	errors *scrapererror.ScrapeErrors,
+) {
+	defer wg.Done()
+	lockWaits, err := client.getLockWaits(ctx, databases)
+	if err != nil {
+		errors.AddPartial(1, err)
+		return
+	}
+	r.Lock()
+	r.lockWaits = lockWaits
+	r.Unlock()
+}
+
+func (p *postgreSQLScraper) retrieveQueryStats(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	client client,
+	databases []string,
+	r *dbRetrieval,
+	errors *scrapererror.ScrapeErrors,
+) {
+	defer wg.Done()
+	stats, err := client.getQueryStats(ctx, databases, p.config.TopQueryCount)
+	if err != nil {
+		p.logger.Error("Errors encountered while fetching query statistics", zap.Error(err))
+		errors.AddPartial(1, err)
+		return
+	}
+	byDatabase := map[databaseName][]queryStats{}
+	for _, stat := range stats {
+		byDatabase[databaseName(stat.database)] = append(byDatabase[databaseName(stat.database)], stat)
+	}
+	r.Lock()
+	r.queryStats = byDatabase
+	r.Unlock()
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -141,6 +142,32 @@ func TestScraperWithResourceAttributeFeatureGateSingle(t *testing.T) {
 		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 }
 
+func TestScraperWithQueryAndLockMetrics(t *testing.T) {
+	factory := mockClientFactory{}
+	factory.initMocks([]string{"otel"})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.PostgresqlConnectionCount.Enabled = true
+	cfg.Metrics.PostgresqlDeadlocks.Enabled = true
+	cfg.Metrics.PostgresqlLockWaits.Enabled = true
+	cfg.Metrics.PostgresqlQueryCalls.Enabled = true
+	cfg.Metrics.PostgresqlQueryMeanExecTime.Enabled = true
+	cfg.Metrics.PostgresqlQueryRows.Enabled = true
+	cfg.Metrics.PostgresqlQuerySharedBlocks.Enabled = true
+	cfg.Metrics.PostgresqlQueryTotalExecTime.Enabled = true
+	scraper := newPostgreSQLScraper(receivertest.NewNopCreateSettings(), cfg, &factory)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "otel", "expected_with_query_stats.json")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics, pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
+
 type mockClientFactory struct{ mock.Mock }
 type mockClient struct{ mock.Mock }
 
@@ -181,6 +208,31 @@ func (m *mockClient) getIndexStats(ctx context.Context, database string) (map[in
 	return args.Get(0).(map[indexIdentifer]indexStat), args.Error(1)
 }
 
+func (m *mockClient) getConnectionStates(_ context.Context, databases []string) (map[databaseName]map[string]int64, error) {
+	args := m.Called(databases)
+	return args.Get(0).(map[databaseName]map[string]int64), args.Error(1)
+}
+
+func (m *mockClient) getLockWaits(_ context.Context, databases []string) (map[databaseName]map[string]int64, error) {
+	args := m.Called(databases)
+	return args.Get(0).(map[databaseName]map[string]int64), args.Error(1)
+}
+
+func (m *mockClient) getQueryStats(_ context.Context, databases []string, limit int) ([]queryStats, error) {
+	args := m.Called(databases, limit)
+	return args.Get(0).([]queryStats), args.Error(1)
+}
+
+func (m *mockClient) getStatementStats(_ context.Context, databases []string) ([]statementStats, error) {
+	args := m.Called(databases)
+	return args.Get(0).([]statementStats), args.Error(1)
+}
+
+func (m *mockClient) getLongRunningQueries(_ context.Context, databases []string, threshold time.Duration) ([]runningQuery, error) {
+	args := m.Called(databases, threshold)
+	return args.Get(0).([]runningQuery), args.Error(1)
+}
+
 func (m *mockClient) getBGWriterStats(ctx context.Context) (*bgStat, error) {
 	args := m.Called(ctx)
 	return args.Get(0).(*bgStat), args.Error(1)
@@ -232,19 +284,44 @@ func (m *mockClient) initMocks(database string, databases []string, index int) {
 		commitsAndRollbacks := map[databaseName]databaseStats{}
 		dbSize := map[databaseName]int64{}
 		backends := map[databaseName]int64{}
+		connectionStates := map[databaseName]map[string]int64{}
+		lockWaits := map[databaseName]map[string]int64{}
+		var stats []queryStats
 
 		for idx, db := range databases {
 			commitsAndRollbacks[databaseName(db)] = databaseStats{
 				transactionCommitted: int64(idx + 1),
 				transactionRollback:  int64(idx + 2),
+				deadlocks:            int64(idx + 5),
 			}
 			dbSize[databaseName(db)] = int64(idx + 4)
 			backends[databaseName(db)] = int64(idx + 3)
+			connectionStates[databaseName(db)] = map[string]int64{
+				"active":                        int64(idx + 6),
+				"idle":                          int64(idx + 7),
+				"idle in transaction (aborted)": int64(idx + 8),
+			}
+			lockWaits[databaseName(db)] = map[string]int64{
+				"RowExclusiveLock": int64(idx + 9),
+			}
+			stats = append(stats, queryStats{
+				database:         db,
+				queryID:          fmt.Sprintf("%d", 1000+idx),
+				query:            "SELECT * FROM table1 WHERE id = $1",
+				calls:            int64(idx + 10),
+				totalExecTime:    float64(idx+1) * 100,
+				rows:             int64(idx + 11),
+				sharedBlocksHit:  int64(idx + 12),
+				sharedBlocksRead: int64(idx + 13),
+			})
 		}
 
 		m.On("getDatabaseStats", databases).Return(commitsAndRollbacks, nil)
 		m.On("getDatabaseSize", databases).Return(dbSize, nil)
 		m.On("getBackends", databases).Return(backends, nil)
+		m.On("getConnectionStates", databases).Return(connectionStates, nil)
+		m.On("getLockWaits", databases).Return(lockWaits, nil)
+		m.On("getQueryStats", databases, defaultTopQueryCount).Return(stats, nil)
 		m.On("getBGWriterStats", mock.Anything).Return(&bgStat{
 			checkpointsReq:       1,
 			checkpointsScheduled: 2,
@@ -15,6 +15,7 @@
 package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cast"
@@ -53,6 +54,9 @@ type receiverTemplate struct {
 	// Rule is the discovery rule that when matched will create a receiver instance
 	// based on receiverTemplate.
 	Rule string `mapstructure:"rule"`
+	// OTTLRule is the discovery rule written as an OTTL condition. It is an alternative to Rule,
+	// only one of them can be set.
+	OTTLRule string `mapstructure:"ottl_rule"`
 	// ResourceAttributes is a map of resource attributes to add to just this receiver's resource metrics.
 	// It can contain expr expressions for endpoint env value expansion
 	ResourceAttributes map[string]interface{} `mapstructure:"resource_attributes"`
@@ -89,6 +93,8 @@ type Config struct {
 	// ResourceAttributes is a map of default resource attributes to add to each resource
 	// object received by this receiver from dynamically created receivers.
 	ResourceAttributes resourceAttributes `mapstructure:"resource_attributes"`
+	// Discovery configures receivers started from the annotations of discovered pods.
+	Discovery DiscoveryConfig `mapstructure:"discovery"`
 }
 
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
@@ -103,12 +109,16 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 
 	for endpointType := range cfg.ResourceAttributes {
 		switch endpointType {
-		case observer.ContainerType, observer.HostPortType, observer.K8sNodeType, observer.PodType, observer.PortType:
+		case observer.ContainerType, observer.HostPortType, observer.K8sNodeType, observer.PodType, observer.PortType, observer.ProcessType:
 		default:
 			return fmt.Errorf("resource attributes for unsupported endpoint type %q", endpointType)
 		}
 	}
 
+	if cfg.Discovery.Enabled && cfg.Discovery.AnnotationPrefix == "" {
+		return errors.New("discovery annotation_prefix must be set when discovery is enabled")
+	}
+
 	receiversCfg, err := componentParser.Sub(receiversConfigKey)
 	if err != nil {
 		return fmt.Errorf("unable to extract key %v: %w", receiversConfigKey, err)
@@ -130,9 +140,17 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 			return fmt.Errorf("failed to deserialize sub-receiver %q: %w", subreceiverKey, err)
 		}
 
-		subreceiver.rule, err = newRule(subreceiver.Rule)
-		if err != nil {
-			return fmt.Errorf("subreceiver %q rule is invalid: %w", subreceiverKey, err)
+		switch {
+		case subreceiver.Rule != "" && subreceiver.OTTLRule != "":
+			return fmt.Errorf("subreceiver %q cannot set both rule and ottl_rule", subreceiverKey)
+		case subreceiver.OTTLRule != "":
+			if subreceiver.rule, err = newOTTLRule(subreceiver.OTTLRule); err != nil {
+				return fmt.Errorf("subreceiver %q ottl_rule is invalid: %w", subreceiverKey, err)
+			}
+		default:
+			if subreceiver.rule, err = newRule(subreceiver.Rule); err != nil {
+				return fmt.Errorf("subreceiver %q rule is invalid: %w", subreceiverKey, err)
+			}
 		}
 
 		for k, v := range subreceiver.ResourceAttributes {
@@ -118,6 +118,12 @@ func TestLoadConfig(t *testing.T) {
 					observer.PortType:      {"port.key": "port.value"},
 					observer.HostPortType:  {"hostport.key": "hostport.value"},
 					observer.K8sNodeType:   {"k8s.node.key": "k8s.node.value"},
+					observer.ProcessType:   {"process.key": "process.value"},
+				},
+				Discovery: DiscoveryConfig{
+					Enabled:          true,
+					AnnotationPrefix: defaultAnnotationPrefix,
+					AllowedReceivers: []string{"nop"},
 				},
 			},
 		},
@@ -138,6 +144,32 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestLoadConfigOTTLRule(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	sub, err := cm.Sub(component.NewIDWithName(typeStr, "ottl").String())
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	template := cfg.receiverTemplates["nop/1"]
+	assert.Equal(t, `type == "port" and pod.labels["app"] == "redis"`, template.OTTLRule)
+	assert.Empty(t, template.Rule)
+	require.NotNil(t, template.rule.condition)
+
+	env, err := portEndpoint.Env()
+	require.NoError(t, err)
+	matches, err := template.rule.eval(env)
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	sub, err = cm.Sub(component.NewIDWithName(typeStr, "rule_and_ottl_rule").String())
+	require.NoError(t, err)
+	cfg = NewFactory().CreateDefaultConfig().(*Config)
+	assert.EqualError(t, component.UnmarshalConfig(sub, cfg), `subreceiver "nop/1" cannot set both rule and ottl_rule`)
+}
+
 func TestInvalidResourceAttributeEndpointType(t *testing.T) {
 	factories, err := otelcoltest.NopFactories()
 	require.Nil(t, err)
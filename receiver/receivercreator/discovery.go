@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+const (
+	// defaultAnnotationPrefix is the prefix of the pod annotations read when discovery is enabled.
+	defaultAnnotationPrefix = "io.opentelemetry.discovery"
+
+	// receiverAnnotation holds the id (<type>[/<name>]) of the receiver to start.
+	receiverAnnotation = "receiver"
+	// configAnnotation holds the YAML configuration of the receiver to start.
+	configAnnotation = "config"
+)
+
+// DiscoveryConfig configures the receivers started from the annotations of discovered pods.
+type DiscoveryConfig struct {
+	// Enabled turns on starting receivers from pod annotations for port endpoints.
+	Enabled bool `mapstructure:"enabled"`
+	// AnnotationPrefix is the prefix of the annotations describing the receiver to start.
+	// `<prefix>/receiver` and `<prefix>/config` apply to all the ports of the pod, while
+	// `<prefix>.<port>/receiver` and `<prefix>.<port>/config` only apply to the given port.
+	AnnotationPrefix string `mapstructure:"annotation_prefix"`
+	// AllowedReceivers are the receiver types that can be started from annotations. It must
+	// be set when discovery is enabled.
+	AllowedReceivers []string `mapstructure:"allowed_receivers"`
+}
+
+// Validate checks that the receiver types that can be started from annotations are listed.
+func (d *DiscoveryConfig) Validate() error {
+	if d.Enabled && len(d.AllowedReceivers) == 0 {
+		return errors.New("discovery allowed_receivers must be set when discovery is enabled")
+	}
+	return nil
+}
+
+// receiverTemplate returns the template of the receiver described by the annotations of the pod
+// the endpoint belongs to, and whether such annotations were found. Only port endpoints are
+// supported since the other endpoint types don't have a target a receiver can connect to.
+func (d *DiscoveryConfig) receiverTemplate(e observer.Endpoint) (receiverTemplate, bool, error) {
+	port, ok := e.Details.(*observer.Port)
+	if !ok {
+		return receiverTemplate{}, false, nil
+	}
+
+	annotations := port.Pod.Annotations
+	prefix := fmt.Sprintf("%s.%d", d.AnnotationPrefix, port.Port)
+	name, ok := annotations[prefix+"/"+receiverAnnotation]
+	if !ok {
+		prefix = d.AnnotationPrefix
+		if name, ok = annotations[prefix+"/"+receiverAnnotation]; !ok {
+			return receiverTemplate{}, false, nil
+		}
+	}
+
+	cfg := userConfigMap{}
+	if raw, ok := annotations[prefix+"/"+configAnnotation]; ok {
+		if err := yaml.Unmarshal([]byte(raw), &cfg); err != nil {
+			return receiverTemplate{}, false, fmt.Errorf("failed to parse annotation %q: %w", prefix+"/"+configAnnotation, err)
+		}
+	}
+
+	template, err := newReceiverTemplate(name, cfg)
+	if err != nil {
+		return receiverTemplate{}, false, fmt.Errorf("invalid receiver %q in annotation %q: %w", name, prefix+"/"+receiverAnnotation, err)
+	}
+	if !d.allowed(template) {
+		return receiverTemplate{}, false, fmt.Errorf("receiver %q is not in the allowed receivers", name)
+	}
+	return template, true, nil
+}
+
+func (d *DiscoveryConfig) allowed(template receiverTemplate) bool {
+	for _, allowed := range d.AllowedReceivers {
+		if string(template.id.Type()) == allowed {
+			return true
+		}
+	}
+	return false
+}
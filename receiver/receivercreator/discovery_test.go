@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receivercreator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func portEndpointWithAnnotations(annotations map[string]string) observer.Endpoint {
+	p := pod
+	p.Annotations = annotations
+	return observer.Endpoint{
+		ID:     "port-1",
+		Target: "localhost:1234",
+		Details: &observer.Port{
+			Name:      "http",
+			Pod:       p,
+			Port:      1234,
+			Transport: observer.ProtocolTCP,
+		},
+	}
+}
+
+func TestDiscoveryReceiverTemplate(t *testing.T) {
+	tests := []struct {
+		name             string
+		endpoint         observer.Endpoint
+		allowedReceivers []string
+		expectedFound    bool
+		expectedID       component.ID
+		expectedConfig   userConfigMap
+		expectedError    string
+	}{
+		{
+			name:     "no annotations",
+			endpoint: portEndpointWithAnnotations(map[string]string{"scrape": "true"}),
+		},
+		{
+			name:     "unsupported endpoint type",
+			endpoint: podEndpoint,
+		},
+		{
+			name: "pod level annotations",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery/receiver": "redis",
+				"io.opentelemetry.discovery/config":   "collection_interval: 20s\npassword: secret",
+			}),
+			allowedReceivers: []string{"nginx", "redis"},
+			expectedFound:    true,
+			expectedID:       component.NewID("redis"),
+			expectedConfig:   userConfigMap{"collection_interval": "20s", "password": "secret"},
+		},
+		{
+			name: "port level annotations take precedence",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery/receiver":      "redis",
+				"io.opentelemetry.discovery/config":        "password: secret",
+				"io.opentelemetry.discovery.1234/receiver": "nginx/status",
+				"io.opentelemetry.discovery.1234/config":   "endpoint: '`endpoint`/status'",
+			}),
+			allowedReceivers: []string{"nginx", "redis"},
+			expectedFound:    true,
+			expectedID:       component.NewIDWithName("nginx", "status"),
+			expectedConfig:   userConfigMap{"endpoint": "`endpoint`/status"},
+		},
+		{
+			name: "annotations of another port are ignored",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery.6379/receiver": "redis",
+			}),
+		},
+		{
+			name: "receiver without config",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery/receiver": "redis",
+			}),
+			allowedReceivers: []string{"nginx", "redis"},
+			expectedFound:    true,
+			expectedID:       component.NewID("redis"),
+			expectedConfig:   userConfigMap{},
+		},
+		{
+			name: "allowed receiver",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery/receiver": "redis/1",
+			}),
+			allowedReceivers: []string{"nginx", "redis"},
+			expectedFound:    true,
+			expectedID:       component.NewIDWithName("redis", "1"),
+			expectedConfig:   userConfigMap{},
+		},
+		{
+			name: "receiver not allowed",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery/receiver": "filelog",
+			}),
+			allowedReceivers: []string{"nginx", "redis"},
+			expectedError:    `receiver "filelog" is not in the allowed receivers`,
+		},
+		{
+			name: "no allowed receivers",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery/receiver": "redis",
+			}),
+			expectedError: `receiver "redis" is not in the allowed receivers`,
+		},
+		{
+			name: "invalid config",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery/receiver": "redis",
+				"io.opentelemetry.discovery/config":   "[not a map",
+			}),
+			expectedError: `failed to parse annotation "io.opentelemetry.discovery/config"`,
+		},
+		{
+			name: "invalid receiver id",
+			endpoint: portEndpointWithAnnotations(map[string]string{
+				"io.opentelemetry.discovery/receiver": "redis/",
+			}),
+			expectedError: `invalid receiver "redis/" in annotation "io.opentelemetry.discovery/receiver"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DiscoveryConfig{
+				Enabled:          true,
+				AnnotationPrefix: defaultAnnotationPrefix,
+				AllowedReceivers: tt.allowedReceivers,
+			}
+			template, found, err := cfg.receiverTemplate(tt.endpoint)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFound, found)
+			if !tt.expectedFound {
+				return
+			}
+			assert.Equal(t, tt.expectedID, template.id)
+			assert.Equal(t, tt.expectedConfig, template.config)
+		})
+	}
+}
+
+func TestDiscoveryValidate(t *testing.T) {
+	cfg := DiscoveryConfig{AnnotationPrefix: defaultAnnotationPrefix}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Enabled = true
+	assert.EqualError(t, cfg.Validate(), "discovery allowed_receivers must be set when discovery is enabled")
+
+	cfg.AllowedReceivers = []string{"redis"}
+	assert.NoError(t, cfg.Validate())
+}
@@ -23,6 +23,7 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 // This file implements factory for receiver_creator. A receiver_creator can create other receivers at runtime.
@@ -37,7 +38,9 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, stability))
+		receiver.WithMetrics(createMetricsReceiver, stability),
+		receiver.WithLogs(createLogsReceiver, component.StabilityLevelDevelopment),
+		receiver.WithTraces(createTracesReceiver, component.StabilityLevelDevelopment))
 }
 
 func createDefaultConfig() component.Config {
@@ -61,16 +64,71 @@ func createDefaultConfig() component.Config {
 				conventions.AttributeK8SNodeName: "`name`",
 				conventions.AttributeK8SNodeUID:  "`uid`",
 			},
+			observer.ProcessType: map[string]string{
+				conventions.AttributeProcessPID:            "`pid`",
+				conventions.AttributeProcessExecutableName: "`process_name`",
+				conventions.AttributeProcessExecutablePath: "`executable`",
+				conventions.AttributeProcessCommandLine:    "`command`",
+				conventions.AttributeProcessOwner:          "`username`",
+				conventions.AttributeContainerID:           "`container_id`",
+			},
+		},
+		Discovery: DiscoveryConfig{
+			AnnotationPrefix: defaultAnnotationPrefix,
 		},
 		receiverTemplates: map[string]receiverTemplate{},
 	}
 }
 
+// The same receiver_creator instance is shared by all pipelines it is configured in,
+// so that endpoints are only watched once and every receiver started at runtime is
+// connected to the pipelines of the signals it supports.
+var receivers = sharedcomponent.NewSharedComponents()
+
+func getOrAddReceiverCreator(params receiver.CreateSettings, cfg component.Config) *sharedcomponent.SharedComponent {
+	return receivers.GetOrAdd(cfg, func() component.Component {
+		return newReceiverCreator(params, cfg.(*Config))
+	})
+}
+
 func createMetricsReceiver(
 	ctx context.Context,
 	params receiver.CreateSettings,
 	cfg component.Config,
 	consumer consumer.Metrics,
 ) (receiver.Metrics, error) {
-	return newReceiverCreator(params, cfg.(*Config), consumer)
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := getOrAddReceiverCreator(params, cfg)
+	r.Unwrap().(*receiverCreator).nextConsumers.metrics = consumer
+	return r, nil
+}
+
+func createLogsReceiver(
+	ctx context.Context,
+	params receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := getOrAddReceiverCreator(params, cfg)
+	r.Unwrap().(*receiverCreator).nextConsumers.logs = consumer
+	return r, nil
+}
+
+func createTracesReceiver(
+	ctx context.Context,
+	params receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Traces,
+) (receiver.Traces, error) {
+	if consumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	r := getOrAddReceiverCreator(params, cfg)
+	r.Unwrap().(*receiverCreator).nextConsumers.traces = consumer
+	return r, nil
 }
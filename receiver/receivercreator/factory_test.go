@@ -22,6 +22,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 func TestCreateReceiver(t *testing.T) {
@@ -37,13 +39,19 @@ func TestCreateReceiver(t *testing.T) {
 	assert.NoError(t, err, "receiver creation failed")
 	assert.NotNil(t, tReceiver, "receiver creation failed")
 
-	mReceiver, err := factory.CreateTracesReceiver(context.Background(), params, cfg, nil)
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, component.ErrDataTypeIsNotSupported)
-	assert.Nil(t, mReceiver)
+	tracesReceiver, err := factory.CreateTracesReceiver(context.Background(), params, cfg, consumertest.NewNop())
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, tracesReceiver, "receiver_creator instance should be shared between pipelines")
+
+	lReceiver, err := factory.CreateLogsReceiver(context.Background(), params, cfg, consumertest.NewNop())
+	assert.NoError(t, err, "receiver creation failed")
+	assert.Same(t, tReceiver, lReceiver, "receiver_creator instance should be shared between pipelines")
+
+	dyn := tReceiver.(*sharedcomponent.SharedComponent).Unwrap().(*receiverCreator)
+	assert.NotNil(t, dyn.nextConsumers.metrics)
+	assert.NotNil(t, dyn.nextConsumers.logs)
+	assert.NotNil(t, dyn.nextConsumers.traces)
 
-	lReceiver, err := factory.CreateLogsReceiver(context.Background(), params, cfg, nil)
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, component.ErrDataTypeIsNotSupported)
-	assert.Nil(t, lReceiver)
+	_, err = factory.CreateLogsReceiver(context.Background(), params, cfg, nil)
+	assert.ErrorIs(t, err, component.ErrNilNextConsumer)
 }
@@ -59,6 +59,18 @@ var hostportEndpoint = observer.Endpoint{
 	},
 }
 
+var processEndpoint = observer.Endpoint{
+	ID: "process-1",
+	Details: &observer.Process{
+		PID:         1234,
+		ProcessName: "splunkd",
+		Executable:  "/opt/splunk/bin/splunkd",
+		Command:     "splunkd -p 8089 start",
+		Username:    "splunk",
+		Cgroup:      "/system.slice/splunk.service",
+	},
+}
+
 var container = observer.Container{
 	Name:          "otel-agent",
 	Image:         "otelcol",
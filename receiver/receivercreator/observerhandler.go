@@ -18,7 +18,6 @@ import (
 	"fmt"
 	"sync"
 
-	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -44,8 +43,8 @@ type observerHandler struct {
 	params receiver.CreateSettings
 	// receiversByEndpointID is a map of endpoint IDs to a receiver instance.
 	receiversByEndpointID receiverMap
-	// nextConsumer is the receiver_creator's own consumer
-	nextConsumer consumer.Metrics
+	// nextConsumers are the receiver_creator's own consumers
+	nextConsumers consumers
 	// runner starts and stops receiver instances.
 	runner runner
 }
@@ -92,82 +91,99 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 
 		for _, template := range obs.config.receiverTemplates {
 			if matches, err := template.rule.eval(env); err != nil {
-				obs.params.TelemetrySettings.Logger.Error("failed matching rule", zap.String("rule", template.Rule), zap.Error(err))
+				obs.params.TelemetrySettings.Logger.Error("failed matching rule", zap.String("rule", template.Rule), zap.String("ottl_rule", template.OTTLRule), zap.Error(err))
 				continue
 			} else if !matches {
 				continue
 			}
+			obs.startReceiver(template, env, e)
+		}
 
-			obs.params.TelemetrySettings.Logger.Info("starting receiver",
-				zap.String("name", template.id.String()),
-				zap.String("endpoint", e.Target),
-				zap.String("endpoint_id", string(e.ID)))
-
-			resolvedConfig, err := expandConfig(template.config, env)
+		if obs.config.Discovery.Enabled {
+			template, found, err := obs.config.Discovery.receiverTemplate(e)
 			if err != nil {
-				obs.params.TelemetrySettings.Logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
+				obs.params.TelemetrySettings.Logger.Error("invalid discovery annotations", zap.String("endpoint_id", string(e.ID)), zap.Error(err))
 				continue
 			}
-
-			discoveredCfg := userConfigMap{}
-			// If user didn't set endpoint set to default value as well as
-			// flag indicating we've done this for later validation.
-			if _, ok := resolvedConfig[endpointConfigKey]; !ok {
-				discoveredCfg[endpointConfigKey] = e.Target
-				discoveredCfg[tmpSetEndpointConfigKey] = struct{}{}
-			}
-
-			// Though not necessary with contrib provided observers, nothing is stopping custom
-			// ones from using expr in their Target values.
-			discoveredConfig, err := expandConfig(discoveredCfg, env)
-			if err != nil {
-				obs.params.TelemetrySettings.Logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
+			if found {
+				obs.startReceiver(template, env, e)
 			}
+		}
+	}
+}
 
-			resAttrs := map[string]string{}
-			for k, v := range template.ResourceAttributes {
-				strVal, ok := v.(string)
-				if !ok {
-					obs.params.TelemetrySettings.Logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
-					continue
-				}
-				resAttrs[k] = strVal
-			}
+// startReceiver starts a receiver from the given template for the endpoint and
+// tracks it so that it is stopped with the endpoint.
+func (obs *observerHandler) startReceiver(template receiverTemplate, env observer.EndpointEnv, e observer.Endpoint) {
+	obs.params.TelemetrySettings.Logger.Info("starting receiver",
+		zap.String("name", template.id.String()),
+		zap.String("endpoint", e.Target),
+		zap.String("endpoint_id", string(e.ID)))
+
+	resolvedConfig, err := expandConfig(template.config, env)
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("unable to resolve template config", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
 
-			// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
-			// as telemetry is emitted.
-			resourceEnhancer, err := newResourceEnhancer(
-				obs.config.ResourceAttributes,
-				resAttrs,
-				env,
-				e,
-				obs.nextConsumer,
-			)
+	discoveredCfg := userConfigMap{}
+	// If user didn't set endpoint set to default value as well as
+	// flag indicating we've done this for later validation. Endpoints
+	// without a target, such as processes, leave the receiver default.
+	if _, ok := resolvedConfig[endpointConfigKey]; !ok && e.Target != "" {
+		discoveredCfg[endpointConfigKey] = e.Target
+		discoveredCfg[tmpSetEndpointConfigKey] = struct{}{}
+	}
 
-			if err != nil {
-				obs.params.TelemetrySettings.Logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	// Though not necessary with contrib provided observers, nothing is stopping custom
+	// ones from using expr in their Target values.
+	discoveredConfig, err := expandConfig(discoveredCfg, env)
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("unable to resolve discovered config", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
 
-			rcvr, err := obs.runner.start(
-				receiverConfig{
-					id:         template.id,
-					config:     resolvedConfig,
-					endpointID: e.ID,
-				},
-				discoveredConfig,
-				resourceEnhancer,
-			)
+	resAttrs := map[string]string{}
+	for k, v := range template.ResourceAttributes {
+		strVal, ok := v.(string)
+		if !ok {
+			obs.params.TelemetrySettings.Logger.Info(fmt.Sprintf("ignoring unsupported `resource_attributes` %q value %v", k, v))
+			continue
+		}
+		resAttrs[k] = strVal
+	}
 
-			if err != nil {
-				obs.params.TelemetrySettings.Logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
-				continue
-			}
+	// Adds default and/or configured resource attributes (e.g. k8s.pod.uid) to resources
+	// as telemetry is emitted.
+	resourceEnhancer, err := newResourceEnhancer(
+		obs.config.ResourceAttributes,
+		resAttrs,
+		env,
+		e,
+		obs.nextConsumers,
+	)
+
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("failed creating resource enhancer", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
+	}
 
-			obs.receiversByEndpointID.Put(e.ID, rcvr)
-		}
+	rcvr, err := obs.runner.start(
+		receiverConfig{
+			id:         template.id,
+			config:     resolvedConfig,
+			endpointID: e.ID,
+		},
+		discoveredConfig,
+		resourceEnhancer,
+	)
+
+	if err != nil {
+		obs.params.TelemetrySettings.Logger.Error("failed to start receiver", zap.String("receiver", template.id.String()), zap.Error(err))
+		return
 	}
+
+	obs.receiversByEndpointID.Put(e.ID, rcvr)
 }
 
 // OnRemove responds to endpoint removal notifications.
@@ -20,7 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
-	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/otelcol"
 	"go.opentelemetry.io/collector/otelcol/otelcoltest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
@@ -124,6 +124,95 @@ func TestOnAdd(t *testing.T) {
 	}
 }
 
+func TestOnAddUnsupportedResourceAttribute(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	rcvrCfg := receiverConfig{
+		id:         component.NewIDWithName("with.endpoint", "some.name"),
+		config:     userConfigMap{"int_field": 12345678},
+		endpointID: portEndpoint.ID,
+	}
+	cfg.receiverTemplates = map[string]receiverTemplate{
+		rcvrCfg.id.String(): {
+			receiverConfig: rcvrCfg,
+			rule:           portRule,
+			Rule:           `type == "port"`,
+			ResourceAttributes: map[string]interface{}{
+				"supported":   "value",
+				"unsupported": 1234,
+			},
+		},
+	}
+
+	handler, mr := newObserverHandler(t, cfg)
+	handler.OnAdd([]observer.Endpoint{portEndpoint})
+
+	// Only the unsupported attribute is ignored, the receiver is still started.
+	require.NoError(t, mr.lastError)
+	require.IsType(t, &nopWithEndpointReceiver{}, mr.startedComponent)
+	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+}
+
+func TestOnAddDiscoveryAnnotations(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Discovery.Enabled = true
+	cfg.Discovery.AllowedReceivers = []string{"with.endpoint"}
+
+	handler, mr := newObserverHandler(t, cfg)
+	handler.OnAdd([]observer.Endpoint{
+		portEndpointWithAnnotations(map[string]string{
+			"io.opentelemetry.discovery.1234/receiver": "with.endpoint/annotated",
+			"io.opentelemetry.discovery.1234/config":   "int_field: 42",
+		}),
+	})
+
+	require.NoError(t, mr.lastError)
+	require.IsType(t, &nopWithEndpointReceiver{}, mr.startedComponent)
+	assert.Equal(t, &nopWithEndpointConfig{
+		IntField: 42,
+		Endpoint: "localhost:1234",
+	}, mr.startedComponent.(*nopWithEndpointReceiver).cfg)
+	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+
+	// Receivers that are not allowed are not started.
+	handler, mr = newObserverHandler(t, cfg)
+	handler.OnAdd([]observer.Endpoint{
+		portEndpointWithAnnotations(map[string]string{
+			"io.opentelemetry.discovery/receiver": "without.endpoint",
+		}),
+	})
+	assert.Nil(t, mr.startedComponent)
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+}
+
+func TestOnAddProcessEndpoint(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	rcvrCfg := receiverConfig{
+		id:         component.NewIDWithName("with.endpoint", "some.name"),
+		config:     userConfigMap{"int_field": 12345678},
+		endpointID: processEndpoint.ID,
+	}
+	processRule, err := newRule(`type == "process" && process_name == "splunkd"`)
+	require.NoError(t, err)
+	cfg.receiverTemplates = map[string]receiverTemplate{
+		rcvrCfg.id.String(): {
+			receiverConfig:     rcvrCfg,
+			rule:               processRule,
+			Rule:               `type == "process" && process_name == "splunkd"`,
+			ResourceAttributes: map[string]interface{}{},
+		},
+	}
+
+	handler, mr := newObserverHandler(t, cfg)
+	handler.OnAdd([]observer.Endpoint{processEndpoint})
+
+	assert.Equal(t, 1, handler.receiversByEndpointID.Size())
+	require.NoError(t, mr.lastError)
+	rcvr, ok := mr.startedComponent.(*nopWithEndpointReceiver)
+	require.True(t, ok)
+	// Process endpoints have no target so the receiver default is kept.
+	require.Equal(t, &nopWithEndpointConfig{IntField: 12345678}, rcvr.cfg)
+}
+
 func TestOnRemove(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	rcvrCfg := receiverConfig{
@@ -198,7 +287,7 @@ type mockRunner struct {
 func (r *mockRunner) start(
 	receiver receiverConfig,
 	discoveredConfig userConfigMap,
-	nextConsumer consumer.Metrics,
+	nextConsumer *resourceEnhancer,
 ) (component.Component, error) {
 	r.startedComponent, r.lastError = r.receiverRunner.start(receiver, discoveredConfig, nextConsumer)
 	return r.startedComponent, r.lastError
@@ -262,6 +351,7 @@ func newObserverHandler(t *testing.T, config *Config) (*observerHandler, *mockRu
 		params:                set,
 		config:                config,
 		receiversByEndpointID: receiverMap{},
+		nextConsumers:         consumers{metrics: consumertest.NewNop()},
 		runner:                mr,
 	}, mr
 }
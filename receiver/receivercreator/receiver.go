@@ -26,29 +26,35 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 )
 
-var _ receiver.Metrics = (*receiverCreator)(nil)
+var (
+	_ receiver.Metrics = (*receiverCreator)(nil)
+	_ receiver.Logs    = (*receiverCreator)(nil)
+	_ receiver.Traces  = (*receiverCreator)(nil)
+)
 
-// receiverCreator implements consumer.Metrics.
+// receiverCreator implements receiver.Metrics, receiver.Logs and receiver.Traces.
 type receiverCreator struct {
 	params          receiver.CreateSettings
 	cfg             *Config
-	nextConsumer    consumer.Metrics
+	nextConsumers   consumers
 	observerHandler *observerHandler
 	observables     []observer.Observable
 }
 
-// newReceiverCreator creates the receiver_creator with the given parameters.
-func newReceiverCreator(params receiver.CreateSettings, cfg *Config, nextConsumer consumer.Metrics) (receiver.Metrics, error) {
-	if nextConsumer == nil {
-		return nil, component.ErrNilNextConsumer
-	}
+// consumers holds the next consumer of every pipeline type the receiver_creator is part of.
+// Receivers started at runtime are created for each pipeline type with a consumer set.
+type consumers struct {
+	metrics consumer.Metrics
+	logs    consumer.Logs
+	traces  consumer.Traces
+}
 
-	r := &receiverCreator{
-		params:       params,
-		cfg:          cfg,
-		nextConsumer: nextConsumer,
+// newReceiverCreator creates the receiver_creator with the given parameters.
+func newReceiverCreator(params receiver.CreateSettings, cfg *Config) *receiverCreator {
+	return &receiverCreator{
+		params: params,
+		cfg:    cfg,
 	}
-	return r, nil
 }
 
 // loggingHost provides a safer version of host that logs errors instead of exiting the process.
@@ -70,7 +76,7 @@ func (rc *receiverCreator) Start(_ context.Context, host component.Host) error {
 		config:                rc.cfg,
 		params:                rc.params,
 		receiversByEndpointID: receiverMap{},
-		nextConsumer:          rc.nextConsumer,
+		nextConsumers:         rc.nextConsumers,
 		runner: &receiverRunner{
 			params:      rc.params,
 			idNamespace: rc.params.ID,
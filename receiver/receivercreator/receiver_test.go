@@ -38,6 +38,7 @@ import (
 	zapObserver "go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 )
 
@@ -94,7 +95,7 @@ func TestMockedEndToEnd(t *testing.T) {
 
 	rcvr, err := factory.CreateMetricsReceiver(context.Background(), params, cfg, mockConsumer)
 	require.NoError(t, err)
-	dyn := rcvr.(*receiverCreator)
+	dyn := rcvr.(*sharedcomponent.SharedComponent).Unwrap().(*receiverCreator)
 	require.NoError(t, rcvr.Start(context.Background(), host))
 
 	var shutdownOnce sync.Once
@@ -19,19 +19,26 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 )
 
-var _ consumer.Metrics = (*resourceEnhancer)(nil)
+var (
+	_ consumer.Metrics = (*resourceEnhancer)(nil)
+	_ consumer.Logs    = (*resourceEnhancer)(nil)
+	_ consumer.Traces  = (*resourceEnhancer)(nil)
+)
 
 // resourceEnhancer adds additional resource attribute entries
 // from the given endpoint environment. The added attributes vary based on the type
 // of the endpoint.
 type resourceEnhancer struct {
-	nextConsumer consumer.Metrics
-	attrs        map[string]string
+	nextConsumers consumers
+	attrs         map[string]string
 }
 
 func newResourceEnhancer(
@@ -39,7 +46,7 @@ func newResourceEnhancer(
 	receiverAttributes map[string]string,
 	env observer.EndpointEnv,
 	endpoint observer.Endpoint,
-	nextConsumer consumer.Metrics,
+	nextConsumers consumers,
 ) (*resourceEnhancer, error) {
 	attrs := map[string]string{}
 
@@ -65,8 +72,8 @@ func newResourceEnhancer(
 	}
 
 	return &resourceEnhancer{
-		nextConsumer: nextConsumer,
-		attrs:        attrs,
+		nextConsumers: nextConsumers,
+		attrs:         attrs,
 	}, nil
 }
 
@@ -77,15 +84,35 @@ func (r *resourceEnhancer) Capabilities() consumer.Capabilities {
 func (r *resourceEnhancer) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	rm := md.ResourceMetrics()
 	for i := 0; i < rm.Len(); i++ {
-		rms := rm.At(i)
-		attrs := rms.Resource().Attributes()
+		r.enhance(rm.At(i).Resource())
+	}
 
-		for attr, val := range r.attrs {
-			if _, found := attrs.Get(attr); !found {
-				attrs.PutStr(attr, val)
-			}
-		}
+	return r.nextConsumers.metrics.ConsumeMetrics(ctx, md)
+}
+
+func (r *resourceEnhancer) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	rl := ld.ResourceLogs()
+	for i := 0; i < rl.Len(); i++ {
+		r.enhance(rl.At(i).Resource())
+	}
+
+	return r.nextConsumers.logs.ConsumeLogs(ctx, ld)
+}
+
+func (r *resourceEnhancer) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	rs := td.ResourceSpans()
+	for i := 0; i < rs.Len(); i++ {
+		r.enhance(rs.At(i).Resource())
 	}
 
-	return r.nextConsumer.ConsumeMetrics(ctx, md)
+	return r.nextConsumers.traces.ConsumeTraces(ctx, td)
+}
+
+func (r *resourceEnhancer) enhance(resource pcommon.Resource) {
+	attrs := resource.Attributes()
+	for attr, val := range r.attrs {
+		if _, found := attrs.Get(attr); !found {
+			attrs.PutStr(attr, val)
+		}
+	}
 }
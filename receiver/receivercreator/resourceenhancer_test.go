@@ -20,7 +20,6 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
@@ -43,13 +42,18 @@ func Test_newResourceEnhancer(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	procEnv, err := processEndpoint.Env()
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	cfg := createDefaultConfig().(*Config)
 	type args struct {
 		resources          resourceAttributes
 		resourceAttributes map[string]string
 		env                observer.EndpointEnv
 		endpoint           observer.Endpoint
-		nextConsumer       consumer.Metrics
+		nextConsumers      consumers
 	}
 	tests := []struct {
 		name    string
@@ -60,13 +64,13 @@ func Test_newResourceEnhancer(t *testing.T) {
 		{
 			name: "pod endpoint",
 			args: args{
-				resources:    cfg.ResourceAttributes,
-				env:          podEnv,
-				endpoint:     podEndpoint,
-				nextConsumer: &consumertest.MetricsSink{},
+				resources:     cfg.ResourceAttributes,
+				env:           podEnv,
+				endpoint:      podEndpoint,
+				nextConsumers: consumers{metrics: &consumertest.MetricsSink{}},
 			},
 			want: &resourceEnhancer{
-				nextConsumer: &consumertest.MetricsSink{},
+				nextConsumers: consumers{metrics: &consumertest.MetricsSink{}},
 				attrs: map[string]string{
 					"k8s.pod.uid":        "uid-1",
 					"k8s.pod.name":       "pod-1",
@@ -78,13 +82,13 @@ func Test_newResourceEnhancer(t *testing.T) {
 		{
 			name: "port endpoint",
 			args: args{
-				resources:    cfg.ResourceAttributes,
-				env:          portEnv,
-				endpoint:     portEndpoint,
-				nextConsumer: &consumertest.MetricsSink{},
+				resources:     cfg.ResourceAttributes,
+				env:           portEnv,
+				endpoint:      portEndpoint,
+				nextConsumers: consumers{metrics: &consumertest.MetricsSink{}},
 			},
 			want: &resourceEnhancer{
-				nextConsumer: &consumertest.MetricsSink{},
+				nextConsumers: consumers{metrics: &consumertest.MetricsSink{}},
 				attrs: map[string]string{
 					"k8s.pod.uid":        "uid-1",
 					"k8s.pod.name":       "pod-1",
@@ -96,13 +100,13 @@ func Test_newResourceEnhancer(t *testing.T) {
 		{
 			name: "container endpoint",
 			args: args{
-				resources:    cfg.ResourceAttributes,
-				env:          cntrEnv,
-				endpoint:     containerEndpoint,
-				nextConsumer: &consumertest.MetricsSink{},
+				resources:     cfg.ResourceAttributes,
+				env:           cntrEnv,
+				endpoint:      containerEndpoint,
+				nextConsumers: consumers{metrics: &consumertest.MetricsSink{}},
 			},
 			want: &resourceEnhancer{
-				nextConsumer: &consumertest.MetricsSink{},
+				nextConsumers: consumers{metrics: &consumertest.MetricsSink{}},
 				attrs: map[string]string{
 					"container.name":       "otel-agent",
 					"container.image.name": "otelcol",
@@ -110,6 +114,26 @@ func Test_newResourceEnhancer(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "process endpoint",
+			args: args{
+				resources:     cfg.ResourceAttributes,
+				env:           procEnv,
+				endpoint:      processEndpoint,
+				nextConsumers: consumers{metrics: &consumertest.MetricsSink{}},
+			},
+			want: &resourceEnhancer{
+				nextConsumers: consumers{metrics: &consumertest.MetricsSink{}},
+				attrs: map[string]string{
+					"process.pid":             "1234",
+					"process.executable.name": "splunkd",
+					"process.executable.path": "/opt/splunk/bin/splunkd",
+					"process.command_line":    "splunkd -p 8089 start",
+					"process.owner":           "splunk",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			// If the configured attribute value is empty it should not touch that
 			// attribute.
@@ -120,12 +144,12 @@ func Test_newResourceEnhancer(t *testing.T) {
 					res[observer.PodType]["k8s.pod.name"] = ""
 					return res
 				}(),
-				env:          podEnv,
-				endpoint:     podEndpoint,
-				nextConsumer: nil,
+				env:           podEnv,
+				endpoint:      podEndpoint,
+				nextConsumers: consumers{},
 			},
 			want: &resourceEnhancer{
-				nextConsumer: nil,
+				nextConsumers: consumers{},
 				attrs: map[string]string{
 					"k8s.pod.uid":        "uid-1",
 					"k8s.namespace.name": "default",
@@ -150,12 +174,12 @@ func Test_newResourceEnhancer(t *testing.T) {
 					"duplicate.resource.attribute": "receiver.value",
 					"delete.me":                    "",
 				},
-				env:          podEnv,
-				endpoint:     podEndpoint,
-				nextConsumer: nil,
+				env:           podEnv,
+				endpoint:      podEndpoint,
+				nextConsumers: consumers{},
 			},
 			want: &resourceEnhancer{
-				nextConsumer: nil,
+				nextConsumers: consumers{},
 				attrs: map[string]string{
 					"k8s.namespace.name":           "default",
 					"k8s.pod.name":                 "pod-1",
@@ -174,9 +198,9 @@ func Test_newResourceEnhancer(t *testing.T) {
 					res[observer.PodType]["k8s.pod.name"] = "`unbalanced"
 					return res
 				}(),
-				env:          podEnv,
-				endpoint:     podEndpoint,
-				nextConsumer: nil,
+				env:           podEnv,
+				endpoint:      podEndpoint,
+				nextConsumers: consumers{},
 			},
 			want:    nil,
 			wantErr: true,
@@ -184,7 +208,7 @@ func Test_newResourceEnhancer(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := newResourceEnhancer(tt.args.resources, tt.args.resourceAttributes, tt.args.env, tt.args.endpoint, tt.args.nextConsumer)
+			got, err := newResourceEnhancer(tt.args.resources, tt.args.resourceAttributes, tt.args.env, tt.args.endpoint, tt.args.nextConsumers)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -241,8 +265,8 @@ func Test_resourceEnhancer_ConsumeMetrics(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &resourceEnhancer{
-				nextConsumer: tt.fields.nextConsumer,
-				attrs:        tt.fields.attrs,
+				nextConsumers: consumers{metrics: tt.fields.nextConsumer},
+				attrs:         tt.fields.attrs,
 			}
 			if err := r.ConsumeMetrics(tt.args.ctx, tt.args.md); (err != nil) != tt.wantErr {
 				t.Errorf("ConsumeMetrics() error = %v, wantErr %v", err, tt.wantErr)
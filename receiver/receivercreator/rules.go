@@ -15,24 +15,31 @@
 package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 
 	"github.com/antonmedv/expr"
 	"github.com/antonmedv/expr/vm"
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
-// rule wraps expr rule for later evaluation.
+// rule wraps expr rule or OTTL condition for later evaluation.
 type rule struct {
-	program *vm.Program
+	program   *vm.Program
+	condition *ottl.BoolExpr[observer.EndpointEnv]
 }
 
 // ruleRe is used to verify the rule starts type check.
 var ruleRe = regexp.MustCompile(
-	fmt.Sprintf(`^type\s*==\s*(%q|%q|%q|%q|%q)`, observer.PodType, observer.PortType, observer.HostPortType, observer.ContainerType, observer.K8sNodeType),
+	fmt.Sprintf(`^type\s*==\s*(%q|%q|%q|%q|%q|%q)`, observer.PodType, observer.PortType, observer.HostPortType, observer.ContainerType, observer.K8sNodeType, observer.ProcessType),
 )
 
 // newRule creates a new rule instance.
@@ -51,11 +58,39 @@ func newRule(ruleStr string) (rule, error) {
 	if err != nil {
 		return rule{}, err
 	}
-	return rule{v}, nil
+	return rule{program: v}, nil
+}
+
+// newOTTLRule creates a new rule instance from an OTTL condition. Paths of the condition
+// refer to the endpoint variables, e.g. `pod.labels["app"]`.
+func newOTTLRule(ruleStr string) (rule, error) {
+	if ruleStr == "" {
+		return rule{}, errors.New("rule cannot be empty")
+	}
+	if !ruleRe.MatchString(ruleStr) {
+		return rule{}, errors.New("rule must specify type")
+	}
+
+	parser, err := ottl.NewParser[observer.EndpointEnv](
+		ottlFunctions(),
+		parseEndpointPath,
+		component.TelemetrySettings{Logger: zap.NewNop()},
+	)
+	if err != nil {
+		return rule{}, err
+	}
+	condition, err := parser.ParseConditions([]string{ruleStr})
+	if err != nil {
+		return rule{}, err
+	}
+	return rule{condition: &condition}, nil
 }
 
 // eval the rule against the given endpoint.
 func (r *rule) eval(env observer.EndpointEnv) (bool, error) {
+	if r.condition != nil {
+		return r.condition.Eval(context.Background(), env)
+	}
 	res, err := expr.Run(r.program, env)
 	if err != nil {
 		return false, err
@@ -65,3 +100,74 @@ func (r *rule) eval(env observer.EndpointEnv) (bool, error) {
 	}
 	return false, errors.New("rule did not return a boolean")
 }
+
+func ottlFunctions() map[string]interface{} {
+	return map[string]interface{}{
+		"IsMatch":     ottlfuncs.IsMatch[observer.EndpointEnv],
+		"Concat":      ottlfuncs.Concat[observer.EndpointEnv],
+		"Split":       ottlfuncs.Split[observer.EndpointEnv],
+		"Int":         ottlfuncs.Int[observer.EndpointEnv],
+		"Substring":   ottlfuncs.Substring[observer.EndpointEnv],
+		"ConvertCase": ottlfuncs.ConvertCase[observer.EndpointEnv],
+	}
+}
+
+// parseEndpointPath returns a read-only accessor of the endpoint variable referred to by
+// the path. Variables that don't exist for the endpoint are nil.
+func parseEndpointPath(path *ottl.Path) (ottl.GetSetter[observer.EndpointEnv], error) {
+	if path == nil || len(path.Fields) == 0 {
+		return nil, errors.New("path cannot be empty")
+	}
+	fields := path.Fields
+	return ottl.StandardGetSetter[observer.EndpointEnv]{
+		Getter: func(_ context.Context, env observer.EndpointEnv) (interface{}, error) {
+			value := reflect.ValueOf(env)
+			for _, field := range fields {
+				value = mapIndex(value, field.Name)
+				if field.MapKey != nil {
+					value = mapIndex(value, *field.MapKey)
+				}
+			}
+			return ottlValue(value), nil
+		},
+		Setter: func(context.Context, observer.EndpointEnv, interface{}) error {
+			return errors.New("endpoint variables cannot be set")
+		},
+	}, nil
+}
+
+// mapIndex returns the value of the key if value is a map with string keys, or the zero
+// reflect.Value otherwise.
+func mapIndex(value reflect.Value, key string) reflect.Value {
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+		return reflect.Value{}
+	}
+	return value.MapIndex(reflect.ValueOf(key).Convert(value.Type().Key()))
+}
+
+// ottlValue converts an endpoint variable to the types OTTL compares: string, int64,
+// float64 and bool. Other values, such as maps, are returned as is.
+func ottlValue(value reflect.Value) interface{} {
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.String:
+		return value.String()
+	case reflect.Bool:
+		return value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	default:
+		return value.Interface()
+	}
+}
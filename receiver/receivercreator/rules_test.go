@@ -42,6 +42,8 @@ func Test_ruleEval(t *testing.T) {
 		{"annotations", args{`type == "pod" && annotations["scrape"] == "true"`, podEndpoint}, true, false},
 		{"basic container", args{`type == "container" && labels["region"] == "east-1"`, containerEndpoint}, true, false},
 		{"basic k8s.node", args{`type == "k8s.node" && kubelet_endpoint_port == 10250`, k8sNodeEndpoint}, true, false},
+		{"basic process", args{`type == "process" && username == "splunk" && executable matches "/splunkd$"`, processEndpoint}, true, false},
+		{"hostport process details", args{`type == "hostport" && pid == 0 && container_id == ""`, hostportEndpoint}, true, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -80,6 +82,7 @@ func Test_newRule(t *testing.T) {
 		{"valid pod", args{`type=="pod" && port_name == "http"`}, false},
 		{"valid hostport", args{`type == "hostport" && port_name == "http"`}, false},
 		{"valid container", args{`type == "container" && port == 8080`}, false},
+		{"valid process", args{`type == "process" && process_name == "splunkd"`}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -94,3 +97,67 @@ func Test_newRule(t *testing.T) {
 		})
 	}
 }
+
+func Test_ottlRuleEval(t *testing.T) {
+	type args struct {
+		ruleStr  string
+		endpoint observer.Endpoint
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"basic port", args{`type == "port" and name == "http" and pod.labels["app"] == "redis"`, portEndpoint}, true},
+		{"port transport", args{`type == "port" and transport == "TCP" and port == 1234`, portEndpoint}, true},
+		{"basic hostport", args{`type == "hostport" and port == 1234 and process_name == "splunk"`, hostportEndpoint}, true},
+		{"basic pod", args{`type == "pod" and labels["region"] == "west-1"`, podEndpoint}, true},
+		{"annotations", args{`type == "pod" and annotations["scrape"] == "true"`, podEndpoint}, true},
+		{"basic container", args{`type == "container" and labels["region"] == "east-1"`, containerEndpoint}, true},
+		{"basic k8s.node", args{`type == "k8s.node" and kubelet_endpoint_port == 10250`, k8sNodeEndpoint}, true},
+		{"basic process", args{`type == "process" and username == "splunk" and IsMatch(executable, "/splunkd$") == true`, processEndpoint}, true},
+		{"hostport process details", args{`type == "hostport" and pid == 0 and container_id == ""`, hostportEndpoint}, true},
+		{"missing label", args{`type == "pod" and labels["missing"] == nil`, podEndpoint}, true},
+		{"unknown variable", args{`type == "port" and unknown_var == 1`, portEndpoint}, false},
+		{"other type", args{`type == "pod"`, portEndpoint}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newOTTLRule(tt.args.ruleStr)
+			require.NoError(t, err)
+
+			env, err := tt.args.endpoint.Env()
+			require.NoError(t, err)
+
+			match, err := got.eval(env)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, match)
+		})
+	}
+}
+
+func Test_newOTTLRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		ruleStr string
+		wantErr bool
+	}{
+		{"empty rule", "", true},
+		{"does not start with type", "port == 1234", true},
+		{"invalid syntax", `type == "port" and port ==`, true},
+		{"unknown function", `type == "port" and Unknown(name) == true`, true},
+		{"valid port", `type == "port" and name == "http"`, false},
+		{"valid pod", `type=="pod" and labels["app"] == "redis"`, false},
+		{"valid process", `type == "process" and process_name == "splunkd"`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newOTTLRule(tt.ruleStr)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -16,20 +16,21 @@ package receivercreator // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cast"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
-	"go.opentelemetry.io/collector/consumer"
 	rcvr "go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
 // runner starts and stops receiver instances.
 type runner interface {
 	// start a receiver instance from its static config and discovered config.
-	start(receiver receiverConfig, discoveredConfig userConfigMap, nextConsumer consumer.Metrics) (component.Component, error)
+	start(receiver receiverConfig, discoveredConfig userConfigMap, nextConsumer *resourceEnhancer) (component.Component, error)
 	// shutdown a receiver.
 	shutdown(rcvr component.Component) error
 }
@@ -47,7 +48,7 @@ var _ runner = (*receiverRunner)(nil)
 func (run *receiverRunner) start(
 	receiver receiverConfig,
 	discoveredConfig userConfigMap,
-	nextConsumer consumer.Metrics,
+	nextConsumer *resourceEnhancer,
 ) (component.Component, error) {
 	factory := run.host.GetFactory(component.KindReceiver, receiver.id.Type())
 
@@ -99,6 +100,9 @@ func (run *receiverRunner) loadRuntimeReceiverConfig(
 	if err := component.UnmarshalConfig(mergedConfig, receiverCfg); err != nil {
 		return nil, "", fmt.Errorf("failed to load %q template config: %w", receiver.id.String(), err)
 	}
+	if err := component.ValidateConfig(receiverCfg); err != nil {
+		return nil, "", fmt.Errorf("invalid %q template config: %w", receiver.id.String(), err)
+	}
 	return receiverCfg, targetEndpoint, nil
 }
 
@@ -133,15 +137,73 @@ func mergeTemplatedAndDiscoveredConfigs(factory rcvr.Factory, templated, discove
 	return templatedConfig, targetEndpoint, nil
 }
 
-// createRuntimeReceiver creates a receiver that is discovered at runtime.
+// createRuntimeReceiver creates a receiver that is discovered at runtime for each pipeline type
+// that both the receiver and the receiver_creator support.
 func (run *receiverRunner) createRuntimeReceiver(
 	factory rcvr.Factory,
 	id component.ID,
 	cfg component.Config,
-	nextConsumer consumer.Metrics,
-) (rcvr.Metrics, error) {
+	nextConsumer *resourceEnhancer,
+) (component.Component, error) {
 	runParams := run.params
 	runParams.Logger = runParams.Logger.With(zap.String("name", id.String()))
 	runParams.ID = id
-	return factory.CreateMetricsReceiver(context.Background(), runParams, cfg, nextConsumer)
+
+	var created []component.Component
+	add := func(r component.Component, err error) error {
+		if errors.Is(err, component.ErrDataTypeIsNotSupported) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		created = append(created, r)
+		return nil
+	}
+
+	if nextConsumer.nextConsumers.metrics != nil {
+		if err := add(factory.CreateMetricsReceiver(context.Background(), runParams, cfg, nextConsumer)); err != nil {
+			return nil, err
+		}
+	}
+	if nextConsumer.nextConsumers.logs != nil {
+		if err := add(factory.CreateLogsReceiver(context.Background(), runParams, cfg, nextConsumer)); err != nil {
+			return nil, err
+		}
+	}
+	if nextConsumer.nextConsumers.traces != nil {
+		if err := add(factory.CreateTracesReceiver(context.Background(), runParams, cfg, nextConsumer)); err != nil {
+			return nil, err
+		}
+	}
+
+	switch len(created) {
+	case 0:
+		return nil, fmt.Errorf("receiver %q does not support any of the pipeline types the receiver_creator is used in", id.String())
+	case 1:
+		return created[0], nil
+	}
+	return multiReceiver(created), nil
+}
+
+// multiReceiver groups the receivers created for each pipeline type from the same
+// discovered endpoint, so that they are started and stopped together.
+type multiReceiver []component.Component
+
+func (m multiReceiver) Start(ctx context.Context, host component.Host) error {
+	for i, r := range m {
+		if err := r.Start(ctx, host); err != nil {
+			// Do not leave the receivers that already started running.
+			return multierr.Append(err, m[:i].Shutdown(ctx))
+		}
+	}
+	return nil
+}
+
+func (m multiReceiver) Shutdown(ctx context.Context) error {
+	var errs error
+	for _, r := range m {
+		errs = multierr.Append(errs, r.Shutdown(ctx))
+	}
+	return errs
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
@@ -54,7 +55,7 @@ func Test_loadAndCreateRuntimeReceiver(t *testing.T) {
 			exampleFactory,
 			component.NewIDWithName("nop", "1/receiver_creator/1{endpoint=\"localhost:12345\"}/endpoint.id"),
 			loadedConfig,
-			nil)
+			&resourceEnhancer{nextConsumers: consumers{metrics: consumertest.NewNop()}})
 		require.NoError(t, err)
 		assert.NotNil(t, recvr)
 		assert.IsType(t, &nopWithEndpointReceiver{}, recvr)
@@ -70,6 +71,33 @@ func Test_loadAndCreateRuntimeReceiver(t *testing.T) {
 			return found
 		}())
 	})
+
+	t.Run("test create receiver for every pipeline type", func(t *testing.T) {
+		recvr, err := run.createRuntimeReceiver(
+			&nopWithEndpointFactory{Factory: receivertest.NewNopFactory()},
+			component.NewIDWithName("nop", "1/receiver_creator/1{endpoint=\"localhost:12345\"}/endpoint.id"),
+			loadedConfig,
+			&resourceEnhancer{nextConsumers: consumers{
+				metrics: consumertest.NewNop(),
+				logs:    consumertest.NewNop(),
+				traces:  consumertest.NewNop(),
+			}})
+		require.NoError(t, err)
+		require.IsType(t, multiReceiver{}, recvr)
+		assert.Len(t, recvr.(multiReceiver), 3)
+		assert.IsType(t, &nopWithEndpointReceiver{}, recvr.(multiReceiver)[0])
+	})
+
+	t.Run("test create receiver without pipeline types", func(t *testing.T) {
+		recvr, err := run.createRuntimeReceiver(
+			exampleFactory,
+			component.NewIDWithName("nop", "1/receiver_creator/1{endpoint=\"localhost:12345\"}/endpoint.id"),
+			loadedConfig,
+			&resourceEnhancer{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not support any of the pipeline types the receiver_creator is used in")
+		assert.Nil(t, recvr)
+	})
 }
 
 func TestValidateSetEndpointFromConfig(t *testing.T) {
@@ -22,6 +22,10 @@ import (
 type client interface {
 	// retrieves a string of key/value pairs of redis metadata
 	retrieveInfo() (string, error)
+	// retrieves the output of CLUSTER NODES, one node per line
+	retrieveClusterNodes() (string, error)
+	// retrieves up to count of the most recent slowlog entries, most recent first
+	retrieveSlowlog(count int) ([]slowlogEntry, error)
 	// line delimiter
 	// redis lines are delimited by \r\n, files (for testing) by \n
 	delimiter() string
@@ -53,7 +57,68 @@ func (c *redisClient) retrieveInfo() (string, error) {
 	return c.client.Info("all").Result()
 }
 
+// Retrieve the nodes of the cluster the Redis node belongs to.
+func (c *redisClient) retrieveClusterNodes() (string, error) {
+	return c.client.ClusterNodes().Result()
+}
+
+// Retrieve the most recent slowlog entries.
+func (c *redisClient) retrieveSlowlog(count int) ([]slowlogEntry, error) {
+	val, err := c.client.Do("slowlog", "get", count).Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseSlowlog(val)
+}
+
 // close client to release connention pool.
 func (c *redisClient) close() error {
 	return c.client.Close()
 }
+
+// Interface for a Redis Sentinel client. Implementation can be faked for testing.
+type sentinelClient interface {
+	// retrieves the state of each master monitored by the sentinel
+	retrieveMasters() ([]map[string]string, error)
+	// retrieves the state of each replica of the master
+	retrieveReplicas(master string) ([]map[string]string, error)
+	// close release sentinel client connection pool
+	close() error
+}
+
+// Wraps a real Redis Sentinel client, implements `sentinelClient` interface.
+type redisSentinelClient struct {
+	client *redis.SentinelClient
+}
+
+var _ sentinelClient = (*redisSentinelClient)(nil)
+
+// Creates a new real Redis Sentinel client from the passed-in redis.Options.
+func newRedisSentinelClient(options *redis.Options) sentinelClient {
+	return &redisSentinelClient{
+		client: redis.NewSentinelClient(options),
+	}
+}
+
+// Retrieve SENTINEL MASTERS.
+func (c *redisSentinelClient) retrieveMasters() ([]map[string]string, error) {
+	val, err := c.client.Masters().Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseSentinelReply(val)
+}
+
+// Retrieve SENTINEL REPLICAS of the master.
+func (c *redisSentinelClient) retrieveReplicas(master string) ([]map[string]string, error) {
+	val, err := c.client.Slaves(master).Result()
+	if err != nil {
+		return nil, err
+	}
+	return parseSentinelReply(val)
+}
+
+// close client to release connention pool.
+func (c *redisSentinelClient) close() error {
+	return c.client.Close()
+}
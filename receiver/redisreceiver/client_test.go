@@ -44,6 +44,21 @@ func (fakeClient) retrieveInfo() (string, error) {
 	return readFile("info")
 }
 
+func (fakeClient) retrieveClusterNodes() (string, error) {
+	return readFile("cluster_nodes")
+}
+
+func (fakeClient) retrieveSlowlog(count int) ([]slowlogEntry, error) {
+	entries, err := parseSlowlog([]interface{}{
+		[]interface{}{int64(14), int64(1676991600), int64(15000), []interface{}{"KEYS", "*"}, "127.0.0.1:52012", "worker"},
+		[]interface{}{int64(13), int64(1676991590), int64(12500), []interface{}{"SMEMBERS", "set:large"}},
+	})
+	if count < len(entries) {
+		entries = entries[:count]
+	}
+	return entries, err
+}
+
 func (fakeClient) close() error {
 	return nil
 }
@@ -62,3 +77,11 @@ func TestRetrieveInfo(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, strings.HasPrefix(res, "# Server"))
 }
+
+func TestRetrieveSlowlog(t *testing.T) {
+	g := fakeClient{}
+	res, err := g.retrieveSlowlog(1)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, int64(14), res[0].id)
+}
@@ -15,6 +15,9 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -36,5 +39,38 @@ type Config struct {
 
 	TLS configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
 
+	// Mode determines how the nodes to collect from are found from the endpoint:
+	// standalone collects from the endpoint only, cluster discovers the nodes of
+	// the Redis Cluster with CLUSTER NODES, and sentinel discovers the masters
+	// and replicas monitored by the Redis Sentinel at the endpoint.
+	Mode string `mapstructure:"mode"`
+
+	// Slowlog configures the collection of the slowlog entries as logs.
+	Slowlog SlowlogConfig `mapstructure:"slowlog"`
+
 	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
 }
+
+type SlowlogConfig struct {
+	// MaxEntries is the maximum number of entries retrieved from each node with
+	// SLOWLOG GET on each collection.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+var (
+	errInvalidMode              = errors.New("invalid mode")
+	errInvalidSlowlogMaxEntries = errors.New("slowlog max_entries must be greater than 0")
+)
+
+// Validate checks the mode and the slowlog settings.
+func (cfg *Config) Validate() error {
+	switch cfg.Mode {
+	case modeStandalone, modeCluster, modeSentinel:
+	default:
+		return fmt.Errorf("%w %q, must be one of %q, %q or %q", errInvalidMode, cfg.Mode, modeStandalone, modeCluster, modeSentinel)
+	}
+	if cfg.Slowlog.MaxEntries <= 0 {
+		return errInvalidSlowlogMaxEntries
+	}
+	return nil
+}
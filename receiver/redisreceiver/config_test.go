@@ -53,8 +53,58 @@ func TestConfig(t *testing.T) {
 			ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
 				CollectionInterval: 10 * time.Second,
 			},
+			Mode: modeCluster,
+			Slowlog: SlowlogConfig{
+				MaxEntries: 32,
+			},
 			Metrics: metadata.DefaultMetricsSettings(),
 		},
 		cfg,
 	)
 }
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		mutate      func(cfg *Config)
+		expectedErr error
+	}{
+		{
+			desc:   "default config",
+			mutate: func(cfg *Config) {},
+		},
+		{
+			desc: "sentinel mode",
+			mutate: func(cfg *Config) {
+				cfg.Mode = modeSentinel
+			},
+		},
+		{
+			desc: "invalid mode",
+			mutate: func(cfg *Config) {
+				cfg.Mode = "replication"
+			},
+			expectedErr: errInvalidMode,
+		},
+		{
+			desc: "invalid slowlog max entries",
+			mutate: func(cfg *Config) {
+				cfg.Slowlog.MaxEntries = 0
+			},
+			expectedErr: errInvalidSlowlogMaxEntries,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tc.mutate(cfg)
+			err := cfg.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
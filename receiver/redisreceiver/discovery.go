@@ -0,0 +1,252 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-redis/redis/v7"
+	"go.uber.org/multierr"
+)
+
+const (
+	// modeStandalone collects from the endpoint only.
+	modeStandalone = "standalone"
+	// modeCluster collects from the nodes of the Redis Cluster the endpoint belongs to.
+	modeCluster = "cluster"
+	// modeSentinel collects from the masters and replicas monitored by the Redis Sentinel at the endpoint.
+	modeSentinel = "sentinel"
+)
+
+// A Redis node to collect from.
+type redisNode struct {
+	// id of the node, empty in standalone mode.
+	id string
+	// address of the node, as host:port.
+	address string
+}
+
+// Finds the Redis nodes to collect from.
+type discoverer interface {
+	// discover returns the nodes to collect from.
+	discover() ([]redisNode, error)
+	// close releases the resources used for the discovery.
+	close() error
+}
+
+// Creates the discoverer of the mode, connecting to the seed endpoint with the options.
+func newDiscoverer(mode string, options *redis.Options) discoverer {
+	switch mode {
+	case modeCluster:
+		return &clusterDiscoverer{client: newRedisClient(options), seed: options.Addr}
+	case modeSentinel:
+		return &sentinelDiscoverer{client: newRedisSentinelClient(options)}
+	default:
+		return &standaloneDiscoverer{address: options.Addr}
+	}
+}
+
+// Returns the endpoint as the only node.
+type standaloneDiscoverer struct {
+	address string
+}
+
+func (d *standaloneDiscoverer) discover() ([]redisNode, error) {
+	return []redisNode{{address: d.address}}, nil
+}
+
+func (d *standaloneDiscoverer) close() error {
+	return nil
+}
+
+// Discovers the nodes of a Redis Cluster with CLUSTER NODES.
+type clusterDiscoverer struct {
+	client client
+	// seed is the address of the endpoint, used for the node the endpoint
+	// connects to if it doesn't know its own address yet.
+	seed string
+}
+
+func (d *clusterDiscoverer) discover() ([]redisNode, error) {
+	str, err := d.client.retrieveClusterNodes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve cluster nodes: %w", err)
+	}
+	return parseClusterNodes(str, d.seed), nil
+}
+
+func (d *clusterDiscoverer) close() error {
+	return d.client.close()
+}
+
+// Parses the output of CLUSTER NODES, skipping the nodes that are failing or
+// can't be connected to. Each line has the format:
+// <id> <ip:port@cport[,hostname]> <flags> <master> <ping-sent> <pong-recv> <config-epoch> <link-state> <slot> ...
+func parseClusterNodes(str string, seed string) []redisNode {
+	var nodes []redisNode
+	for _, line := range strings.Split(str, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 8 {
+			continue
+		}
+		flags := strings.Split(fields[2], ",")
+		if hasAnyFlag(flags, "fail", "fail?", "handshake", "noaddr") || fields[7] != "connected" {
+			continue
+		}
+
+		address := fields[1]
+		if i := strings.IndexAny(address, "@,"); i >= 0 {
+			address = address[:i]
+		}
+		if host, _, err := net.SplitHostPort(address); err != nil || host == "" {
+			if !hasAnyFlag(flags, "myself") {
+				continue
+			}
+			address = seed
+		}
+		nodes = append(nodes, redisNode{id: fields[0], address: address})
+	}
+	return nodes
+}
+
+// Discovers the masters and replicas monitored by a Redis Sentinel.
+type sentinelDiscoverer struct {
+	client sentinelClient
+}
+
+func (d *sentinelDiscoverer) discover() ([]redisNode, error) {
+	masters, err := d.client.retrieveMasters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sentinel masters: %w", err)
+	}
+
+	var nodes []redisNode
+	var errs error
+	for _, master := range masters {
+		if node, ok := sentinelNode(master); ok {
+			nodes = append(nodes, node)
+		}
+		replicas, err := d.client.retrieveReplicas(master["name"])
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to retrieve replicas of %s: %w", master["name"], err))
+			continue
+		}
+		for _, replica := range replicas {
+			if node, ok := sentinelNode(replica); ok {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return nodes, errs
+}
+
+func (d *sentinelDiscoverer) close() error {
+	return d.client.close()
+}
+
+// Returns the node described by the state reported by the sentinel, unless it is down.
+func sentinelNode(state map[string]string) (redisNode, bool) {
+	if hasAnyFlag(strings.Split(state["flags"], ","), "s_down", "o_down", "disconnected") {
+		return redisNode{}, false
+	}
+	if state["ip"] == "" || state["port"] == "" {
+		return redisNode{}, false
+	}
+	address := net.JoinHostPort(state["ip"], state["port"])
+	id := state["runid"]
+	if id == "" {
+		id = address
+	}
+	return redisNode{id: id, address: address}, true
+}
+
+// Parses the reply of SENTINEL MASTERS and SENTINEL REPLICAS, a list of flat
+// lists of alternating field names and values.
+func parseSentinelReply(val []interface{}) ([]map[string]string, error) {
+	states := make([]map[string]string, 0, len(val))
+	for _, item := range val {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields)%2 != 0 {
+			return nil, fmt.Errorf("unexpected sentinel reply: %v", item)
+		}
+		state := make(map[string]string, len(fields)/2)
+		for i := 0; i < len(fields); i += 2 {
+			state[fmt.Sprint(fields[i])] = fmt.Sprint(fields[i+1])
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+func hasAnyFlag(flags []string, wanted ...string) bool {
+	for _, flag := range flags {
+		for _, w := range wanted {
+			if flag == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Keeps a client for each of the nodes collected from.
+type nodeClients struct {
+	newClient func(address string) client
+	clients   map[string]client
+}
+
+func newNodeClients(options *redis.Options) *nodeClients {
+	return &nodeClients{
+		newClient: func(address string) client {
+			nodeOptions := *options
+			nodeOptions.Addr = address
+			return newRedisClient(&nodeOptions)
+		},
+		clients: map[string]client{},
+	}
+}
+
+// get returns the client of the node, creating it if needed.
+func (nc *nodeClients) get(node redisNode) client {
+	c, ok := nc.clients[node.address]
+	if !ok {
+		c = nc.newClient(node.address)
+		nc.clients[node.address] = c
+	}
+	return c
+}
+
+// retain closes the clients of the nodes that are no longer discovered.
+func (nc *nodeClients) retain(nodes []redisNode) error {
+	discovered := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		discovered[node.address] = true
+	}
+	var errs error
+	for address, c := range nc.clients {
+		if !discovered[address] {
+			errs = multierr.Append(errs, c.close())
+			delete(nc.clients, address)
+		}
+	}
+	return errs
+}
+
+// close closes the clients of all the nodes.
+func (nc *nodeClients) close() error {
+	return nc.retain(nil)
+}
@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterDiscoverer(t *testing.T) {
+	d := &clusterDiscoverer{client: newFakeClient(), seed: "redis-0:30001"}
+	nodes, err := d.discover()
+	require.NoError(t, err)
+	assert.Equal(t, []redisNode{
+		{id: "07c37dfeb235213a872192d90877d0cd55635b91", address: "127.0.0.1:30004"},
+		{id: "67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1", address: "127.0.0.1:30002"},
+		{id: "292f8b365bb7edb5e285caf0b7e6ddc7265d2f4f", address: "127.0.0.1:30003"},
+		{id: "824fe116063bc5fcf9f4ffd895bc17aee7731ac3", address: "127.0.0.1:30006"},
+		{id: "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", address: "redis-0:30001"},
+	}, nodes)
+}
+
+func TestParseClusterNodes(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		str      string
+		expected []redisNode
+	}{
+		{
+			desc: "empty",
+			str:  "",
+		},
+		{
+			desc: "without hostname",
+			str:  "a 10.0.0.1:6379@16379 master - 0 0 1 connected 0-16383\r\n",
+			expected: []redisNode{
+				{id: "a", address: "10.0.0.1:6379"},
+			},
+		},
+		{
+			desc: "handshake and noaddr",
+			str: "a 10.0.0.1:6379@16379 handshake - 0 0 1 connected\n" +
+				"b :0@0 noaddr,slave a 0 0 1 connected\n",
+		},
+		{
+			desc: "unknown address of other node",
+			str:  "a :6379@16379 master - 0 0 1 connected 0-16383\n",
+		},
+		{
+			desc: "truncated line",
+			str:  "a 10.0.0.1:6379@16379 master",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseClusterNodes(tc.str, "seed:6379"))
+		})
+	}
+}
+
+var _ sentinelClient = (*fakeSentinelClient)(nil)
+
+type fakeSentinelClient struct {
+	masters  []map[string]string
+	replicas map[string][]map[string]string
+}
+
+func (c *fakeSentinelClient) retrieveMasters() ([]map[string]string, error) {
+	return c.masters, nil
+}
+
+func (c *fakeSentinelClient) retrieveReplicas(master string) ([]map[string]string, error) {
+	replicas, ok := c.replicas[master]
+	if !ok {
+		return nil, errors.New("no such master")
+	}
+	return replicas, nil
+}
+
+func (c *fakeSentinelClient) close() error {
+	return nil
+}
+
+func TestSentinelDiscoverer(t *testing.T) {
+	d := &sentinelDiscoverer{client: &fakeSentinelClient{
+		masters: []map[string]string{
+			{"name": "cache", "ip": "10.0.0.1", "port": "6379", "runid": "c1", "flags": "master"},
+			{"name": "sessions", "ip": "10.0.0.2", "port": "6379", "runid": "s1", "flags": "master,s_down"},
+			{"name": "queue", "ip": "10.0.0.3", "port": "6379", "runid": "q1", "flags": "master"},
+		},
+		replicas: map[string][]map[string]string{
+			"cache": {
+				{"name": "10.0.0.4:6379", "ip": "10.0.0.4", "port": "6379", "runid": "c2", "flags": "slave"},
+				{"name": "10.0.0.5:6379", "ip": "10.0.0.5", "port": "6379", "runid": "", "flags": "slave"},
+				{"name": "10.0.0.6:6379", "ip": "10.0.0.6", "port": "6379", "runid": "c4", "flags": "slave,disconnected"},
+			},
+			"sessions": {
+				{"name": "10.0.0.7:6379", "ip": "10.0.0.7", "port": "6379", "runid": "s2", "flags": "slave"},
+			},
+		},
+	}}
+
+	nodes, err := d.discover()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to retrieve replicas of queue")
+	assert.Equal(t, []redisNode{
+		{id: "c1", address: "10.0.0.1:6379"},
+		{id: "c2", address: "10.0.0.4:6379"},
+		{id: "10.0.0.5:6379", address: "10.0.0.5:6379"},
+		{id: "s2", address: "10.0.0.7:6379"},
+		{id: "q1", address: "10.0.0.3:6379"},
+	}, nodes)
+}
+
+func TestParseSentinelReply(t *testing.T) {
+	states, err := parseSentinelReply([]interface{}{
+		[]interface{}{"name", "cache", "ip", "10.0.0.1", "port", "6379"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"name": "cache", "ip": "10.0.0.1", "port": "6379"}}, states)
+
+	_, err = parseSentinelReply([]interface{}{[]interface{}{"name"}})
+	assert.Error(t, err)
+	_, err = parseSentinelReply([]interface{}{"name"})
+	assert.Error(t, err)
+}
+
+type closeCountingClient struct {
+	fakeClient
+	closed *int
+}
+
+func (c closeCountingClient) close() error {
+	*c.closed++
+	return nil
+}
+
+func TestNodeClientsRetain(t *testing.T) {
+	closed := 0
+	created := 0
+	nc := &nodeClients{
+		newClient: func(string) client {
+			created++
+			return closeCountingClient{closed: &closed}
+		},
+		clients: map[string]client{},
+	}
+
+	a := redisNode{id: "a", address: "10.0.0.1:6379"}
+	b := redisNode{id: "b", address: "10.0.0.2:6379"}
+	nc.get(a)
+	nc.get(b)
+	nc.get(a)
+	assert.Equal(t, 2, created)
+
+	require.NoError(t, nc.retain([]redisNode{b}))
+	assert.Equal(t, 1, closed)
+	assert.Len(t, nc.clients, 1)
+	assert.Contains(t, nc.clients, b.address)
+
+	require.NoError(t, nc.close())
+	assert.Equal(t, 2, closed)
+	assert.Empty(t, nc.clients)
+}
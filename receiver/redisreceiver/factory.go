@@ -29,8 +29,12 @@ import (
 )
 
 const (
-	typeStr   = "redis"
-	stability = component.StabilityLevelBeta
+	typeStr       = "redis"
+	stability     = component.StabilityLevelBeta
+	logsStability = component.StabilityLevelDevelopment
+
+	// defaultSlowlogMaxEntries is the default length of the slowlog of Redis.
+	defaultSlowlogMaxEntries = 128
 )
 
 // NewFactory creates a factory for Redis receiver.
@@ -38,7 +42,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, stability))
+		receiver.WithMetrics(createMetricsReceiver, stability),
+		receiver.WithLogs(createLogsReceiver, logsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -52,7 +57,11 @@ func createDefaultConfig() component.Config {
 			Insecure: true,
 		},
 		ScraperControllerSettings: scs,
-		Metrics:                   metadata.DefaultMetricsSettings(),
+		Mode:                      modeStandalone,
+		Slowlog: SlowlogConfig{
+			MaxEntries: defaultSlowlogMaxEntries,
+		},
+		Metrics: metadata.DefaultMetricsSettings(),
 	}
 }
 
@@ -71,3 +80,12 @@ func createMetricsReceiver(
 
 	return scraperhelper.NewScraperControllerReceiver(&oCfg.ScraperControllerSettings, set, consumer, scraperhelper.AddScraper(scrp))
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	return newRedisLogsReceiver(cfg.(*Config), set, consumer)
+}
@@ -193,11 +193,15 @@ func (ras *ResourceAttributeSettings) Unmarshal(parser *confmap.Conf) error {
 
 // ResourceAttributesSettings provides settings for redisreceiver metrics.
 type ResourceAttributesSettings struct {
+	RedisNodeID  ResourceAttributeSettings `mapstructure:"redis.node.id"`
 	RedisVersion ResourceAttributeSettings `mapstructure:"redis.version"`
 }
 
 func DefaultResourceAttributesSettings() ResourceAttributesSettings {
 	return ResourceAttributesSettings{
+		RedisNodeID: ResourceAttributeSettings{
+			Enabled: true,
+		},
 		RedisVersion: ResourceAttributeSettings{
 			Enabled: true,
 		},
@@ -2059,6 +2063,15 @@ func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
 // ResourceMetricsOption applies changes to provided resource metrics.
 type ResourceMetricsOption func(ResourceAttributesSettings, pmetric.ResourceMetrics)
 
+// WithRedisNodeID sets provided value as "redis.node.id" attribute for current resource.
+func WithRedisNodeID(val string) ResourceMetricsOption {
+	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
+		if ras.RedisNodeID.Enabled {
+			rm.Resource().Attributes().PutStr("redis.node.id", val)
+		}
+	}
+}
+
 // WithRedisVersion sets provided value as "redis.version" attribute for current resource.
 func WithRedisVersion(val string) ResourceMetricsOption {
 	return func(ras ResourceAttributesSettings, rm pmetric.ResourceMetrics) {
@@ -186,7 +186,7 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisUptimeDataPoint(ts, 1)
 
-			metrics := mb.Emit(WithRedisVersion("attr-val"))
+			metrics := mb.Emit(WithRedisNodeID("attr-val"), WithRedisVersion("attr-val"))
 
 			if test.metricsSet == testMetricsSetNo {
 				assert.Equal(t, 0, metrics.ResourceMetrics().Len())
@@ -197,7 +197,14 @@ func TestMetricsBuilder(t *testing.T) {
 			rm := metrics.ResourceMetrics().At(0)
 			attrCount := 0
 			enabledAttrCount := 0
-			attrVal, ok := rm.Resource().Attributes().Get("redis.version")
+			attrVal, ok := rm.Resource().Attributes().Get("redis.node.id")
+			attrCount++
+			assert.Equal(t, mb.resourceAttributesSettings.RedisNodeID.Enabled, ok)
+			if mb.resourceAttributesSettings.RedisNodeID.Enabled {
+				enabledAttrCount++
+				assert.EqualValues(t, "attr-val", attrVal.Str())
+			}
+			attrVal, ok = rm.Resource().Attributes().Get("redis.version")
 			attrCount++
 			assert.Equal(t, mb.resourceAttributesSettings.RedisVersion.Enabled, ok)
 			if mb.resourceAttributesSettings.RedisVersion.Enabled {
@@ -205,7 +212,7 @@ func TestMetricsBuilder(t *testing.T) {
 				assert.EqualValues(t, "attr-val", attrVal.Str())
 			}
 			assert.Equal(t, enabledAttrCount, rm.Resource().Attributes().Len())
-			assert.Equal(t, attrCount, 1)
+			assert.Equal(t, attrCount, 2)
 
 			assert.Equal(t, 1, rm.ScopeMetrics().Len())
 			ms := rm.ScopeMetrics().At(0).Metrics()
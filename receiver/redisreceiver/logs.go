@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const slowlogEventName = "redis.slowlog"
+
+// redisLogsReceiver periodically retrieves the slowlog of each Redis node and
+// emits the entries that weren't emitted by previous collections as log records.
+type redisLogsReceiver struct {
+	logger     *zap.Logger
+	config     *Config
+	consumer   consumer.Logs
+	buildInfo  component.BuildInfo
+	discoverer discoverer
+	clients    *nodeClients
+
+	// lastIDs holds the ID of the most recent slowlog entry of each node, keyed by address.
+	lastIDs map[string]int64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newRedisLogsReceiver(config *Config, settings receiver.CreateSettings, consumer consumer.Logs) (*redisLogsReceiver, error) {
+	opts := &redis.Options{
+		Addr:     config.Endpoint,
+		Password: config.Password,
+		Network:  config.Transport,
+	}
+
+	var err error
+	if opts.TLSConfig, err = config.TLS.LoadTLSConfig(); err != nil {
+		return nil, err
+	}
+	return &redisLogsReceiver{
+		logger:     settings.Logger,
+		config:     config,
+		consumer:   consumer,
+		buildInfo:  settings.BuildInfo,
+		discoverer: newDiscoverer(config.Mode, opts),
+		clients:    newNodeClients(opts),
+		lastIDs:    map[string]int64{},
+	}, nil
+}
+
+// Start begins collecting on the configured interval.
+func (r *redisLogsReceiver) Start(_ context.Context, _ component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.CollectionInterval)
+		defer ticker.Stop()
+
+		for {
+			r.collect(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the collection and closes the connections to the nodes.
+func (r *redisLogsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return multierr.Append(r.clients.close(), r.discoverer.close())
+}
+
+func (r *redisLogsReceiver) collect(ctx context.Context) {
+	logs, err := r.buildLogs(time.Now())
+	if err != nil {
+		r.logger.Error("Failed to collect slowlog entries", zap.Error(err))
+	}
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		r.logger.Error("Failed to consume slowlog entries", zap.Error(err))
+	}
+}
+
+func (r *redisLogsReceiver) buildLogs(now time.Time) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	nodes, errs := r.discoverer.discover()
+	errs = multierr.Append(errs, r.clients.retain(nodes))
+	for address := range r.lastIDs {
+		if _, ok := r.clients.clients[address]; !ok {
+			delete(r.lastIDs, address)
+		}
+	}
+
+	observed := pcommon.NewTimestampFromTime(now)
+	for _, node := range nodes {
+		entries, err := r.clients.get(node).retrieveSlowlog(r.config.Slowlog.MaxEntries)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to retrieve the slowlog of node %s: %w", node.address, err))
+			continue
+		}
+		entries = r.newEntries(node.address, entries)
+		if len(entries) == 0 {
+			continue
+		}
+
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("redis.node.endpoint", node.address)
+		if node.id != "" {
+			rl.Resource().Attributes().PutStr("redis.node.id", node.id)
+		}
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName("otelcol/redisreceiver")
+		sl.Scope().SetVersion(r.buildInfo.Version)
+		for _, entry := range entries {
+			recordSlowlogEntry(sl.LogRecords().AppendEmpty(), observed, entry)
+		}
+	}
+	return logs, errs
+}
+
+// newEntries returns the entries that weren't emitted by previous collections, oldest first.
+// The entries are retrieved most recent first, with incrementing IDs that start over when
+// the node restarts.
+func (r *redisLogsReceiver) newEntries(address string, entries []slowlogEntry) []slowlogEntry {
+	if len(entries) == 0 {
+		return nil
+	}
+	lastID, ok := r.lastIDs[address]
+	if ok && entries[0].id < lastID {
+		ok = false
+	}
+	r.lastIDs[address] = entries[0].id
+
+	var result []slowlogEntry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if !ok || entries[i].id > lastID {
+			result = append(result, entries[i])
+		}
+	}
+	return result
+}
+
+func recordSlowlogEntry(lr plog.LogRecord, observed pcommon.Timestamp, entry slowlogEntry) {
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(entry.timestamp))
+	lr.SetObservedTimestamp(observed)
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetSeverityText("INFO")
+	lr.Body().SetStr(strings.Join(entry.args, " "))
+
+	attrs := lr.Attributes()
+	attrs.PutStr("event.name", slowlogEventName)
+	attrs.PutStr("db.system", "redis")
+	if len(entry.args) > 0 {
+		attrs.PutStr("db.operation", strings.ToUpper(entry.args[0]))
+	}
+	attrs.PutInt("redis.slowlog.id", entry.id)
+	attrs.PutInt("redis.slowlog.duration", entry.duration.Microseconds())
+	if entry.clientAddress != "" {
+		attrs.PutStr("redis.client.address", entry.clientAddress)
+	}
+	if entry.clientName != "" {
+		attrs.PutStr("redis.client.name", entry.clientName)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+)
+
+type slowlogClient struct {
+	fakeClient
+	entries []slowlogEntry
+}
+
+func (c *slowlogClient) retrieveSlowlog(count int) ([]slowlogEntry, error) {
+	if count < len(c.entries) {
+		return c.entries[:count], nil
+	}
+	return c.entries, nil
+}
+
+func newTestLogsReceiver(nodes []redisNode, c client, consumer *consumertest.LogsSink) *redisLogsReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CollectionInterval = 10 * time.Millisecond
+	return &redisLogsReceiver{
+		logger:     zap.NewNop(),
+		config:     cfg,
+		consumer:   consumer,
+		discoverer: &fakeDiscoverer{nodes: nodes},
+		clients: &nodeClients{
+			newClient: func(string) client { return c },
+			clients:   map[string]client{},
+		},
+		lastIDs: map[string]int64{},
+	}
+}
+
+func slowlogIDs(t *testing.T, logs plog.Logs) []int64 {
+	var ids []int64
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		lrs := logs.ResourceLogs().At(i).ScopeLogs().At(0).LogRecords()
+		for j := 0; j < lrs.Len(); j++ {
+			id, ok := lrs.At(j).Attributes().Get("redis.slowlog.id")
+			require.True(t, ok)
+			ids = append(ids, id.Int())
+		}
+	}
+	return ids
+}
+
+func TestBuildLogs(t *testing.T) {
+	node := redisNode{id: "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", address: "127.0.0.1:30001"}
+	r := newTestLogsReceiver([]redisNode{node}, newFakeClient(), nil)
+
+	now := time.Unix(1676991610, 0)
+	logs, err := r.buildLogs(now)
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.ResourceLogs().Len())
+	assert.Equal(t, []int64{13, 14}, slowlogIDs(t, logs))
+
+	rl := logs.ResourceLogs().At(0)
+	endpoint, _ := rl.Resource().Attributes().Get("redis.node.endpoint")
+	assert.Equal(t, node.address, endpoint.Str())
+	id, _ := rl.Resource().Attributes().Get("redis.node.id")
+	assert.Equal(t, node.id, id.Str())
+	assert.Equal(t, "otelcol/redisreceiver", rl.ScopeLogs().At(0).Scope().Name())
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(1)
+	assert.Equal(t, time.Unix(1676991600, 0).UTC(), lr.Timestamp().AsTime())
+	assert.Equal(t, now.UTC(), lr.ObservedTimestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+	assert.Equal(t, "KEYS *", lr.Body().Str())
+	assert.Equal(t, map[string]interface{}{
+		"event.name":             "redis.slowlog",
+		"db.system":              "redis",
+		"db.operation":           "KEYS",
+		"redis.slowlog.id":       int64(14),
+		"redis.slowlog.duration": int64(15000),
+		"redis.client.address":   "127.0.0.1:52012",
+		"redis.client.name":      "worker",
+	}, lr.Attributes().AsRaw())
+
+	// The entries were already emitted.
+	logs, err = r.buildLogs(now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.LogRecordCount())
+}
+
+func TestBuildLogsNewEntries(t *testing.T) {
+	c := &slowlogClient{}
+	r := newTestLogsReceiver([]redisNode{{address: "localhost:6379"}}, c, nil)
+
+	entry := func(id int64) slowlogEntry {
+		return slowlogEntry{id: id, timestamp: time.Unix(1676991600+id, 0), args: []string{"get", "key"}}
+	}
+
+	logs, err := r.buildLogs(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.LogRecordCount())
+
+	c.entries = []slowlogEntry{entry(2), entry(1)}
+	logs, err = r.buildLogs(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, slowlogIDs(t, logs))
+	_, ok := logs.ResourceLogs().At(0).Resource().Attributes().Get("redis.node.id")
+	assert.False(t, ok)
+
+	c.entries = []slowlogEntry{entry(5), entry(4), entry(3), entry(2)}
+	logs, err = r.buildLogs(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4, 5}, slowlogIDs(t, logs))
+
+	// The IDs start over when the node restarts.
+	c.entries = []slowlogEntry{entry(1), entry(0)}
+	logs, err = r.buildLogs(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0, 1}, slowlogIDs(t, logs))
+}
+
+func TestLogsReceiverStartShutdown(t *testing.T) {
+	sink := &consumertest.LogsSink{}
+	r := newTestLogsReceiver([]redisNode{{address: "localhost:6379"}}, newFakeClient(), sink)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Equal(t, 2, sink.LogRecordCount())
+}
+
+func TestNewLogsReceiverInvalidTLS(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TLS.CAFile = "/invalid"
+	_, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, "failed to load TLS config")
+}
+
+func TestParseSlowlogInvalid(t *testing.T) {
+	for _, val := range []interface{}{
+		"OK",
+		[]interface{}{"entry"},
+		[]interface{}{[]interface{}{int64(1), int64(1676991600), int64(100)}},
+		[]interface{}{[]interface{}{"1", int64(1676991600), int64(100), []interface{}{"GET", "key"}}},
+	} {
+		_, err := parseSlowlog(val)
+		assert.Error(t, err, val)
+	}
+}
@@ -33,7 +33,6 @@ func TestDataPointRecorders(t *testing.T) {
 	settings := receivertest.NewNopCreateSettings()
 	settings.Logger = logger
 	rs := &redisScraper{
-		redisSvc: newRedisSvc(newFakeClient()),
 		settings: settings.TelemetrySettings,
 		mb:       metadata.NewMetricsBuilder(Config{}.Metrics, settings),
 	}
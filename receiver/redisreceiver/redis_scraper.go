@@ -16,6 +16,8 @@ package redisreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -25,24 +27,34 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
 )
 
-// Runs intermittently, fetching info from Redis, creating metrics/datapoints,
+// Runs intermittently, fetching info from each Redis node, creating metrics/datapoints,
 // and feeding them to a metricsConsumer.
 type redisScraper struct {
-	client   client
-	redisSvc *redisSvc
-	settings component.TelemetrySettings
-	mb       *metadata.MetricsBuilder
-	uptime   time.Duration
+	discoverer discoverer
+	clients    *nodeClients
+	settings   component.TelemetrySettings
+	mb         *metadata.MetricsBuilder
+	// uptimes holds the uptime and the derived start time of each node, keyed by address.
+	uptimes map[string]nodeUptime
+}
+
+type nodeUptime struct {
+	uptime    time.Duration
+	startTime pcommon.Timestamp
 }
 
 const redisMaxDbs = 16 // Maximum possible number of redis databases
 
+var errNoNodesDiscovered = errors.New("no Redis nodes discovered")
+
 func newRedisScraper(cfg *Config, settings receiver.CreateSettings) (scraperhelper.Scraper, error) {
 	opts := &redis.Options{
 		Addr:     cfg.Endpoint,
@@ -54,15 +66,24 @@ func newRedisScraper(cfg *Config, settings receiver.CreateSettings) (scraperhelp
 	if opts.TLSConfig, err = cfg.TLS.LoadTLSConfig(); err != nil {
 		return nil, err
 	}
-	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+	return newRedisScraperWithDiscovery(newDiscoverer(cfg.Mode, opts), newNodeClients(opts), settings, cfg)
 }
 
-func newRedisScraperWithClient(client client, settings receiver.CreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
+func newRedisScraperWithClient(c client, settings receiver.CreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
+	clients := &nodeClients{
+		newClient: func(string) client { return c },
+		clients:   map[string]client{},
+	}
+	return newRedisScraperWithDiscovery(&standaloneDiscoverer{address: cfg.Endpoint}, clients, settings, cfg)
+}
+
+func newRedisScraperWithDiscovery(discoverer discoverer, clients *nodeClients, settings receiver.CreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
 	rs := &redisScraper{
-		client:   client,
-		redisSvc: newRedisSvc(client),
-		settings: settings.TelemetrySettings,
-		mb:       metadata.NewMetricsBuilder(cfg.Metrics, settings),
+		discoverer: discoverer,
+		clients:    clients,
+		settings:   settings.TelemetrySettings,
+		mb:         metadata.NewMetricsBuilder(cfg.Metrics, settings),
+		uptimes:    map[string]nodeUptime{},
 	}
 	return scraperhelper.NewScraper(
 		typeStr,
@@ -72,39 +93,88 @@ func newRedisScraperWithClient(client client, settings receiver.CreateSettings,
 }
 
 func (rs *redisScraper) shutdown(context.Context) error {
-	if rs.client != nil {
-		return rs.client.close()
+	var errs error
+	if rs.clients != nil {
+		errs = multierr.Append(errs, rs.clients.close())
 	}
-	return nil
+	if rs.discoverer != nil {
+		errs = multierr.Append(errs, rs.discoverer.close())
+	}
+	return errs
 }
 
-// Scrape is called periodically, querying Redis and building Metrics to send to
-// the next consumer. First builds 'fixed' metrics (non-keyspace metrics)
-// defined at startup time. Then builds 'keyspace' metrics if there are any
-// keyspace lines returned by Redis. There should be one keyspace line per
-// active Redis database, of which there can be 16.
+// Scrape is called periodically, discovering the Redis nodes, querying each
+// of them and building Metrics to send to the next consumer, with a resource
+// for each node.
 func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
-	inf, err := rs.redisSvc.info()
-	if err != nil {
+	nodes, err := rs.discoverer.discover()
+	if len(nodes) == 0 {
+		if err == nil {
+			err = errNoNodesDiscovered
+		}
 		return pmetric.Metrics{}, err
 	}
 
+	var errs scrapererror.ScrapeErrors
+	if err != nil {
+		errs.AddPartial(1, err)
+	}
+	if err = rs.clients.retain(nodes); err != nil {
+		rs.settings.Logger.Warn("failed to close the client of a node no longer discovered", zap.Error(err))
+	}
+
+	for _, node := range nodes {
+		if err = rs.scrapeNode(node); err != nil {
+			if len(nodes) == 1 {
+				return pmetric.Metrics{}, err
+			}
+			errs.AddPartial(1, fmt.Errorf("failed to scrape node %s: %w", node.address, err))
+		}
+	}
+	for address := range rs.uptimes {
+		if _, ok := rs.clients.clients[address]; !ok {
+			delete(rs.uptimes, address)
+		}
+	}
+	return rs.mb.Emit(), errs.Combine()
+}
+
+// scrapeNode queries a Redis node and records its metrics in a resource. First
+// builds 'fixed' metrics (non-keyspace metrics) defined at startup time. Then
+// builds 'keyspace' metrics if there are any keyspace lines returned by Redis.
+// There should be one keyspace line per active Redis database, of which there
+// can be 16.
+func (rs *redisScraper) scrapeNode(node redisNode) error {
+	inf, err := newRedisSvc(rs.clients.get(node)).info()
+	if err != nil {
+		return err
+	}
+
 	now := pcommon.NewTimestampFromTime(time.Now())
 	currentUptime, err := inf.getUptimeInSeconds()
 	if err != nil {
-		return pmetric.Metrics{}, err
+		return err
 	}
 
-	if rs.uptime == time.Duration(0) || rs.uptime > currentUptime {
-		rs.mb.Reset(metadata.WithStartTime(pcommon.NewTimestampFromTime(now.AsTime().Add(-currentUptime))))
+	nu := rs.uptimes[node.address]
+	if nu.uptime == time.Duration(0) || nu.uptime > currentUptime {
+		nu.startTime = pcommon.NewTimestampFromTime(now.AsTime().Add(-currentUptime))
 	}
-	rs.uptime = currentUptime
+	nu.uptime = currentUptime
+	rs.uptimes[node.address] = nu
+	rs.mb.Reset(metadata.WithStartTime(nu.startTime))
 
 	rs.recordCommonMetrics(now, inf)
 	rs.recordKeyspaceMetrics(now, inf)
 	rs.recordRoleMetrics(now, inf)
 	rs.recordCmdStatsMetrics(now, inf)
-	return rs.mb.Emit(metadata.WithRedisVersion(rs.getRedisVersion(inf))), nil
+
+	rmo := []metadata.ResourceMetricsOption{metadata.WithRedisVersion(rs.getRedisVersion(inf))}
+	if node.id != "" {
+		rmo = append(rmo, metadata.WithRedisNodeID(node.id))
+	}
+	rs.mb.EmitForResource(rmo...)
+	return nil
 }
 
 // recordCommonMetrics records metrics from Redis info key-value pairs.
@@ -16,12 +16,14 @@ package redisreceiver
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
@@ -46,6 +48,69 @@ func TestRedisRunnable(t *testing.T) {
 	assert.Equal(t, "otelcol/redisreceiver", il.Name())
 }
 
+type fakeDiscoverer struct {
+	nodes []redisNode
+	err   error
+}
+
+func (d *fakeDiscoverer) discover() ([]redisNode, error) {
+	return d.nodes, d.err
+}
+
+func (d *fakeDiscoverer) close() error {
+	return nil
+}
+
+type failingClient struct {
+	fakeClient
+}
+
+func (failingClient) retrieveInfo() (string, error) {
+	return "", errors.New("connection refused")
+}
+
+func TestRedisScraperCluster(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Mode = modeCluster
+	d := &fakeDiscoverer{nodes: []redisNode{
+		{id: "07c37dfeb235213a872192d90877d0cd55635b91", address: "127.0.0.1:30004"},
+		{id: "67ed2db8d677e59ec4a4cefb06858cf2a1a89fa1", address: "127.0.0.1:30002"},
+	}}
+	clients := &nodeClients{
+		newClient: func(string) client { return newFakeClient() },
+		clients:   map[string]client{},
+	}
+	runner, err := newRedisScraperWithDiscovery(d, clients, receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	for i, node := range d.nodes {
+		id, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("redis.node.id")
+		require.True(t, ok)
+		assert.Equal(t, node.id, id.Str())
+	}
+
+	// A node that can't be scraped doesn't prevent scraping the others.
+	clients.clients["127.0.0.1:30002"] = failingClient{}
+	md, err = runner.Scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "failed to scrape node 127.0.0.1:30002")
+	assert.Equal(t, 1, md.ResourceMetrics().Len())
+
+	// Clients of the nodes that are no longer discovered are released.
+	d.nodes = d.nodes[:1]
+	_, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, clients.clients, 1)
+
+	d.nodes = nil
+	_, err = runner.Scrape(context.Background())
+	assert.ErrorIs(t, err, errNoNodesDiscovered)
+}
+
 func TestNewReceiver_invalid_auth_error(t *testing.T) {
 	c := createDefaultConfig().(*Config)
 	c.TLS = configtls.TLSClientSetting{
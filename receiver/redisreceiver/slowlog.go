@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"time"
+)
+
+// An entry of the Redis slowlog, as returned by SLOWLOG GET.
+type slowlogEntry struct {
+	// id is the unique, incrementing, identifier of the entry.
+	id int64
+	// timestamp is when the command was processed.
+	timestamp time.Time
+	// duration is the execution time of the command.
+	duration time.Duration
+	// args are the command and its arguments.
+	args []string
+	// clientAddress and clientName are only reported by Redis 4.0 and later.
+	clientAddress string
+	clientName    string
+}
+
+// Parses the reply of SLOWLOG GET, a list of entries each made of the id, the
+// unix timestamp, the duration in microseconds, the arguments, and optionally
+// the client address and name.
+func parseSlowlog(val interface{}) ([]slowlogEntry, error) {
+	items, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected slowlog reply: %v", val)
+	}
+	entries := make([]slowlogEntry, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 4 {
+			return nil, fmt.Errorf("unexpected slowlog entry: %v", item)
+		}
+		id, ok1 := fields[0].(int64)
+		ts, ok2 := fields[1].(int64)
+		duration, ok3 := fields[2].(int64)
+		args, ok4 := fields[3].([]interface{})
+		if !ok1 || !ok2 || !ok3 || !ok4 {
+			return nil, fmt.Errorf("unexpected slowlog entry: %v", item)
+		}
+		entry := slowlogEntry{
+			id:        id,
+			timestamp: time.Unix(ts, 0),
+			duration:  time.Duration(duration) * time.Microsecond,
+			args:      make([]string, 0, len(args)),
+		}
+		for _, arg := range args {
+			entry.args = append(entry.args, fmt.Sprint(arg))
+		}
+		if len(fields) >= 6 {
+			entry.clientAddress = fmt.Sprint(fields[4])
+			entry.clientName = fmt.Sprint(fields[5])
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}